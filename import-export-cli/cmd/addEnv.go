@@ -26,13 +26,17 @@ import (
 
 var envToBeAdded string // Name of the environment to be added
 
-var flagTokenEndpoint string        // token endpoint of the environment to be added
-var flagPublisherEndpoint string    // Publisher endpoint of the environment to be added
-var flagDevPortalEndpoint string    // DevPortal endpoint of the environment to be added
-var flagRegistrationEndpoint string // registration endpoint of the environment to be added
-var flagApiManagerEndpoint string   // api manager endpoint of the environment to be added
-var flagAdminEndpoint string        // admin endpoint of the environment to be added
-var flagMiManagementEndpoint string // mi management endpoint of the environment to be added
+var flagTokenEndpoint string                // token endpoint of the environment to be added
+var flagPublisherEndpoint string            // Publisher endpoint of the environment to be added
+var flagDevPortalEndpoint string            // DevPortal endpoint of the environment to be added
+var flagRegistrationEndpoint string         // registration endpoint of the environment to be added
+var flagApiManagerEndpoint string           // api manager endpoint of the environment to be added
+var flagAdminEndpoint string                // admin endpoint of the environment to be added
+var flagMiManagementEndpoint string         // mi management endpoint of the environment to be added
+var flagMgwAdapterEndpoint string           // microgateway adapter endpoint of the environment to be added
+var flagMiManagementNodes map[string]string // mi management endpoints of the nodes of the environment to be added
+var flagMiClientCertificate string          // client certificate presented to the mi management endpoints
+var flagMiClientKey string                  // private key of the client certificate presented to the mi management endpoints
 
 // AddEnv command related Info
 const AddEnvCmdLiteral = "env [environment]"
@@ -57,6 +61,18 @@ const addEnvCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnv
 --token https://gw.com:8243/token \
 --mi https://localhost:9164
 
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` cluster \
+--mi-node node1=https://mi-1:9164 \
+--mi-node node2=https://mi-2:9164
+
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` secured \
+--mi https://localhost:9164 \
+--mi-client-cert ./client.crt \
+--mi-client-key ./client.key
+
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` mgw \
+--mg  https://localhost:9843
+
 ` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` dev \
 --apim https://apim.com:9443 \
 --registration https://idp.com:9443 \
@@ -65,7 +81,11 @@ const addEnvCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnv
 You can either provide only the flag --apim , or all the other 4 flags (--registration --publisher --devportal --admin) without providing --apim flag.
 If you are omitting any of --registration --publisher --devportal --admin flags, you need to specify --apim flag with the API Manager endpoint. In both of the
 cases --token flag is optional and use it to specify the gateway token endpoint. This will be used for "apictl get-keys" operation.
-To add a micro integrator instance to an environment you can use the --mi flag.`
+To add a micro integrator instance to an environment you can use the --mi flag.
+To add a cluster of micro integrator nodes to an environment use the --mi-node flag for each node instead of --mi.
+MI commands on the environment are run on all the nodes. Use [environment]@[node] to run them on a single node.
+Use the --mi-client-cert and --mi-client-key flags when the micro integrator requires mutual TLS.
+To add a microgateway adapter to an environment you can use the --mg flag.`
 
 // addEnvCmd represents the addEnv command
 var addEnvCmd = &cobra.Command{
@@ -92,6 +112,10 @@ func executeAddEnvCmd(mainConfigFilePath string) {
 	envEndpoints.AdminEndpoint = flagAdminEndpoint
 	envEndpoints.TokenEndpoint = flagTokenEndpoint
 	envEndpoints.MiManagementEndpoint = flagMiManagementEndpoint
+	envEndpoints.MgwAdapterEndpoint = flagMgwAdapterEndpoint
+	envEndpoints.MiManagementNodes = flagMiManagementNodes
+	envEndpoints.MiClientCertificate = flagMiClientCertificate
+	envEndpoints.MiClientKey = flagMiClientKey
 	err := impl.AddEnv(envToBeAdded, envEndpoints, mainConfigFilePath, AddEnvCmdLiteral)
 	if err != nil {
 		utils.HandleErrorAndExit("Error adding environment", err)
@@ -110,5 +134,12 @@ func init() {
 		"Registration endpoint for the environment")
 	addEnvCmd.Flags().StringVar(&flagAdminEndpoint, "admin", "", "Admin endpoint for the environment")
 	addEnvCmd.Flags().StringVar(&flagMiManagementEndpoint, "mi", "", "Micro Integrator Management endpoint for the environment")
+	addEnvCmd.Flags().StringToStringVar(&flagMiManagementNodes, "mi-node", nil,
+		"Name and Micro Integrator Management endpoint of a node of the environment (node=endpoint)")
+	addEnvCmd.Flags().StringVar(&flagMiClientCertificate, "mi-client-cert", "",
+		"PEM encoded client certificate presented to the Micro Integrator Management endpoints of the environment")
+	addEnvCmd.Flags().StringVar(&flagMiClientKey, "mi-client-key", "",
+		"PEM encoded private key of the Micro Integrator client certificate")
+	addEnvCmd.Flags().StringVar(&flagMgwAdapterEndpoint, "mg", "", "Microgateway adapter endpoint for the environment")
 	_ = addEnvCmd.MarkFlagRequired("environment")
 }
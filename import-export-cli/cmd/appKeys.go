@@ -0,0 +1,97 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// app-keys command related usage Info
+const appKeysCmdLiteral = "app-keys"
+const appKeysCmdShortDesc = "Manage the OAuth keys of an Application"
+const appKeysCmdLongDesc = `Generate, update, map, regenerate the consumer secret of and clean up the production and
+sandbox OAuth keys of an Application in the environment specified by the flag (--environment, -e)`
+const appKeysCmdExamples = utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysGenerateCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysUpdateCmdLiteral + ` -n SampleApp -e dev --grant-types client_credentials
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysMapCmdLiteral + ` -n SampleApp -e dev --consumer-key <key> --consumer-secret <secret>
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysRegenerateSecretCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysCleanUpCmdLiteral + ` -n SampleApp -e dev --key-type SANDBOX`
+
+// AppKeysCmd represents the app-keys command
+var AppKeysCmd = &cobra.Command{
+	Use:     appKeysCmdLiteral,
+	Short:   appKeysCmdShortDesc,
+	Long:    appKeysCmdLongDesc,
+	Example: appKeysCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + appKeysCmdLiteral + " called")
+	},
+}
+
+// getDevPortalAccessTokenAndAppId logs into the DevPortal of the environment and resolves the ID of the application
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @return accessToken, appId
+func getDevPortalAccessTokenAndAppId(environment, appName string) (string, string) {
+	cred, err := GetCredentials(environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	// Calling the DCR endpoint to get the credentials of the env
+	cred.ClientId, cred.ClientSecret, err = impl.CallDCREndpoint(cred, environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Internal error occurred", err)
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(cred, environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens", err)
+	}
+	appId, err := impl.GetDevPortalAppId(accessToken, environment, appName)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while searching the application", err)
+	}
+	return accessToken, appId
+}
+
+// getValidKeyType validates the key type flag and exits on invalid values
+func getValidKeyType(keyType string) string {
+	validKeyType, err := impl.ValidateKeyType(keyType)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid key type", err)
+	}
+	return validKeyType
+}
+
+// addAppKeysCommonFlags adds the flags shared by all the app-keys sub commands
+func addAppKeysCommonFlags(cmd *cobra.Command, appName, environment, keyType, keyManager *string) {
+	cmd.Flags().StringVarP(appName, "name", "n", "", "Name of the Application")
+	cmd.Flags().StringVarP(environment, "environment", "e", "", "Environment of the Application")
+	cmd.Flags().StringVarP(keyType, "key-type", "", utils.KeyTypeProduction,
+		"Type of the keys (PRODUCTION or SANDBOX)")
+	cmd.Flags().StringVarP(keyManager, "key-manager", "", utils.DefaultKeyManager, "Name of the key manager")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("environment")
+}
+
+func init() {
+	RootCmd.AddCommand(AppKeysCmd)
+}
@@ -0,0 +1,74 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var appKeysCleanUpAppName string
+var appKeysCleanUpEnvironment string
+var appKeysCleanUpKeyType string
+var appKeysCleanUpKeyManager string
+
+// app-keys clean-up command related usage Info
+const appKeysCleanUpCmdLiteral = "clean-up"
+const appKeysCleanUpCmdShortDesc = "Clean up the OAuth keys of an Application"
+const appKeysCleanUpCmdLongDesc = `Remove the production or sandbox keys of an Application, so that the keys can be
+generated or mapped again`
+const appKeysCleanUpCmdExamples = utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysCleanUpCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysCleanUpCmdLiteral + ` -n SampleApp -e dev --key-type SANDBOX --key-manager Keycloak
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// appKeysCleanUpCmd represents the app-keys clean-up command
+var appKeysCleanUpCmd = &cobra.Command{
+	Use:     appKeysCleanUpCmdLiteral,
+	Short:   appKeysCleanUpCmdShortDesc,
+	Long:    appKeysCleanUpCmdLongDesc,
+	Example: appKeysCleanUpCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + appKeysCmdLiteral + " " + appKeysCleanUpCmdLiteral + " called")
+		executeAppKeysCleanUpCmd()
+	},
+}
+
+// executeAppKeysCleanUpCmd executes the app-keys clean-up command
+func executeAppKeysCleanUpCmd() {
+	keyType := getValidKeyType(appKeysCleanUpKeyType)
+	accessToken, appId := getDevPortalAccessTokenAndAppId(appKeysCleanUpEnvironment, appKeysCleanUpAppName)
+	key, err := impl.GetAppKey(accessToken, appKeysCleanUpEnvironment, appId, keyType, appKeysCleanUpKeyManager)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while retrieving the keys of the application", err)
+	}
+	err = impl.CleanUpAppKeys(accessToken, appKeysCleanUpEnvironment, appId, key.KeyMappingID)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while cleaning up the keys of the application", err)
+	}
+	fmt.Println(keyType + " keys of the application " + appKeysCleanUpAppName + " cleaned up successfully.")
+}
+
+func init() {
+	AppKeysCmd.AddCommand(appKeysCleanUpCmd)
+	addAppKeysCommonFlags(appKeysCleanUpCmd, &appKeysCleanUpAppName, &appKeysCleanUpEnvironment,
+		&appKeysCleanUpKeyType, &appKeysCleanUpKeyManager)
+}
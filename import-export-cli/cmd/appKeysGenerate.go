@@ -0,0 +1,95 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var appKeysGenerateAppName string
+var appKeysGenerateEnvironment string
+var appKeysGenerateKeyType string
+var appKeysGenerateKeyManager string
+var appKeysGenerateGrantTypes []string
+var appKeysGenerateCallbackURL string
+var appKeysGenerateValidityTime int
+var appKeysGenerateOutputFile string
+
+// app-keys generate command related usage Info
+const appKeysGenerateCmdLiteral = "generate"
+const appKeysGenerateCmdShortDesc = "Generate the OAuth keys of an Application"
+const appKeysGenerateCmdLongDesc = `Generate the production or sandbox consumer key and consumer secret of an Application
+using the key manager specified by the flag (--key-manager). The keys are written to the file specified by the flag
+(--output-file) with owner only permissions, or printed to the console if the flag is not given`
+const appKeysGenerateCmdExamples = utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysGenerateCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysGenerateCmdLiteral + ` -n SampleApp -e dev --key-type SANDBOX --grant-types client_credentials,password
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysGenerateCmdLiteral + ` -n SampleApp -e dev --callback-url https://localhost/callback --output-file keys.json
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysGenerateCmdLiteral + ` -n SampleApp -e dev --key-manager Keycloak
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// appKeysGenerateCmd represents the app-keys generate command
+var appKeysGenerateCmd = &cobra.Command{
+	Use:     appKeysGenerateCmdLiteral,
+	Short:   appKeysGenerateCmdShortDesc,
+	Long:    appKeysGenerateCmdLongDesc,
+	Example: appKeysGenerateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + appKeysCmdLiteral + " " + appKeysGenerateCmdLiteral + " called")
+		executeAppKeysGenerateCmd()
+	},
+}
+
+// executeAppKeysGenerateCmd executes the app-keys generate command
+func executeAppKeysGenerateCmd() {
+	keyType := getValidKeyType(appKeysGenerateKeyType)
+	accessToken, appId := getDevPortalAccessTokenAndAppId(appKeysGenerateEnvironment, appKeysGenerateAppName)
+	keygenResponse, err := impl.GenerateAppKeys(accessToken, appKeysGenerateEnvironment, appId, impl.AppKeyOptions{
+		KeyType:      keyType,
+		KeyManager:   appKeysGenerateKeyManager,
+		GrantTypes:   appKeysGenerateGrantTypes,
+		CallbackURL:  appKeysGenerateCallbackURL,
+		ValidityTime: appKeysGenerateValidityTime,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error while generating the keys of the application", err)
+	}
+	keys := impl.NewAppKeysOutput(appKeysGenerateAppName, keygenResponse.KeyType, keygenResponse.KeyManager,
+		keygenResponse.KeyMappingID, keygenResponse.ConsumerKey, keygenResponse.ConsumerSecret,
+		keygenResponse.SupportedGrantTypes, keygenResponse.CallbackURL)
+	err = impl.PrintAppKeys(keys, appKeysGenerateOutputFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while writing the keys of the application", err)
+	}
+}
+
+func init() {
+	AppKeysCmd.AddCommand(appKeysGenerateCmd)
+	addAppKeysCommonFlags(appKeysGenerateCmd, &appKeysGenerateAppName, &appKeysGenerateEnvironment,
+		&appKeysGenerateKeyType, &appKeysGenerateKeyManager)
+	appKeysGenerateCmd.Flags().StringSliceVarP(&appKeysGenerateGrantTypes, "grant-types", "", utils.DefaultGrantTypes,
+		"Grant types supported by the keys")
+	appKeysGenerateCmd.Flags().StringVarP(&appKeysGenerateCallbackURL, "callback-url", "", "",
+		"Callback URL of the keys")
+	appKeysGenerateCmd.Flags().IntVarP(&appKeysGenerateValidityTime, "validity-time", "",
+		utils.DefaultTokenValidityPeriod, "Validity period of the access tokens in seconds")
+	appKeysGenerateCmd.Flags().StringVarP(&appKeysGenerateOutputFile, "output-file", "", "",
+		"File to write the consumer key and consumer secret")
+}
@@ -0,0 +1,91 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var appKeysMapAppName string
+var appKeysMapEnvironment string
+var appKeysMapKeyType string
+var appKeysMapKeyManager string
+var appKeysMapConsumerKey string
+var appKeysMapConsumerSecret string
+
+// app-keys map command related usage Info
+const appKeysMapCmdLiteral = "map"
+const appKeysMapCmdShortDesc = "Map an externally created OAuth client to an Application"
+const appKeysMapCmdLongDesc = `Map the consumer key and consumer secret of an OAuth client created directly in a key manager
+as the production or sandbox keys of an Application. If the consumer secret is not provided using the flag
+(--consumer-secret), it is prompted`
+const appKeysMapCmdExamples = utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysMapCmdLiteral + ` -n SampleApp -e dev --consumer-key <key>
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysMapCmdLiteral + ` -n SampleApp -e dev --key-type SANDBOX --key-manager Keycloak --consumer-key <key> --consumer-secret <secret>
+NOTE: The flags (--name (-n), --environment (-e) and --consumer-key) are mandatory.`
+
+// appKeysMapCmd represents the app-keys map command
+var appKeysMapCmd = &cobra.Command{
+	Use:     appKeysMapCmdLiteral,
+	Short:   appKeysMapCmdShortDesc,
+	Long:    appKeysMapCmdLongDesc,
+	Example: appKeysMapCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + appKeysCmdLiteral + " " + appKeysMapCmdLiteral + " called")
+		executeAppKeysMapCmd()
+	},
+}
+
+// executeAppKeysMapCmd executes the app-keys map command
+func executeAppKeysMapCmd() {
+	keyType := getValidKeyType(appKeysMapKeyType)
+	if appKeysMapConsumerSecret == "" {
+		consumerSecret, err := utils.ReadPassword("Enter Consumer Secret")
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the consumer secret", err)
+		}
+		appKeysMapConsumerSecret = consumerSecret
+	}
+	accessToken, appId := getDevPortalAccessTokenAndAppId(appKeysMapEnvironment, appKeysMapAppName)
+	keygenResponse, err := impl.MapAppKeys(accessToken, appKeysMapEnvironment, appId, impl.AppKeyOptions{
+		KeyType:        keyType,
+		KeyManager:     appKeysMapKeyManager,
+		ConsumerKey:    appKeysMapConsumerKey,
+		ConsumerSecret: appKeysMapConsumerSecret,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error while mapping the keys of the application", err)
+	}
+	fmt.Println("OAuth client " + keygenResponse.ConsumerKey + " mapped to the application " +
+		appKeysMapAppName + " successfully.")
+}
+
+func init() {
+	AppKeysCmd.AddCommand(appKeysMapCmd)
+	addAppKeysCommonFlags(appKeysMapCmd, &appKeysMapAppName, &appKeysMapEnvironment,
+		&appKeysMapKeyType, &appKeysMapKeyManager)
+	appKeysMapCmd.Flags().StringVarP(&appKeysMapConsumerKey, "consumer-key", "", "",
+		"Consumer key of the OAuth client")
+	appKeysMapCmd.Flags().StringVarP(&appKeysMapConsumerSecret, "consumer-secret", "", "",
+		"Consumer secret of the OAuth client")
+	_ = appKeysMapCmd.MarkFlagRequired("consumer-key")
+}
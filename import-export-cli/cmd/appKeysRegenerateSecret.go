@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var appKeysRegenerateAppName string
+var appKeysRegenerateEnvironment string
+var appKeysRegenerateKeyType string
+var appKeysRegenerateKeyManager string
+var appKeysRegenerateOutputFile string
+
+// app-keys regenerate-secret command related usage Info
+const appKeysRegenerateSecretCmdLiteral = "regenerate-secret"
+const appKeysRegenerateSecretCmdShortDesc = "Regenerate the consumer secret of an Application"
+const appKeysRegenerateSecretCmdLongDesc = `Regenerate the consumer secret of the production or sandbox keys of an
+Application. The previous consumer secret becomes invalid`
+const appKeysRegenerateSecretCmdExamples = utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysRegenerateSecretCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysRegenerateSecretCmdLiteral + ` -n SampleApp -e dev --key-type SANDBOX --output-file keys.json
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// appKeysRegenerateSecretCmd represents the app-keys regenerate-secret command
+var appKeysRegenerateSecretCmd = &cobra.Command{
+	Use:     appKeysRegenerateSecretCmdLiteral,
+	Short:   appKeysRegenerateSecretCmdShortDesc,
+	Long:    appKeysRegenerateSecretCmdLongDesc,
+	Example: appKeysRegenerateSecretCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + appKeysCmdLiteral + " " + appKeysRegenerateSecretCmdLiteral + " called")
+		executeAppKeysRegenerateSecretCmd()
+	},
+}
+
+// executeAppKeysRegenerateSecretCmd executes the app-keys regenerate-secret command
+func executeAppKeysRegenerateSecretCmd() {
+	keyType := getValidKeyType(appKeysRegenerateKeyType)
+	accessToken, appId := getDevPortalAccessTokenAndAppId(appKeysRegenerateEnvironment, appKeysRegenerateAppName)
+	key, err := impl.GetAppKey(accessToken, appKeysRegenerateEnvironment, appId, keyType, appKeysRegenerateKeyManager)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while retrieving the keys of the application", err)
+	}
+	regenResponse, err := impl.RegenerateAppConsumerSecret(accessToken, appKeysRegenerateEnvironment, appId,
+		key.KeyMappingID)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while regenerating the consumer secret of the application", err)
+	}
+	keys := impl.NewAppKeysOutput(appKeysRegenerateAppName, key.KeyType, key.KeyManager, key.KeyMappingID,
+		regenResponse.ConsumerKey, regenResponse.ConsumerSecret, key.SupportedGrantTypes, key.CallbackURL)
+	err = impl.PrintAppKeys(keys, appKeysRegenerateOutputFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while writing the keys of the application", err)
+	}
+}
+
+func init() {
+	AppKeysCmd.AddCommand(appKeysRegenerateSecretCmd)
+	addAppKeysCommonFlags(appKeysRegenerateSecretCmd, &appKeysRegenerateAppName, &appKeysRegenerateEnvironment,
+		&appKeysRegenerateKeyType, &appKeysRegenerateKeyManager)
+	appKeysRegenerateSecretCmd.Flags().StringVarP(&appKeysRegenerateOutputFile, "output-file", "", "",
+		"File to write the consumer key and the new consumer secret")
+}
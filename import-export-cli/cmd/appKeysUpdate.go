@@ -0,0 +1,88 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var appKeysUpdateAppName string
+var appKeysUpdateEnvironment string
+var appKeysUpdateKeyType string
+var appKeysUpdateKeyManager string
+var appKeysUpdateGrantTypes []string
+var appKeysUpdateCallbackURL string
+
+// app-keys update command related usage Info
+const appKeysUpdateCmdLiteral = "update"
+const appKeysUpdateCmdShortDesc = "Update the grant types and the callback URL of the OAuth keys of an Application"
+const appKeysUpdateCmdLongDesc = `Update the grant types and the callback URL of the production or sandbox keys of an
+Application which were already generated or mapped`
+const appKeysUpdateCmdExamples = utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysUpdateCmdLiteral + ` -n SampleApp -e dev --grant-types client_credentials,refresh_token
+` + utils.ProjectName + ` ` + appKeysCmdLiteral + ` ` + appKeysUpdateCmdLiteral + ` -n SampleApp -e dev --key-type SANDBOX --callback-url https://localhost/callback
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.
+At least one of the flags (--grant-types and --callback-url) should be provided.`
+
+// appKeysUpdateCmd represents the app-keys update command
+var appKeysUpdateCmd = &cobra.Command{
+	Use:     appKeysUpdateCmdLiteral,
+	Short:   appKeysUpdateCmdShortDesc,
+	Long:    appKeysUpdateCmdLongDesc,
+	Example: appKeysUpdateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + appKeysCmdLiteral + " " + appKeysUpdateCmdLiteral + " called")
+		if len(appKeysUpdateGrantTypes) == 0 && appKeysUpdateCallbackURL == "" {
+			utils.HandleErrorAndExit("Nothing to update. Provide either --grant-types or --callback-url", nil)
+		}
+		executeAppKeysUpdateCmd()
+	},
+}
+
+// executeAppKeysUpdateCmd executes the app-keys update command
+func executeAppKeysUpdateCmd() {
+	keyType := getValidKeyType(appKeysUpdateKeyType)
+	accessToken, appId := getDevPortalAccessTokenAndAppId(appKeysUpdateEnvironment, appKeysUpdateAppName)
+	key, err := impl.GetAppKey(accessToken, appKeysUpdateEnvironment, appId, keyType, appKeysUpdateKeyManager)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while retrieving the keys of the application", err)
+	}
+	updatedKey, err := impl.UpdateAppKeys(accessToken, appKeysUpdateEnvironment, appId, key, impl.AppKeyOptions{
+		GrantTypes:  appKeysUpdateGrantTypes,
+		CallbackURL: appKeysUpdateCallbackURL,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error while updating the keys of the application", err)
+	}
+	fmt.Println("Keys of the application " + appKeysUpdateAppName + " updated successfully.")
+	utils.Logln(utils.LogPrefixInfo+"Supported grant types:", updatedKey.SupportedGrantTypes)
+}
+
+func init() {
+	AppKeysCmd.AddCommand(appKeysUpdateCmd)
+	addAppKeysCommonFlags(appKeysUpdateCmd, &appKeysUpdateAppName, &appKeysUpdateEnvironment,
+		&appKeysUpdateKeyType, &appKeysUpdateKeyManager)
+	appKeysUpdateCmd.Flags().StringSliceVarP(&appKeysUpdateGrantTypes, "grant-types", "", []string{},
+		"Grant types supported by the keys")
+	appKeysUpdateCmd.Flags().StringVarP(&appKeysUpdateCallbackURL, "callback-url", "", "",
+		"Callback URL of the keys")
+}
@@ -18,16 +18,11 @@
 package deprecated
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd/k8s"
-	"io"
-	"io/ioutil"
-	"log"
 	"math/rand"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -97,20 +92,20 @@ func handleAddApi(nameSuffix string) {
 			swaggerPath := filepath.Join(flagSwaggerFilePath, filepath.FromSlash("Meta-information/swagger.yaml"))
 			//creating kubernetes configmap with swagger definition
 			fmt.Println("creating configmap with swagger definition")
-			errConf := createConfigMapWithNamespace(swaggerCmNames[i], swaggerPath, flagNamespace, k8sUtils.K8sCreate)
+			errConf := createConfigMapWithNamespace(swaggerCmNames[i], swaggerPath, flagNamespace)
 			if errConf != nil {
 				utils.HandleErrorAndExit("Error creating configmap", errConf)
 			}
 
 			// copy all bal interceptors to the temp dir
 			balInterceptorsCmName := fmt.Sprintf("%v-%v-bal-intcpt%s", flagApiName, i+1, nameSuffix)
-			balFound := handleBalInterceptors(balInterceptorsCmName, flagSwaggerFilePath, "create", flagNamespace)
+			balFound := handleBalInterceptors(balInterceptorsCmName, flagSwaggerFilePath, flagNamespace)
 			if balFound {
 				balInterceptorsCmNames = append(balInterceptorsCmNames, balInterceptorsCmName)
 			}
 
 			// handle java interceptors
-			tempJavaIntCms := handleJavaInterceptors(nameSuffix, flagSwaggerFilePath, "create", flagNamespace,
+			tempJavaIntCms := handleJavaInterceptors(nameSuffix, flagSwaggerFilePath, flagNamespace,
 				fmt.Sprintf("%v-%v", flagApiName, i+1))
 			if tempJavaIntCms != nil {
 				javaInterceptorsCmNames = append(javaInterceptorsCmNames, tempJavaIntCms...)
@@ -119,8 +114,7 @@ func handleAddApi(nameSuffix string) {
 		case mode.IsRegular():
 			//creating kubernetes configmap with swagger definition
 			fmt.Println("creating configmap with swagger definition")
-			errConf := createConfigMapWithNamespace(swaggerCmNames[i], flagSwaggerFilePath, flagNamespace,
-				k8sUtils.K8sCreate)
+			errConf := createConfigMapWithNamespace(swaggerCmNames[i], flagSwaggerFilePath, flagNamespace)
 			if errConf != nil {
 				utils.HandleErrorAndExit("Error creating configmap", errConf)
 			}
@@ -170,25 +164,12 @@ func validateAddApiCommand() {
 	}
 }
 
-//create configmap with swagger definition
-func createConfigMapWithNamespace(configMapName string, filePath string, namespace string, operation string) error {
-	cmd := exec.Command(
-		k8sUtils.Kubectl,
-		operation,
-		"configmap",
-		configMapName,
-		"--from-file",
-		filePath,
-		"-n", namespace,
-	)
-	//print kubernetes error commands
-	var errBuf, outBuf bytes.Buffer
-	cmd.Stderr = io.MultiWriter(os.Stderr, &errBuf)
-	cmd.Stdout = io.MultiWriter(os.Stdout, &outBuf)
-	err := cmd.Run()
-	if err != nil {
+// create configmap with swagger definition
+func createConfigMapWithNamespace(configMapName, filePath, namespace string) error {
+	if err := k8sUtils.K8sCreateConfigMapFromPath(configMapName, namespace, filePath); err != nil {
 		return err
 	}
+	fmt.Printf("configmap/%s created\n", configMapName)
 	return nil
 }
 
@@ -211,13 +192,9 @@ func createAPI(configMapNames []string, timestamp string, balInterceptors []stri
 	apiCrd.Spec.Image = flagImage
 	apiCrd.Spec.IngressHostname = flagHostname
 
-	k8sOperation := k8sUtils.K8sCreate
-	k8sSaveConfig := true
 	if timestamp != "" {
 		//set update timestamp
 		apiCrd.Spec.UpdateTimeStamp = timestamp
-		k8sOperation = k8sUtils.K8sApply
-		k8sSaveConfig = false
 	}
 	if len(balInterceptors) > 0 {
 		// set bal interceptors configmap name in API cr
@@ -245,39 +222,30 @@ func createAPI(configMapNames []string, timestamp string, balInterceptors []stri
 		apiCrd.Spec.IngressHostname = flagHostname
 	}
 
-	byteVal, errMarshal := yaml.Marshal(apiCrd)
-	if errMarshal != nil {
-		utils.HandleErrorAndExit("Error marshal api configmap ", errMarshal)
-	}
-	//write configmap to a temp file
-	tmpFile, err := ioutil.TempFile(os.TempDir(), "apicr-*.yaml")
+	apiCr, err := k8sUtils.ToUnstructured(apiCrd)
 	if err != nil {
-		log.Fatal("Cannot create temporary file", err)
+		utils.HandleErrorAndExit("Error rendering API custom resource", err)
 	}
-	defer os.Remove(tmpFile.Name())
-	if _, err = tmpFile.Write(byteVal); err != nil {
-		log.Fatal("Failed to write to temporary file", err)
-	}
-	// Close the file
-	if err := tmpFile.Close(); err != nil {
-		log.Fatal(err)
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
 	}
 
-	k8sArgs := []string{k8sOperation, "-f", tmpFile.Name(), "-n", flagNamespace}
-	if k8sSaveConfig {
-		k8sArgs = append(k8sArgs, "--save-config")
+	//create or update api in the cluster
+	var errAddApi error
+	if timestamp != "" {
+		errAddApi = client.Apply(apiCr, flagNamespace)
+	} else {
+		errAddApi = client.Create(apiCr, flagNamespace)
 	}
-
-	//execute kubernetes command to create or update api from file
-	errAddApi := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sArgs...)
 	if errAddApi != nil {
-		fmt.Println("error configuring API")
+		fmt.Println("error configuring API:", errAddApi)
 		// delete all configs if any error
 		rollbackConfigs(apiCrd)
 	}
 }
 
-func handleBalInterceptors(configMapName string, path string, operation string, namespace string) bool {
+func handleBalInterceptors(configMapName string, path string, namespace string) bool {
 	//get interceptors if available
 	interceptorsPath := filepath.Join(path, "Interceptors")
 	//check interceptors dir is not empty
@@ -292,7 +260,7 @@ func handleBalInterceptors(configMapName string, path string, operation string,
 
 		//creating kubernetes configmap with interceptors
 		fmt.Println("creating configmap with ballerina interceptors")
-		if err := createConfigMapWithNamespace(configMapName, interceptorsPath, namespace, operation); err != nil {
+		if err := createConfigMapWithNamespace(configMapName, interceptorsPath, namespace); err != nil {
 			utils.HandleErrorAndExit("Error creating configmap for interceptors", err)
 		}
 
@@ -302,7 +270,7 @@ func handleBalInterceptors(configMapName string, path string, operation string,
 	return false
 }
 
-func handleJavaInterceptors(nameSuffix string, path string, operation string, namespace string, cmPrefixName string) []string {
+func handleJavaInterceptors(nameSuffix string, path string, namespace string, cmPrefixName string) []string {
 	var interceptors []string
 	var javaInterceptorsConfNames []string
 	//get interceptors if available
@@ -333,7 +301,7 @@ func handleJavaInterceptors(nameSuffix string, path string, operation string, na
 			javaInterceptorsConfNames = append(javaInterceptorsConfNames, cmName)
 
 			fmt.Println("creating configmap with java interceptor " + cmName)
-			errConfInt := createConfigMapWithNamespace(cmName, filePath, namespace, operation)
+			errConfInt := createConfigMapWithNamespace(cmName, filePath, namespace)
 			if errConfInt != nil {
 				utils.HandleErrorAndExit("Error creating configmap for java-interceptor "+cmName, errConfInt)
 			}
@@ -357,12 +325,8 @@ func rollbackConfigs(apiCr *wso2v1alpha1.API) {
 		return
 	}
 
-	// execute kubernetes command to delete
 	fmt.Println("Deleting created configs")
-	k8sArgs := []string{k8sUtils.K8sDelete, "cm"}
-	k8sArgs = append(k8sArgs, rollbackConfMaps...)
-
-	delConfErr := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sArgs...)
+	delConfErr := k8sUtils.K8sDeleteConfigMaps(apiCr.Namespace, rollbackConfMaps...)
 	if delConfErr != nil {
 		utils.HandleErrorAndExit("error deleting configmaps of the API: "+apiCr.Name, delConfErr)
 	}
@@ -92,7 +92,7 @@ func init() {
 	// flags for installing api-operator in batch mode
 	// only the flag "registry-type" is required and others are registry specific flags
 	// same flags defined in 'installApiOperator'
-	changeDockerRegistryCmdDeprecated.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR |GCR | HTTP")
+	changeDockerRegistryCmdDeprecated.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR | GCR | HTTP | HTTPS | QUAY | AZURE_ACR | HARBOR | GHCR")
 	changeDockerRegistryCmdDeprecated.Flags().StringVarP(&flagBmRepository, k8sUtils.FlagBmRepository, "r", "", "Repository name or URI")
 	changeDockerRegistryCmdDeprecated.Flags().StringVarP(&flagBmUsername, k8sUtils.FlagBmUsername, "u", "", "Username of the repository")
 	changeDockerRegistryCmdDeprecated.Flags().StringVarP(&flagBmPassword, k8sUtils.FlagBmPassword, "p", "", "Password of the given user")
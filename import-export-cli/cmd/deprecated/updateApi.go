@@ -18,6 +18,7 @@
 package deprecated
 
 import (
+	"context"
 	"fmt"
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd/k8s"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const updateCmdLiteral = "update"
@@ -59,9 +61,14 @@ var updateApiCmdDeprecated = &cobra.Command{
 		validateAddApiCommand()
 
 		// check the existence of the API
-		getApiErr := k8sUtils.ExecuteCommandWithoutOutputs(
-			k8sUtils.Kubectl, k8sUtils.K8sGet, k8sUtils.ApiOpCrdApi, flagApiName, "-n", flagNamespace)
+		client, err := k8sUtils.GetK8sClient()
+		if err != nil {
+			utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+		}
+		_, getApiErr := client.Dynamic.Resource(k8sUtils.ApiGVR).Namespace(client.ResolveNamespace(flagNamespace)).
+			Get(context.TODO(), strings.ToLower(flagApiName), metav1.GetOptions{})
 		if getApiErr != nil {
+			utils.Logln(utils.LogPrefixError + getApiErr.Error())
 			var errMsg string
 			if flagNamespace != "" {
 				errMsg = fmt.Sprintf("Could not find the API \"%s\" in the namespace \"%s\"",
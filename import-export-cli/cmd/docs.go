@@ -0,0 +1,128 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// docs command related usage Info
+const docsCmdLiteral = "docs"
+const docsCmdShortDesc = "Manage the documents of an API"
+const docsCmdLongDesc = `List, add, update and delete the documents of an API in the environment specified by the flag
+(--environment, -e), or sync the documents in the Docs directory of an API project without re-importing the API`
+const docsCmdExamples = utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsListCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsAddCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide --source-type MARKDOWN --content-file guide.md
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsUpdateCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide --summary "Getting started"
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsDeleteCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsSyncCmdLiteral + ` -f ~/PizzaShackAPI -e dev`
+
+// DocsCmd represents the docs command
+var DocsCmd = &cobra.Command{
+	Use:     docsCmdLiteral,
+	Short:   docsCmdShortDesc,
+	Long:    docsCmdLongDesc,
+	Example: docsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + docsCmdLiteral + " called")
+	},
+}
+
+// getPublisherAccessTokenAndApiId logs into the Publisher of the environment and resolves the ID of the API
+// @param environment : Environment of the API
+// @param apiName : Name of the API
+// @param apiVersion : Version of the API
+// @param apiProvider : Provider of the API
+// @return accessToken, apiId
+func getPublisherAccessTokenAndApiId(environment, apiName, apiVersion, apiProvider string) (string, string) {
+	accessToken := getPublisherAccessToken(environment)
+	apiId, err := impl.GetAPIId(accessToken, environment, apiName, apiVersion, apiProvider)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while searching the API", err)
+	}
+	return accessToken, apiId
+}
+
+// getPublisherAccessToken logs into the Publisher of the environment
+func getPublisherAccessToken(environment string) string {
+	cred, err := GetCredentials(environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(cred, environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens", err)
+	}
+	return accessToken
+}
+
+// readDocContent returns the content of a document given inline or as a file. For FILE documents, the path to the
+// file is returned
+func readDocContent(sourceType, content, contentFile string) string {
+	if contentFile == "" {
+		return content
+	}
+	if sourceType == utils.DocSourceTypeFile {
+		return contentFile
+	}
+	fileContent, err := ioutil.ReadFile(contentFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the content file", err)
+	}
+	return string(fileContent)
+}
+
+// addDocsCommonFlags adds the flags shared by the docs sub commands which operate on an API
+func addDocsCommonFlags(cmd *cobra.Command, apiName, apiVersion, apiProvider, environment *string) {
+	cmd.Flags().StringVarP(apiName, "name", "n", "", "Name of the API")
+	cmd.Flags().StringVarP(apiVersion, "version", "v", "", "Version of the API")
+	cmd.Flags().StringVarP(apiProvider, "provider", "r", "", "Provider of the API")
+	cmd.Flags().StringVarP(environment, "environment", "e", "", "Environment of the API")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("version")
+	_ = cmd.MarkFlagRequired("environment")
+}
+
+// addDocFlags adds the flags which describe a document
+func addDocFlags(cmd *cobra.Command, doc *utils.Document, content, contentFile *string) {
+	cmd.Flags().StringVarP(&doc.Name, "doc-name", "", "", "Name of the document")
+	cmd.Flags().StringVarP(&doc.Type, "type", "", utils.DefaultDocType, "Type of the document. Valid types: "+
+		"HOWTO, SAMPLES, PUBLIC_FORUM, SUPPORT_FORUM, API_MESSAGE_FORMAT, SWAGGER_DOC and OTHER")
+	cmd.Flags().StringVarP(&doc.OtherTypeName, "other-type-name", "", "",
+		"Name of the type of the document if the type is OTHER")
+	cmd.Flags().StringVarP(&doc.SourceType, "source-type", "", utils.DocSourceTypeInline,
+		"Source type of the document (INLINE, MARKDOWN, URL or FILE)")
+	cmd.Flags().StringVarP(&doc.Summary, "summary", "", "", "Summary of the document")
+	cmd.Flags().StringVarP(&doc.Visibility, "visibility", "", utils.DefaultDocVisibility,
+		"Visibility of the document (OWNER_ONLY, PRIVATE or API_LEVEL)")
+	cmd.Flags().StringVarP(&doc.SourceURL, "source-url", "", "", "URL of the document if the source type is URL")
+	cmd.Flags().StringVarP(content, "content", "", "", "Inline content of an INLINE or MARKDOWN document")
+	cmd.Flags().StringVarP(contentFile, "content-file", "", "",
+		"File containing the content of an INLINE or MARKDOWN document, or the file of a FILE document")
+	_ = cmd.MarkFlagRequired("doc-name")
+}
+
+func init() {
+	RootCmd.AddCommand(DocsCmd)
+}
@@ -0,0 +1,89 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var docsAddAPIName string
+var docsAddAPIVersion string
+var docsAddAPIProvider string
+var docsAddEnvironment string
+var docsAddDoc utils.Document
+var docsAddContent string
+var docsAddContentFile string
+
+// docs add command related usage Info
+const docsAddCmdLiteral = "add"
+const docsAddCmdShortDesc = "Add a document to an API"
+const docsAddCmdLongDesc = `Add an INLINE, MARKDOWN, URL or FILE document to an API in the environment specified by the
+flag (--environment, -e)`
+const docsAddCmdExamples = utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsAddCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide --content "Order pizzas using the API"
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsAddCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide --source-type MARKDOWN --content-file guide.md
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsAddCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Forum --type PUBLIC_FORUM --source-type URL --source-url https://forum.example.com
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsAddCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Samples --type SAMPLES --source-type FILE --content-file samples.pdf
+NOTE: The 4 flags (--name (-n), --version (-v), --environment (-e) and --doc-name) are mandatory.`
+
+// DocsAddCmd represents the docs add command
+var DocsAddCmd = &cobra.Command{
+	Use:     docsAddCmdLiteral,
+	Short:   docsAddCmdShortDesc,
+	Long:    docsAddCmdLongDesc,
+	Example: docsAddCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + docsCmdLiteral + " " + docsAddCmdLiteral + " called")
+		executeDocsAddCmd()
+	},
+}
+
+// executeDocsAddCmd executes the docs add command
+func executeDocsAddCmd() {
+	if err := impl.ValidateDocument(&docsAddDoc); err != nil {
+		utils.HandleErrorAndExit("Invalid document", err)
+	}
+	if docsAddDoc.SourceType == utils.DocSourceTypeFile && docsAddContentFile == "" {
+		utils.HandleErrorAndExit("The flag --content-file is required for the documents of source type "+
+			utils.DocSourceTypeFile, nil)
+	}
+	content := readDocContent(docsAddDoc.SourceType, docsAddContent, docsAddContentFile)
+
+	accessToken, apiId := getPublisherAccessTokenAndApiId(docsAddEnvironment, docsAddAPIName, docsAddAPIVersion,
+		docsAddAPIProvider)
+	doc, err := impl.AddAPIDocument(accessToken, docsAddEnvironment, apiId, &docsAddDoc)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while adding the document", err)
+	}
+	if content != "" && docsAddDoc.SourceType != utils.DocSourceTypeURL {
+		if err = impl.SetAPIDocumentContent(accessToken, docsAddEnvironment, apiId, doc, content); err != nil {
+			utils.HandleErrorAndExit("Error while adding the content of the document", err)
+		}
+	}
+	fmt.Println("Document " + doc.Name + " added successfully. ID: " + doc.DocumentID)
+}
+
+func init() {
+	DocsCmd.AddCommand(DocsAddCmd)
+	addDocsCommonFlags(DocsAddCmd, &docsAddAPIName, &docsAddAPIVersion, &docsAddAPIProvider, &docsAddEnvironment)
+	addDocFlags(DocsAddCmd, &docsAddDoc, &docsAddContent, &docsAddContentFile)
+}
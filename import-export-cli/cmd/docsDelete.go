@@ -0,0 +1,69 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var docsDeleteAPIName string
+var docsDeleteAPIVersion string
+var docsDeleteAPIProvider string
+var docsDeleteEnvironment string
+var docsDeleteDocName string
+
+// docs delete command related usage Info
+const docsDeleteCmdLiteral = "delete"
+const docsDeleteCmdShortDesc = "Delete a document of an API"
+const docsDeleteCmdLongDesc = `Delete a document of an API in the environment specified by the flag (--environment, -e)`
+const docsDeleteCmdExamples = utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsDeleteCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide
+NOTE: The 4 flags (--name (-n), --version (-v), --environment (-e) and --doc-name) are mandatory.`
+
+// DocsDeleteCmd represents the docs delete command
+var DocsDeleteCmd = &cobra.Command{
+	Use:     docsDeleteCmdLiteral,
+	Short:   docsDeleteCmdShortDesc,
+	Long:    docsDeleteCmdLongDesc,
+	Example: docsDeleteCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + docsCmdLiteral + " " + docsDeleteCmdLiteral + " called")
+		accessToken, apiId := getPublisherAccessTokenAndApiId(docsDeleteEnvironment, docsDeleteAPIName,
+			docsDeleteAPIVersion, docsDeleteAPIProvider)
+		doc, err := impl.GetAPIDocumentByName(accessToken, docsDeleteEnvironment, apiId, docsDeleteDocName)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while retrieving the document", err)
+		}
+		if err = impl.DeleteAPIDocument(accessToken, docsDeleteEnvironment, apiId, doc.DocumentID); err != nil {
+			utils.HandleErrorAndExit("Error while deleting the document", err)
+		}
+		fmt.Println("Document " + doc.Name + " deleted successfully")
+	},
+}
+
+func init() {
+	DocsCmd.AddCommand(DocsDeleteCmd)
+	addDocsCommonFlags(DocsDeleteCmd, &docsDeleteAPIName, &docsDeleteAPIVersion, &docsDeleteAPIProvider,
+		&docsDeleteEnvironment)
+	DocsDeleteCmd.Flags().StringVarP(&docsDeleteDocName, "doc-name", "", "", "Name of the document to be deleted")
+	_ = DocsDeleteCmd.MarkFlagRequired("doc-name")
+}
@@ -0,0 +1,65 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var docsListAPIName string
+var docsListAPIVersion string
+var docsListAPIProvider string
+var docsListEnvironment string
+var docsListFormat string
+
+// docs list command related usage Info
+const docsListCmdLiteral = "list"
+const docsListCmdShortDesc = "List the documents of an API"
+const docsListCmdLongDesc = `List the documents of an API in the environment specified by the flag (--environment, -e)`
+const docsListCmdExamples = utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsListCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsListCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -r admin -e dev --format "{{.Name}}"
+NOTE: The 3 flags (--name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// DocsListCmd represents the docs list command
+var DocsListCmd = &cobra.Command{
+	Use:     docsListCmdLiteral,
+	Short:   docsListCmdShortDesc,
+	Long:    docsListCmdLongDesc,
+	Example: docsListCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + docsCmdLiteral + " " + docsListCmdLiteral + " called")
+		accessToken, apiId := getPublisherAccessTokenAndApiId(docsListEnvironment, docsListAPIName,
+			docsListAPIVersion, docsListAPIProvider)
+		docs, err := impl.GetAPIDocuments(accessToken, docsListEnvironment, apiId)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while retrieving the documents", err)
+		}
+		impl.PrintDocuments(docs, docsListFormat)
+	},
+}
+
+func init() {
+	DocsCmd.AddCommand(DocsListCmd)
+	addDocsCommonFlags(DocsListCmd, &docsListAPIName, &docsListAPIVersion, &docsListAPIProvider,
+		&docsListEnvironment)
+	DocsListCmd.Flags().StringVarP(&docsListFormat, "format", "", "", "Pretty-print documents "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+}
@@ -0,0 +1,71 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var docsSyncProjectPath string
+var docsSyncEnvironment string
+var docsSyncPrune bool
+
+// docs sync command related usage Info
+const docsSyncCmdLiteral = "sync"
+const docsSyncCmdShortDesc = "Sync the documents of an API project"
+const docsSyncCmdLongDesc = `Sync the documents in the Docs directory of an API project (Docs/docs.yaml, Docs/InlineContents
+and Docs/FileContents) to the API in the environment specified by the flag (--environment, -e) without re-importing the
+API. Documents which are not in the API are added and the changed documents are updated. Documents which are not in the
+project are deleted only if --prune is specified`
+const docsSyncCmdExamples = utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsSyncCmdLiteral + ` -f ~/PizzaShackAPI -e dev
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsSyncCmdLiteral + ` -f ~/PizzaShackAPI.zip -e dev --prune
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// DocsSyncCmd represents the docs sync command
+var DocsSyncCmd = &cobra.Command{
+	Use:     docsSyncCmdLiteral,
+	Short:   docsSyncCmdShortDesc,
+	Long:    docsSyncCmdLongDesc,
+	Example: docsSyncCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + docsCmdLiteral + " " + docsSyncCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(docsSyncEnvironment)
+		result, err := impl.SyncAPIDocuments(accessToken, docsSyncEnvironment, docsSyncProjectPath, docsSyncPrune)
+		if result != nil {
+			impl.PrintDocsSyncResult(result)
+		}
+		if err != nil {
+			utils.HandleErrorAndExit("Error while syncing the documents", err)
+		}
+		impl.PrintDocsSyncSummary(result)
+	},
+}
+
+func init() {
+	DocsCmd.AddCommand(DocsSyncCmd)
+	DocsSyncCmd.Flags().StringVarP(&docsSyncProjectPath, "file", "f", "", "Path to the API project directory "+
+		"or archive")
+	DocsSyncCmd.Flags().StringVarP(&docsSyncEnvironment, "environment", "e", "", "Environment of the API")
+	DocsSyncCmd.Flags().BoolVarP(&docsSyncPrune, "prune", "", false, "Delete the documents of the API which are "+
+		"not in the project")
+	_ = DocsSyncCmd.MarkFlagRequired("file")
+	_ = DocsSyncCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,103 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var docsUpdateAPIName string
+var docsUpdateAPIVersion string
+var docsUpdateAPIProvider string
+var docsUpdateEnvironment string
+var docsUpdateDoc utils.Document
+var docsUpdateContent string
+var docsUpdateContentFile string
+
+// docs update command related usage Info
+const docsUpdateCmdLiteral = "update"
+const docsUpdateCmdShortDesc = "Update a document of an API"
+const docsUpdateCmdLongDesc = `Update the details and the content of a document of an API in the environment specified by
+the flag (--environment, -e). Only the details given as flags are changed`
+const docsUpdateCmdExamples = utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsUpdateCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide --summary "Getting started"
+` + utils.ProjectName + ` ` + docsCmdLiteral + ` ` + docsUpdateCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name Guide --content-file guide.md
+NOTE: The 4 flags (--name (-n), --version (-v), --environment (-e) and --doc-name) are mandatory.`
+
+// DocsUpdateCmd represents the docs update command
+var DocsUpdateCmd = &cobra.Command{
+	Use:     docsUpdateCmdLiteral,
+	Short:   docsUpdateCmdShortDesc,
+	Long:    docsUpdateCmdLongDesc,
+	Example: docsUpdateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + docsCmdLiteral + " " + docsUpdateCmdLiteral + " called")
+		executeDocsUpdateCmd(cmd)
+	},
+}
+
+// executeDocsUpdateCmd executes the docs update command
+func executeDocsUpdateCmd(cmd *cobra.Command) {
+	accessToken, apiId := getPublisherAccessTokenAndApiId(docsUpdateEnvironment, docsUpdateAPIName,
+		docsUpdateAPIVersion, docsUpdateAPIProvider)
+	doc, err := impl.GetAPIDocumentByName(accessToken, docsUpdateEnvironment, apiId, docsUpdateDoc.Name)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while retrieving the document", err)
+	}
+
+	// Only the details given as flags are changed
+	changedFields := map[string]*string{
+		"type":            &doc.Type,
+		"other-type-name": &doc.OtherTypeName,
+		"source-type":     &doc.SourceType,
+		"summary":         &doc.Summary,
+		"visibility":      &doc.Visibility,
+		"source-url":      &doc.SourceURL,
+	}
+	for flagName, field := range changedFields {
+		if cmd.Flags().Changed(flagName) {
+			*field = cmd.Flags().Lookup(flagName).Value.String()
+		}
+	}
+	if err = impl.ValidateDocument(doc); err != nil {
+		utils.HandleErrorAndExit("Invalid document", err)
+	}
+	doc, err = impl.UpdateAPIDocument(accessToken, docsUpdateEnvironment, apiId, doc)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while updating the document", err)
+	}
+
+	content := readDocContent(doc.SourceType, docsUpdateContent, docsUpdateContentFile)
+	if content != "" && doc.SourceType != utils.DocSourceTypeURL {
+		if err = impl.SetAPIDocumentContent(accessToken, docsUpdateEnvironment, apiId, doc, content); err != nil {
+			utils.HandleErrorAndExit("Error while updating the content of the document", err)
+		}
+	}
+	fmt.Println("Document " + doc.Name + " updated successfully")
+}
+
+func init() {
+	DocsCmd.AddCommand(DocsUpdateCmd)
+	addDocsCommonFlags(DocsUpdateCmd, &docsUpdateAPIName, &docsUpdateAPIVersion, &docsUpdateAPIProvider,
+		&docsUpdateEnvironment)
+	addDocFlags(DocsUpdateCmd, &docsUpdateDoc, &docsUpdateContent, &docsUpdateContentFile)
+}
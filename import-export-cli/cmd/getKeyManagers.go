@@ -0,0 +1,80 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getKeyManagersCmdEnvironment string
+var getKeyManagersCmdFormat string
+
+// GetKeyManagersCmd related info
+const GetKeyManagersCmdLiteral = "key-managers"
+const getKeyManagersCmdShortDesc = "Display a list of key managers in an environment"
+const getKeyManagersCmdLongDesc = `Display a list of key managers available in the DevPortal of the environment specified
+by the flag --environment, -e`
+const getKeyManagersCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetKeyManagersCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetKeyManagersCmdLiteral + ` -e dev --format "{{.Name}}"
+NOTE: The flag (--environment (-e)) is mandatory`
+
+// getKeyManagersCmd represents the key-managers command
+var getKeyManagersCmd = &cobra.Command{
+	Use:     GetKeyManagersCmdLiteral,
+	Short:   getKeyManagersCmdShortDesc,
+	Long:    getKeyManagersCmdLongDesc,
+	Example: getKeyManagersCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetKeyManagersCmdLiteral + " called")
+		cred, err := GetCredentials(getKeyManagersCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeGetKeyManagersCmd(cred)
+	},
+}
+
+func executeGetKeyManagersCmd(credential credentials.Credential) {
+	var err error
+	credential.ClientId, credential.ClientSecret, err = impl.CallDCREndpoint(credential, getKeyManagersCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Internal error occurred", err)
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(credential, getKeyManagersCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error calling '"+GetKeyManagersCmdLiteral+"'", err)
+	}
+	keyManagers, err := impl.GetKeyManagerListFromEnv(accessToken, getKeyManagersCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while getting the list of key managers", err)
+	}
+	impl.PrintKeyManagers(keyManagers, getKeyManagersCmdFormat)
+}
+
+func init() {
+	GetCmd.AddCommand(getKeyManagersCmd)
+	getKeyManagersCmd.Flags().StringVarP(&getKeyManagersCmdEnvironment, "environment", "e",
+		"", "Environment to be searched")
+	getKeyManagersCmd.Flags().StringVarP(&getKeyManagersCmdFormat, "format", "", "", "Pretty-print output"+
+		"using Go templates. Use \"{{jsonPretty .}}\" to list all fields")
+	_ = getKeyManagersCmd.MarkFlagRequired("environment")
+}
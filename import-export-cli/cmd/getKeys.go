@@ -27,8 +27,11 @@ import (
 // keys command related Info
 const GetKeysCmdLiteral = "keys"
 const getKeysCmdShortDesc = "Generate access token to invoke the API or API Product"
-const getKeysCmdLongDesc = `Generate JWT token to invoke the API or API Product by subscribing to a default application for testing purposes`
+const getKeysCmdLongDesc = `Generate JWT token to invoke the API or API Product by subscribing to a default application for testing purposes.
+The application, subscription tier, requested scopes, token validity period and token type can be customized using flags`
 const getKeysCmdExamples = utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --provider admin
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --app TestApp --tier Gold --scopes read_tweets
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --validity-period 300 --token-type OAUTH -o json
 NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.
 You can override the default token endpoint using --token (-t) optional flag providing a new token endpoint`
 
@@ -37,6 +40,12 @@ var apiName string
 var apiVersion string
 var apiProvider string
 var keyGenTokenEndpoint string
+var keyGenAppName string
+var keyGenSubscriptionTier string
+var keyGenScopes []string
+var keyGenValidityPeriod int
+var keyGenTokenType string
+var keyGenOutputFormat string
 
 var getKeysCmd = &cobra.Command{
 	Use:     GetKeysCmdLiteral,
@@ -57,7 +66,15 @@ var getKeysCmd = &cobra.Command{
 			utils.HandleErrorAndExit("Internal error occurred", err)
 		}
 		utils.Logln(utils.LogPrefixInfo + "Called DCR endpoint successfully")
-		impl.GetKeys(cred, keyGenEnv, apiName, apiVersion, apiProvider, keyGenTokenEndpoint)
+		impl.GetKeysWithOptions(cred, keyGenEnv, apiName, apiVersion, apiProvider, keyGenTokenEndpoint,
+			impl.KeyGenOptions{
+				AppName:          keyGenAppName,
+				SubscriptionTier: keyGenSubscriptionTier,
+				Scopes:           keyGenScopes,
+				ValidityPeriod:   keyGenValidityPeriod,
+				TokenType:        keyGenTokenType,
+				OutputFormat:     keyGenOutputFormat,
+			})
 	},
 }
 
@@ -69,6 +86,18 @@ func init() {
 	getKeysCmd.Flags().StringVarP(&apiVersion, "version", "v", "", "Version of the API")
 	getKeysCmd.Flags().StringVarP(&apiProvider, "provider", "r", "", "Provider of the API or API Product")
 	getKeysCmd.Flags().StringVarP(&keyGenTokenEndpoint, "token", "t", "", "Token endpoint URL of Environment")
+	getKeysCmd.Flags().StringVarP(&keyGenAppName, "app", "", utils.DefaultCliApp,
+		"Application to subscribe to the API or API Product")
+	getKeysCmd.Flags().StringVarP(&keyGenSubscriptionTier, "tier", "", "",
+		"Subscription tier to use. The first available tier is used if not specified")
+	getKeysCmd.Flags().StringSliceVarP(&keyGenScopes, "scopes", "", []string{},
+		"Scopes to request. All the subscribed scopes are requested if not specified")
+	getKeysCmd.Flags().IntVarP(&keyGenValidityPeriod, "validity-period", "", utils.DefaultTokenValidityPeriod,
+		"Validity period of the access token in seconds")
+	getKeysCmd.Flags().StringVarP(&keyGenTokenType, "token-type", "", "",
+		"Token type of the application (JWT or OAUTH). Defaults to the token type in the main config")
+	getKeysCmd.Flags().StringVarP(&keyGenOutputFormat, "output", "o", "",
+		"Output format of the token. Use \"json\" to print the token with its expiry")
 	_ = getKeysCmd.MarkFlagRequired("name")
 	_ = getKeysCmd.MarkFlagRequired("environment")
 }
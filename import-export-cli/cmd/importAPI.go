@@ -19,6 +19,8 @@
 package cmd
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
@@ -32,6 +34,9 @@ var (
 	importAPIUpdate              bool
 	importAPIParamsFile          string
 	importAPISkipCleanup         bool
+	importAPIRunTests            bool
+	importAPITestOptions         impl.ApiTestOptions
+	importAPITestReportFile      string
 )
 
 const (
@@ -44,7 +49,7 @@ const (
 const importAPICmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f qa/TwitterAPI.zip -e dev
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f staging/FacebookAPI.zip -e production
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f ~/myapi -e production --update
-` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f ~/myapi -e production --update
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f ~/myapi -e production --update --run-tests --junit-report report.xml
 NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory`
 
 // ImportAPICmd represents the importAPI command
@@ -70,6 +75,10 @@ var ImportAPICmd = &cobra.Command{
 			utils.HandleErrorAndExit("Error importing API", err)
 			return
 		}
+		if importAPIRunTests && !runApiTests(cred, importEnvironment, []string{importAPIFile}, importAPITestOptions,
+			importAPITestReportFile) {
+			os.Exit(1)
+		}
 	},
 }
 
@@ -88,6 +97,9 @@ func init() {
 		"Provide a API Manager params file")
 	ImportAPICmd.Flags().BoolVarP(&importAPISkipCleanup, "skipCleanup", "", false, "Leave "+
 		"all temporary files created during import process")
+	ImportAPICmd.Flags().BoolVarP(&importAPIRunTests, "run-tests", "", false, "Run smoke tests generated "+
+		"from the OpenAPI definition against the imported API")
+	addApiTestFlags(ImportAPICmd, &importAPITestOptions, &importAPITestReportFile)
 	// Mark required flags
 	_ = ImportAPICmd.MarkFlagRequired("environment")
 	_ = ImportAPICmd.MarkFlagRequired("file")
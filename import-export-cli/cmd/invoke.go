@@ -0,0 +1,47 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Invoke command related usage Info
+const invokeCmdLiteral = "invoke"
+const invokeCmdShortDesc = "Invoke an API through the gateway"
+const invokeCmdLongDesc = `Invoke a resource of an API through the gateway of the environment specified by the flag
+(--environment, -e) using an access token generated for the API`
+const invokeCmdExamples = utils.ProjectName + ` ` + invokeCmdLiteral + ` ` + invokeAPICmdLiteral + ` -n PetstoreAPI -v 1.0.0 -e dev --resource GET:/pets/{petId}`
+
+// InvokeCmd represents the invoke command
+var InvokeCmd = &cobra.Command{
+	Use:     invokeCmdLiteral,
+	Short:   invokeCmdShortDesc,
+	Long:    invokeCmdLongDesc,
+	Example: invokeCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + invokeCmdLiteral + " called")
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(InvokeCmd)
+}
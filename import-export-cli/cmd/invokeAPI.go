@@ -0,0 +1,174 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var invokeAPIName string
+var invokeAPIVersion string
+var invokeAPIProvider string
+var invokeAPIEnvironment string
+var invokeAPIResource string
+var invokeAPIPathParams map[string]string
+var invokeAPIQueryParams map[string]string
+var invokeAPIHeaders map[string]string
+var invokeAPIBody string
+var invokeAPIBodyFile string
+var invokeAPIGatewayEnvironment string
+var invokeAPIUseHTTP bool
+var invokeAPIAccessToken string
+var invokeAPIAppName string
+
+// InvokeAPI command related usage Info
+const invokeAPICmdLiteral = "api"
+const invokeAPICmdShortDesc = "Invoke a resource of an API"
+const invokeAPICmdLongDesc = `Invoke a resource of an API through the gateway. The gateway URL is resolved from the
+gateway environments the API is deployed in, an access token is generated by subscribing to an application as in
+"get keys", and the request is built using the examples in the API definition. Path parameters, query parameters,
+headers and the body can be overridden using flags. Exits with a non-zero status if the API responds with an error`
+const invokeAPICmdExamples = utils.ProjectName + ` ` + invokeCmdLiteral + ` ` + invokeAPICmdLiteral + ` -n PetstoreAPI -v 1.0.0 -e dev --resource GET:/pets/{petId}
+` + utils.ProjectName + ` ` + invokeCmdLiteral + ` ` + invokeAPICmdLiteral + ` -n PetstoreAPI -v 1.0.0 -e dev --resource GET:/pets/{petId} --path-param petId=2
+` + utils.ProjectName + ` ` + invokeCmdLiteral + ` ` + invokeAPICmdLiteral + ` -n PetstoreAPI -v 1.0.0 -e dev --resource GET:/pets --query-param status=available --gateway-env Production
+` + utils.ProjectName + ` ` + invokeCmdLiteral + ` ` + invokeAPICmdLiteral + ` -n PetstoreAPI -v 1.0.0 -e dev --resource POST:/pets --body-file pet.json --header X-Request-Id=1234
+NOTE: The flags (--name (-n), --environment (-e) and --resource) are mandatory.`
+
+// InvokeAPICmd represents the invoke api command
+var InvokeAPICmd = &cobra.Command{
+	Use:     invokeAPICmdLiteral,
+	Short:   invokeAPICmdShortDesc,
+	Long:    invokeAPICmdLongDesc,
+	Example: invokeAPICmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + invokeCmdLiteral + " " + invokeAPICmdLiteral + " called")
+		cred, err := GetCredentials(invokeAPIEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeInvokeAPICmd(cred)
+	},
+}
+
+// executeInvokeAPICmd executes the invoke api command
+func executeInvokeAPICmd(credential credentials.Credential) {
+	method, path, err := impl.ParseResource(invokeAPIResource)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid resource", err)
+	}
+	body := invokeAPIBody
+	if invokeAPIBodyFile != "" {
+		content, err := ioutil.ReadFile(invokeAPIBodyFile)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the body file", err)
+		}
+		body = string(content)
+	}
+
+	// Calling the DCR endpoint to get the credentials of the env
+	credential.ClientId, credential.ClientSecret, err = impl.CallDCREndpoint(credential, invokeAPIEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Internal error occurred", err)
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(credential, invokeAPIEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens", err)
+	}
+	apiId, err := impl.GetApiOrProductId(accessToken, invokeAPIEnvironment, invokeAPIName, invokeAPIVersion,
+		invokeAPIProvider)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while searching the API", err)
+	}
+	api, err := impl.GetDevPortalAPI(accessToken, invokeAPIEnvironment, apiId)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while retrieving the API", err)
+	}
+	gatewayURL, err := impl.ResolveGatewayURL(api, invokeAPIGatewayEnvironment, invokeAPIUseHTTP)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while resolving the gateway URL", err)
+	}
+	definition, err := impl.GetDevPortalAPIDefinition(accessToken, invokeAPIEnvironment, apiId)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while retrieving the API definition", err)
+	}
+	swagger, err := impl.LoadOpenAPIDefinition(definition)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while reading the API definition", err)
+	}
+	request, err := impl.BuildApiRequest(swagger, method, path, impl.ApiRequestOverrides{
+		PathParams:  invokeAPIPathParams,
+		QueryParams: invokeAPIQueryParams,
+		Headers:     invokeAPIHeaders,
+		Body:        body,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error while building the request", err)
+	}
+
+	apiAccessToken := invokeAPIAccessToken
+	if apiAccessToken == "" {
+		token := impl.GenerateAccessToken(credential, invokeAPIEnvironment, invokeAPIName, invokeAPIVersion,
+			invokeAPIProvider, "", impl.KeyGenOptions{AppName: invokeAPIAppName})
+		apiAccessToken = token.AccessToken
+	}
+	resp, err := impl.InvokeApiResource(gatewayURL, request, apiAccessToken)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while invoking the API", err)
+	}
+	impl.PrintApiInvocationResponse(resp)
+	if resp.StatusCode() >= 400 {
+		os.Exit(1)
+	}
+}
+
+// init using Cobra
+func init() {
+	InvokeCmd.AddCommand(InvokeAPICmd)
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIName, "name", "n", "", "Name of the API to be invoked")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIVersion, "version", "v", "", "Version of the API to be invoked")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIProvider, "provider", "r", "", "Provider of the API to be invoked")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIEnvironment, "environment", "e", "",
+		"Environment of the API to be invoked")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIResource, "resource", "", "",
+		"Resource to be invoked in the format <METHOD>:<path>")
+	InvokeAPICmd.Flags().StringToStringVarP(&invokeAPIPathParams, "path-param", "", map[string]string{},
+		"Values of the path parameters in the format <name>=<value>")
+	InvokeAPICmd.Flags().StringToStringVarP(&invokeAPIQueryParams, "query-param", "", map[string]string{},
+		"Values of the query parameters in the format <name>=<value>")
+	InvokeAPICmd.Flags().StringToStringVarP(&invokeAPIHeaders, "header", "", map[string]string{},
+		"Request headers in the format <name>=<value>")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIBody, "body", "", "", "Request body")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIBodyFile, "body-file", "", "", "File containing the request body")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIGatewayEnvironment, "gateway-env", "", "",
+		"Gateway environment to invoke the API. The first gateway environment of the API is used if not specified")
+	InvokeAPICmd.Flags().BoolVarP(&invokeAPIUseHTTP, "http", "", false, "Invoke the API using the HTTP gateway URL")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIAccessToken, "token", "t", "",
+		"Access token to invoke the API. A new token is generated if not specified")
+	InvokeAPICmd.Flags().StringVarP(&invokeAPIAppName, "app", "", utils.DefaultCliApp,
+		"Application to subscribe to the API when generating the access token")
+	_ = InvokeAPICmd.MarkFlagRequired("name")
+	_ = InvokeAPICmd.MarkFlagRequired("environment")
+	_ = InvokeAPICmd.MarkFlagRequired("resource")
+}
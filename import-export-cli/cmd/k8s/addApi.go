@@ -19,30 +19,43 @@
 package k8s
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
-	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
 	wso2v1alpha2 "github.com/wso2/k8s-api-operator/api-operator/pkg/apis/wso2/v1alpha2"
-	"github.com/wso2/product-apim-tooling/import-export-cli/box"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var flagApiName string
 var flagSwaggerFilePath string
+var flagProjectPath string
+var flagParamsPath string
+var flagParamsEnvironment string
 var flagReplicas int
+var flagReplicasChanged bool // replicas are given with the flag instead of the default
 var flagNamespace string
 var flagApiVersion string
 var flagApiMode string
+var flagEnv []string
+var flagImage string
+var flagOverride bool
+var flagApiEndPoint string
+var flagHostname string
+var flagBalInterceptors []string
+var flagJavaInterceptors []string
+var flagOverlayFile string
+var flagDryRun bool
+var flagWatch bool
+var flagWatchTimeout int
+var flagOutputFormat string
+var flagOutputDir string
 
 const AddApiCmdLiteral = "api"
 const addApiCmdShortDesc = "Handle APIs in kubernetes cluster "
@@ -50,7 +63,19 @@ const addApiLongDesc = `Add, Update and Delete APIs in kubernetes cluster. JSON
 available modes are as follows
 * kubernetes`
 const addApiExamples = utils.ProjectName + " " + K8sCmdLiteral + " add/update " + AddApiCmdLiteral +
-	` -n petstore --from-file=./Swagger.json --replicas=3 --namespace=wso2`
+	` -n petstore --from-file=./Swagger.json --replicas=3 --namespace=wso2
+` + utils.ProjectName + " " + K8sCmdLiteral + " add " + AddApiCmdLiteral +
+	` -n petstore --from-file=./PetstoreAPI --namespace=wso2 --mode=sidecar --hostname=petstore.wso2.com -e LOG_LEVEL=DEBUG
+` + utils.ProjectName + " " + K8sCmdLiteral + " add " + AddApiCmdLiteral +
+	` -n petstore --from-project=./PetstoreAPI --namespace=wso2 --params=./api_params.yaml --params-environment=production
+` + utils.ProjectName + " " + K8sCmdLiteral + " add/update " + AddApiCmdLiteral +
+	` --from-dir=./apis --manifest=./apis/manifest.yaml --namespace=wso2 --parallel=4
+` + utils.ProjectName + " " + K8sCmdLiteral + " add " + AddApiCmdLiteral +
+	` -n petstore --from-file=./PetstoreAPI --namespace=wso2 --overlay=./petstore-overlay.yaml
+` + utils.ProjectName + " " + K8sCmdLiteral + " add " + AddApiCmdLiteral +
+	` -n petstore --from-file=./PetstoreAPI --namespace=wso2 --dry-run -o yaml > petstore.yaml
+` + utils.ProjectName + " " + K8sCmdLiteral + " add " + AddApiCmdLiteral +
+	` -n petstore --from-file=./PetstoreAPI --namespace=wso2 --dry-run --output-dir=./manifests`
 
 // addApiCmd represents the api command
 var addApiCmd = &cobra.Command{
@@ -60,6 +85,7 @@ var addApiCmd = &cobra.Command{
 	Example: addApiExamples,
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + AddApiCmdLiteral + " called")
+		flagReplicasChanged = cmd.Flags().Changed("replicas")
 		handleAddApi("")
 	},
 }
@@ -67,155 +93,348 @@ var addApiCmd = &cobra.Command{
 func handleAddApi(nameSuffix string) {
 	validateAddApiCommand()
 
+	if flagDirPath != "" {
+		handleAddApiBatch(nameSuffix)
+		return
+	}
+
+	sourcePath := flagSwaggerFilePath
+	if flagProjectPath != "" {
+		sourcePath = flagProjectPath
+	}
 	// log processing only if there are more projects
-	utils.Logln(fmt.Sprintf("%sProcessing swagger  %v", utils.LogPrefixInfo, flagSwaggerFilePath))
+	utils.Logln(fmt.Sprintf("%sProcessing swagger  %v", utils.LogPrefixInfo, sourcePath))
 
 	flagApiName = strings.ToLower(flagApiName)
-	swaggerCmName := fmt.Sprintf("%v-swagger%s", flagApiName, nameSuffix)
-
-	fi, _ := os.Stat(flagSwaggerFilePath) // error already handled and ignore error
-	switch mode := fi.Mode(); {
-	//check if the swagger path is a Dir
-	case mode.IsDir():
-		//get swagger definition
-		swaggerPath := filepath.Join(flagSwaggerFilePath, filepath.FromSlash("Meta-information/swagger.yaml"))
-		//creating kubernetes configmap with swagger definition
-		fmt.Println("creating configmap with swagger definition")
-		errConf := createConfigMapWithNamespace(swaggerCmName, swaggerPath, flagNamespace, k8sUtils.K8sCreate)
-		if errConf != nil {
-			utils.HandleErrorAndExit("Error creating configmap", errConf)
-		}
+	manifests, err := renderApiManifests(flagApiName, flagNamespace, sourcePath, flagProjectPath, nameSuffix, 0)
+	if err != nil {
+		utils.HandleErrorAndExit("Error rendering kubernetes resources of the API", err)
+	}
 
-	//check if the swagger path is a file
-	case mode.IsRegular():
-		//creating kubernetes configmap with swagger definition
-		fmt.Println("creating configmap with swagger definition")
-		errConf := createConfigMapWithNamespace(swaggerCmName, flagSwaggerFilePath, flagNamespace,
-			k8sUtils.K8sCreate)
-		if errConf != nil {
-			utils.HandleErrorAndExit("Error creating configmap", errConf)
+	if flagDryRun {
+		err = k8sUtils.WriteManifests(manifests.Objects(), flagOutputFormat, flagOutputDir, os.Stdout)
+		if err != nil {
+			utils.HandleErrorAndExit("Error writing kubernetes resources of the API", err)
+		}
+		if flagOutputDir != "" {
+			fmt.Println("Kubernetes resources of the API written to " + flagOutputDir)
 		}
+		return
+	}
+
+	//creating kubernetes configmaps and secrets with swagger definition, interceptors, certificates, docs and the
+	//archive of the project
+	fmt.Println("creating configs of the API")
+	if err = createApiConfigs(manifests, os.Stdout); err != nil {
+		utils.HandleErrorAndExit("Error creating configs of the API", err)
+	}
+
+	// generation of the existing deployment to wait for the updated deployment
+	var sinceGeneration int64
+	if flagWatch && nameSuffix != "" {
+		sinceGeneration = getApiDeploymentGeneration()
 	}
 
 	//create API
 	fmt.Println("creating API definition")
-	createAPI(swaggerCmName, nameSuffix)
+	err = createAPI(manifests, nameSuffix != "", os.Stdout)
+	if err != nil {
+		utils.HandleErrorAndExit("Error configuring API", err)
+	}
+	if flagWatch {
+		client, err := k8sUtils.GetK8sClient()
+		if err != nil {
+			utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+		}
+		watchApiRollout(client, flagNamespace, flagApiName, sinceGeneration, flagWatchTimeout)
+	}
 }
 
-// validateAddApiCommand validates for required flags and if invalid print error and exit
-func validateAddApiCommand() {
-	// validate --from-file flag values
-	if _, err := os.Stat(flagSwaggerFilePath); err != nil {
-		utils.HandleErrorAndExit("swagger file path or project not found", err)
+// getApiDeploymentGeneration returns the generation of the deployment of the API or 0 if not found
+func getApiDeploymentGeneration() int64 {
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
 	}
+	status, err := k8sUtils.GetApiStatus(client, flagNamespace, flagApiName)
+	if err != nil || status.Deployment == nil {
+		return 0
+	}
+	return status.Deployment.Generation
 }
 
-//create configmap with swagger definition
-func createConfigMapWithNamespace(configMapName, filePath, namespace, operation string) error {
-	cmd := exec.Command(
-		k8sUtils.Kubectl,
-		operation,
-		"configmap",
-		configMapName,
-		"--from-file",
-		filePath,
-		"-n", namespace,
-	)
-	//print kubernetes error commands
-	var errBuf, outBuf bytes.Buffer
-	cmd.Stderr = io.MultiWriter(os.Stderr, &errBuf)
-	cmd.Stdout = io.MultiWriter(os.Stdout, &outBuf)
-	err := cmd.Run()
+// renderApiManifests renders the kubernetes resources of an API with the fields set with the flags
+// @param apiName : Name of the API
+// @param namespace : Namespace of the API
+// @param sourcePath : Path to the swagger file or the apictl project
+// @param projectPath : Path to the apictl project to be added as an archive. Not added if empty
+// @param nameSuffix : Suffix of the configs and the update timestamp of the API
+// @param replicas : Replicas of the API. Overrides the flags if greater than 0
+// @return manifests, error
+func renderApiManifests(apiName, namespace, sourcePath, projectPath, nameSuffix string,
+	replicas int) (*k8sUtils.ApiManifests, error) {
+	manifests, err := k8sUtils.NewApiManifests(apiName, namespace, sourcePath, nameSuffix)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if projectPath != "" {
+		if err = setProjectArchive(manifests, projectPath); err != nil {
+			return nil, err
+		}
+	}
+	if nameSuffix != "" {
+		//set update timestamp
+		manifests.Api.Spec.UpdateTimeStamp = nameSuffix
+	}
+	if err = setApiCrFields(manifests); err != nil {
+		return nil, err
+	}
+	if replicas > 0 {
+		manifests.Api.Spec.Replicas = replicas
+	}
+	if err = k8sUtils.ValidateApi(manifests.Api); err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+// setProjectArchive adds the archive of an apictl project to the swagger config map. Configs of the environment
+// given with --params-environment are added to the archive from the params file. Docs are left out of the archive
+// as they are in the docs config map
+func setProjectArchive(manifests *k8sUtils.ApiManifests, projectPath string) error {
+	paramsPath := ""
+	if flagParamsEnvironment != "" {
+		paramsPath = flagParamsPath
+	}
+	projectClone, err := utils.GetTempCloneFromDirOrZip(projectPath)
+	if err != nil {
+		return fmt.Errorf("error creating the archive of the project: %v", err)
+	}
+	defer os.RemoveAll(filepath.Dir(projectClone))
+	for _, dir := range k8sUtils.ProjectDocsDirs {
+		if err = os.RemoveAll(filepath.Join(projectClone, dir)); err != nil {
+			return fmt.Errorf("error creating the archive of the project: %v", err)
+		}
+	}
+	archivePath, cleanup, err := impl.CreateAPIProjectArchive(projectClone, paramsPath, flagParamsEnvironment, false)
+	if err != nil {
+		return fmt.Errorf("error creating the archive of the project: %v", err)
+	}
+	defer cleanup()
+
+	if err = manifests.SetProjectArchive(archivePath); err != nil {
+		return fmt.Errorf("error adding the project to the swagger config map: %v", err)
 	}
 	return nil
 }
 
-func createAPI(configMapName, timestamp string) {
-	//get API definition from file
-	apiConfigMapData, _ := box.Get("/kubernetes_resources/api_cr.yaml")
-	apiCrd := &wso2v1alpha2.API{}
-	errUnmarshal := yaml.Unmarshal(apiConfigMapData, apiCrd)
-	if errUnmarshal != nil {
-		utils.HandleErrorAndExit("Error unmarshal api configmap into struct ", errUnmarshal)
-	}
-	//assigning values to API cr
-	apiCrd.Name = flagApiName
-	apiCrd.Namespace = flagNamespace
-	apiCrd.Spec.SwaggerConfigMapName = configMapName
-
-	k8sOperation := k8sUtils.K8sCreate
-	k8sSaveConfig := true
-	if timestamp != "" {
-		//set update timestamp
-		apiCrd.Spec.UpdateTimeStamp = timestamp
-		k8sOperation = k8sUtils.K8sApply
-		k8sSaveConfig = false
+// validateAddApiCommand validates for required flags and if invalid print error and exit
+func validateAddApiCommand() {
+	sources := 0
+	for _, source := range []string{flagSwaggerFilePath, flagProjectPath, flagDirPath} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		utils.HandleErrorAndExit("either --from-file, --from-project or --from-dir should be specified", nil)
 	}
+	if flagDirPath != "" {
+		// validate --from-dir flag values
+		validateAddApiBatchFlags()
+	} else if flagApiName == "" {
+		utils.HandleErrorAndExit("required flag \"name\" not set", nil)
+	} else if flagProjectPath != "" {
+		// validate --from-project flag values
+		if _, err := os.Stat(filepath.Join(flagProjectPath, "Meta-information")); err != nil {
+			utils.HandleErrorAndExit("apictl project not found", err)
+		}
+	} else if _, err := os.Stat(flagSwaggerFilePath); err != nil {
+		// validate --from-file flag values
+		utils.HandleErrorAndExit("swagger file path or project not found", err)
+	}
+	if flagParamsEnvironment != "" && flagSwaggerFilePath != "" {
+		utils.HandleErrorAndExit("--params-environment can only be used with --from-project or --from-dir", nil)
+	}
+	// validate --output flag
+	if flagOutputFormat != k8sUtils.ManifestFormatYaml && flagOutputFormat != k8sUtils.ManifestFormatJson {
+		utils.HandleErrorAndExit(fmt.Sprintf("invalid output format. available formats: %v, %v",
+			k8sUtils.ManifestFormatYaml, k8sUtils.ManifestFormatJson), nil)
+	}
+}
 
+// setApiCrFields sets the fields of the API custom resource from the overlay file and the flags.
+// Flags override the values of the overlay file
+func setApiCrFields(manifests *k8sUtils.ApiManifests) error {
+	if flagOverlayFile != "" {
+		overlay, err := ioutil.ReadFile(flagOverlayFile)
+		if err != nil {
+			return fmt.Errorf("error reading API overlay file: %v", err)
+		}
+		if err = manifests.ApplyOverlay(overlay); err != nil {
+			return fmt.Errorf("error applying API overlay file: %v", err)
+		}
+	}
 
-	byteVal, errMarshal := yaml.Marshal(apiCrd)
-	if errMarshal != nil {
-		utils.HandleErrorAndExit("Error marshal api configmap ", errMarshal)
+	spec := &manifests.Api.Spec
+	if spec.Replicas == 0 || flagReplicasChanged {
+		// default replicas are used only if the replicas are not set in the overlay file
+		spec.Replicas = flagReplicas
 	}
-	//write configmap to a temp file
-	tmpFile, err := ioutil.TempFile(os.TempDir(), "apicr-*.yaml")
-	if err != nil {
-		log.Fatal("Cannot create temporary file", err)
+	if flagApiVersion != "" {
+		spec.Version = flagApiVersion
+	}
+	if flagApiMode != "" {
+		spec.Mode = wso2v1alpha2.Mode(flagApiMode)
+	}
+	if len(flagEnv) > 0 {
+		spec.EnvironmentVariables = append(spec.EnvironmentVariables, flagEnv...)
 	}
-	defer os.Remove(tmpFile.Name())
-	if _, err = tmpFile.Write(byteVal); err != nil {
-		log.Fatal("Failed to write to temporary file", err)
+	if flagImage != "" {
+		spec.Image = flagImage
 	}
-	// Close the file
-	if err := tmpFile.Close(); err != nil {
-		log.Fatal(err)
+	if flagOverride {
+		spec.Override = true
 	}
+	if flagApiEndPoint != "" {
+		spec.ApiEndPoint = flagApiEndPoint
+	}
+	if flagHostname != "" {
+		spec.IngressHostname = flagHostname
+	}
+	spec.Definition.Interceptors.Ballerina = append(spec.Definition.Interceptors.Ballerina, flagBalInterceptors...)
+	spec.Definition.Interceptors.Java = append(spec.Definition.Interceptors.Java, flagJavaInterceptors...)
+	return nil
+}
 
-	k8sArgs := []string{k8sOperation, "-f", tmpFile.Name(), "-n", flagNamespace}
-	if k8sSaveConfig {
-		k8sArgs = append(k8sArgs, "--save-config")
+// createApiConfigs creates the configmaps and secrets of the API. Created configs are deleted if any of them fails
+func createApiConfigs(manifests *k8sUtils.ApiManifests, out io.Writer) error {
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		return err
 	}
+	var createdConfigMaps, createdSecrets []string
+	objects := manifests.Objects()
+	for _, obj := range objects[:len(objects)-1] {
+		config, err := k8sUtils.ToUnstructured(obj)
+		if err == nil {
+			err = client.Create(config, manifests.Api.Namespace)
+		}
+		if err != nil {
+			return withRollbackError(err,
+				rollbackConfigs(manifests.Api.Namespace, createdConfigMaps, createdSecrets, out))
+		}
+		if config.GetKind() == "Secret" {
+			createdSecrets = append(createdSecrets, config.GetName())
+		} else {
+			createdConfigMaps = append(createdConfigMaps, config.GetName())
+		}
+		fmt.Fprintf(out, "%s/%s created\n", strings.ToLower(config.GetKind()), config.GetName())
+	}
+	return nil
+}
 
-	//execute kubernetes command to create or update api from file
-	errAddApi := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sArgs...)
-	if errAddApi != nil {
-		fmt.Println("error configuring API")
+// createAPI creates or updates the API custom resource. Configs of the API are deleted if it fails
+func createAPI(manifests *k8sUtils.ApiManifests, update bool, out io.Writer) error {
+	apiCr, err := k8sUtils.ToUnstructured(manifests.Api)
+	if err != nil {
+		return err
+	}
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		return err
+	}
+
+	//create or update api in the cluster
+	if update {
+		err = client.Apply(apiCr, manifests.Api.Namespace)
+	} else {
+		err = client.Create(apiCr, manifests.Api.Namespace)
+	}
+	if err != nil {
 		// delete all configs if any error
-		rollbackConfigs(apiCrd)
+		configMaps, secrets := manifests.ConfigNames()
+		return withRollbackError(err, rollbackConfigs(manifests.Api.Namespace, configMaps, secrets, out))
 	}
+	fmt.Fprintf(out, "api.wso2.com/%s configured\n", apiCr.GetName())
+	return nil
 }
 
-// rollbackConfigs deletes configs defined in the API CR given
-func rollbackConfigs(apiCr *wso2v1alpha2.API) {
-	var rollbackConfMaps []string // configmap names to be deleted
+// rollbackConfigs deletes the given configmaps and secrets of an API
+func rollbackConfigs(namespace string, configMaps, secrets []string, out io.Writer) error {
+	if len(configMaps) == 0 && len(secrets) == 0 {
+		return nil
+	}
 
-	// swagger configmaps
-	rollbackConfMaps = append(rollbackConfMaps, apiCr.Spec.SwaggerConfigMapName)
+	fmt.Fprintln(out, "Deleting created configs")
+	if err := k8sUtils.K8sDeleteConfigMaps(namespace, configMaps...); err != nil {
+		return err
+	}
+	return k8sUtils.K8sDeleteSecrets(namespace, secrets...)
+}
 
-	if len(rollbackConfMaps) == 0 {
-		return
+// withRollbackError adds the error of rolling back the configs of an API to the error caused the rollback
+func withRollbackError(err, rollbackErr error) error {
+	if rollbackErr != nil {
+		return fmt.Errorf("%v, error deleting created configs: %v", err, rollbackErr)
 	}
+	return err
+}
 
-	// execute kubernetes command to delete
-	fmt.Println("Deleting created configs")
-	k8sArgs := []string{k8sUtils.K8sDelete, "cm"}
-	k8sArgs = append(k8sArgs, rollbackConfMaps...)
+// addApiCrFlags adds the flags to set the fields of the API custom resource
+func addApiCrFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&flagReplicas, "replicas", 1, "replica set")
+	cmd.Flags().StringVarP(&flagApiVersion, "version", "v", "",
+		"Property to override the API version")
+	cmd.Flags().StringVarP(&flagApiMode, "mode", "m", "",
+		fmt.Sprintf("Property to override the deploying mode. Available modes: %v, %v, %v, %v", wso2v1alpha2.PrivateJet,
+			wso2v1alpha2.Sidecar, wso2v1alpha2.Shared, wso2v1alpha2.Serverless))
+	cmd.Flags().StringArrayVarP(&flagEnv, "env", "e", []string{},
+		"Environment variables to be passed to the API deployment in the format KEY=VALUE")
+	cmd.Flags().StringVarP(&flagImage, "image", "i", "",
+		"Docker image of the API. If specified, ignores the value of --override")
+	cmd.Flags().BoolVar(&flagOverride, "override", false,
+		"Property to override the existing docker image with the same name and version")
+	cmd.Flags().StringVar(&flagApiEndPoint, "api-endpoint", "", "Endpoint of the API")
+	cmd.Flags().StringVar(&flagHostname, "hostname", "", "Ingress hostname that the API is being exposed")
+	cmd.Flags().StringSliceVar(&flagBalInterceptors, "bal-interceptors", []string{},
+		"Names of existing configmaps with ballerina interceptors")
+	cmd.Flags().StringSliceVar(&flagJavaInterceptors, "java-interceptors", []string{},
+		"Names of existing configmaps with java interceptors")
+	cmd.Flags().StringVar(&flagOverlayFile, "overlay", "",
+		"Path to a YAML or JSON file with the fields of the API custom resource. Overridden by the flags")
+}
 
-	delConfErr := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sArgs...)
-	if delConfErr != nil {
-		utils.HandleErrorAndExit("error deleting configmaps of the API: "+apiCr.Name, delConfErr)
-	}
+// addProjectFlags adds the flags to deploy a full apictl project
+func addProjectFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagProjectPath, "from-project", "",
+		"Path to an apictl project to deploy with its sequences, interceptors, certificates and docs")
+	cmd.Flags().StringVar(&flagParamsPath, "params", utils.ParamFileAPI,
+		"Path to the params file or directory with the environment configs of the project")
+	cmd.Flags().StringVar(&flagParamsEnvironment, "params-environment", "",
+		"Environment in the params file to add its configs to the project. Params are not used if not specified")
+}
+
+// addDryRunFlags adds the flags to render the kubernetes resources without applying them to the cluster
+func addDryRunFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&flagDryRun, "dry-run", false,
+		"Print the kubernetes resources of the API without applying them to the cluster")
+	cmd.Flags().StringVarP(&flagOutputFormat, "output", "o", k8sUtils.ManifestFormatYaml,
+		fmt.Sprintf("Output format of the kubernetes resources with --dry-run. Available formats: %v, %v",
+			k8sUtils.ManifestFormatYaml, k8sUtils.ManifestFormatJson))
+	cmd.Flags().StringVar(&flagOutputDir, "output-dir", "",
+		"Directory to write the kubernetes resources with --dry-run, a file per resource. Printed to stdout if not specified")
 }
 
 func init() {
 	AddCmd.AddCommand(addApiCmd)
 	addApiCmd.Flags().StringVarP(&flagApiName, "name", "n", "", "Name of the API")
 	addApiCmd.Flags().StringVarP(&flagSwaggerFilePath, "from-file", "f", "",
-		"Path to swagger file")
+		"Path to swagger file or apictl project")
 	addApiCmd.Flags().StringVar(&flagNamespace, "namespace", "", "namespace of API")
-	_ = addApiCmd.MarkFlagRequired("name")
-	_ = addApiCmd.MarkFlagRequired("from-file")
+	addProjectFlags(addApiCmd)
+	addBatchFlags(addApiCmd)
+	addApiCrFlags(addApiCmd)
+	addDryRunFlags(addApiCmd)
+	addWatchFlags(addApiCmd, &flagWatch, &flagWatchTimeout)
 }
-
@@ -0,0 +1,144 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var flagDirPath string
+var flagBatchManifest string
+var flagParallel int
+
+// handleAddApiBatch adds or updates the APIs of the apictl projects in the directory given with --from-dir
+func handleAddApiBatch(nameSuffix string) {
+	// replicas of the APIs not given in the manifest are set from the overlay file or the default replicas
+	replicas := 0
+	if flagReplicasChanged {
+		replicas = flagReplicas
+	}
+	apis, err := k8sUtils.ResolveApiBatch(flagDirPath, flagBatchManifest, flagNamespace, replicas)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading APIs in the directory", err)
+	}
+
+	if flagDryRun {
+		writeApiBatchManifests(apis, nameSuffix)
+		return
+	}
+
+	// the client is created before the APIs are deployed in parallel, so that each of them shares it
+	if _, err = k8sUtils.GetK8sClient(); err != nil {
+		utils.HandleErrorAndExit("Error connecting to the cluster", err)
+	}
+
+	update := nameSuffix != ""
+	fmt.Printf("deploying %d APIs from %s\n", len(apis), flagDirPath)
+	results := k8sUtils.RunApiBatch(apis, flagParallel, func(api *k8sUtils.ApiBatchEntry) (string, error) {
+		return deployBatchApi(api, nameSuffix, update)
+	})
+	k8sUtils.PrintApiBatchResults(os.Stdout, results)
+	if err = k8sUtils.ApiBatchError(results); err != nil {
+		utils.HandleErrorAndExit("Error deploying APIs", err)
+	}
+}
+
+// deployBatchApi creates or updates an API in a batch and returns its status. Configs of the API are deleted if
+// it fails
+func deployBatchApi(api *k8sUtils.ApiBatchEntry, nameSuffix string, update bool) (string, error) {
+	if update {
+		if err := getApiExistsError(api.Namespace, api.Name); err != nil {
+			return "", err
+		}
+	}
+	projectPath := filepath.Join(flagDirPath, api.Project)
+	manifests, err := renderApiManifests(api.Name, api.Namespace, projectPath, projectPath, nameSuffix, api.Replicas)
+	if err != nil {
+		return "", err
+	}
+
+	// output of the APIs deployed in parallel is logged once the API is completed
+	out := &bytes.Buffer{}
+	defer func() {
+		utils.Logln(utils.LogPrefixInfo + "Deployed project " + api.Project + "\n" + out.String())
+	}()
+	if err = createApiConfigs(manifests, out); err != nil {
+		return "", err
+	}
+	if err = createAPI(manifests, update, out); err != nil {
+		return "", err
+	}
+	if update {
+		return k8sUtils.ApiBatchStatusUpdated, nil
+	}
+	return k8sUtils.ApiBatchStatusCreated, nil
+}
+
+// writeApiBatchManifests writes the kubernetes resources of all the APIs without applying them to the cluster
+func writeApiBatchManifests(apis []*k8sUtils.ApiBatchEntry, nameSuffix string) {
+	var objects []runtime.Object
+	for _, api := range apis {
+		projectPath := filepath.Join(flagDirPath, api.Project)
+		manifests, err := renderApiManifests(api.Name, api.Namespace, projectPath, projectPath, nameSuffix,
+			api.Replicas)
+		if err != nil {
+			utils.HandleErrorAndExit("Error rendering kubernetes resources of the project "+api.Project, err)
+		}
+		objects = append(objects, manifests.Objects()...)
+	}
+	if err := k8sUtils.WriteManifests(objects, flagOutputFormat, flagOutputDir, os.Stdout); err != nil {
+		utils.HandleErrorAndExit("Error writing kubernetes resources of the APIs", err)
+	}
+	if flagOutputDir != "" {
+		fmt.Println("Kubernetes resources of the APIs written to " + flagOutputDir)
+	}
+}
+
+// validateAddApiBatchFlags validates the flags used with --from-dir and if invalid print error and exit
+func validateAddApiBatchFlags() {
+	if stat, err := os.Stat(flagDirPath); err != nil || !stat.IsDir() {
+		utils.HandleErrorAndExit("directory of apictl projects not found: "+flagDirPath, err)
+	}
+	if flagApiName != "" {
+		utils.HandleErrorAndExit("--name can not be used with --from-dir. Set names of the APIs in the manifest", nil)
+	}
+	if flagWatch {
+		utils.HandleErrorAndExit("--watch can not be used with --from-dir", nil)
+	}
+	if flagParallel < 1 {
+		utils.HandleErrorAndExit("--parallel should be greater than 0", nil)
+	}
+}
+
+// addBatchFlags adds the flags to deploy the apictl projects in a directory
+func addBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagDirPath, "from-dir", "",
+		"Path to a directory of apictl projects to deploy an API for each project")
+	cmd.Flags().StringVar(&flagBatchManifest, "manifest", "",
+		"Path to a manifest with the names, namespaces and replicas of the APIs deployed with --from-dir")
+	cmd.Flags().IntVar(&flagParallel, "parallel", 4, "Number of APIs deployed in parallel with --from-dir")
+}
@@ -60,6 +60,15 @@ var changeDockerRegistryCmd = &cobra.Command{
 				errors.New("mode should be set to kubernetes"))
 		}
 
+		// registry configs are created in the namespace of the installed API Operator
+		installation, err := k8sUtils.GetApiOperatorInstallation()
+		if err != nil {
+			utils.HandleErrorAndExit("Error checking the installed API Operator", err)
+		}
+		if installation != nil {
+			registry.SetNamespace(installation.Namespace)
+		}
+
 		// check for installation mode: interactive or batch mode
 		if flagBmRegistryType == "" {
 			// run in interactive mode
@@ -87,7 +96,7 @@ func init() {
 	// flags for installing api-operator in batch mode
 	// only the flag "registry-type" is required and others are registry specific flags
 	// same flags defined in 'installApiOperator'
-	changeDockerRegistryCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR |GCR | HTTP")
+	changeDockerRegistryCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR | GCR | HTTP | HTTPS | QUAY | AZURE_ACR | HARBOR | GHCR")
 	changeDockerRegistryCmd.Flags().StringVarP(&flagBmRepository, k8sUtils.FlagBmRepository, "r", "", "Repository name or URI")
 	changeDockerRegistryCmd.Flags().StringVarP(&flagBmUsername, k8sUtils.FlagBmUsername, "u", "", "Username of the repository")
 	changeDockerRegistryCmd.Flags().StringVarP(&flagBmPassword, k8sUtils.FlagBmPassword, "p", "", "Password of the given user")
@@ -17,3 +17,25 @@
  */
 
 package k8s
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const K8sGetCmdLiteral = "get"
+const k8sGetCmdShortDesc = "Get resources in the kubernetes cluster"
+const k8sGetCmdLongDesc = "Get the status of the resources deployed with the API Operator in the kubernetes cluster"
+const k8sGetCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGetCmdLiteral + ` ` + K8sGetApiCmdLiteral + ` petstore --namespace=wso2`
+
+// getCmd represents the get command
+var getCmd = &cobra.Command{
+	Use:     K8sGetCmdLiteral,
+	Short:   k8sGetCmdShortDesc,
+	Long:    k8sGetCmdLongDesc,
+	Example: k8sGetCmdExamples,
+}
+
+func init() {
+	Cmd.AddCommand(getCmd)
+}
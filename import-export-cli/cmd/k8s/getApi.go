@@ -17,3 +17,101 @@
  */
 
 package k8s
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var flagGetApiNamespace string
+var flagGetApiWatch bool
+var flagGetApiTimeout int
+var flagGetApiFormat string
+
+const K8sGetApiCmdLiteral = "api"
+const k8sGetApiCmdShortDesc = "Get the status of APIs in the kubernetes cluster"
+const k8sGetApiCmdLongDesc = `Get the status of APIs deployed with the API Operator. The status is read from the API
+custom resource, the generated deployment, service, horizontal pod autoscaler and pods. Lists all the APIs in the
+namespace if the name of the API is not given. With --watch, follows the rollout of the API until it is ready or
+failed and exits with a non-zero status code if it fails or the timeout is reached`
+const k8sGetApiCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGetCmdLiteral + ` ` + K8sGetApiCmdLiteral + ` --namespace=wso2
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGetCmdLiteral + ` ` + K8sGetApiCmdLiteral + ` petstore --namespace=wso2
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGetCmdLiteral + ` ` + K8sGetApiCmdLiteral + ` petstore --namespace=wso2 --watch --timeout=600`
+
+// getApiCmd represents the get api command
+var getApiCmd = &cobra.Command{
+	Use:     K8sGetApiCmdLiteral + " [name-of-the-api]",
+	Short:   k8sGetApiCmdShortDesc,
+	Long:    k8sGetApiCmdLongDesc,
+	Example: k8sGetApiCmdExamples,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + K8sGetCmdLiteral + " " + K8sGetApiCmdLiteral + " called")
+		client, err := k8sUtils.GetK8sClient()
+		if err != nil {
+			utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+		}
+
+		if len(args) == 0 {
+			if flagGetApiWatch {
+				utils.HandleErrorAndExit("Name of the API is required with --watch", nil)
+			}
+			statuses, err := k8sUtils.ListApiStatuses(client, flagGetApiNamespace)
+			if err != nil {
+				utils.HandleErrorAndExit("Error getting APIs", err)
+			}
+			k8sUtils.PrintApiStatuses(statuses, flagGetApiFormat)
+			return
+		}
+
+		if flagGetApiWatch {
+			watchApiRollout(client, flagGetApiNamespace, args[0], 0, flagGetApiTimeout)
+			return
+		}
+		status, err := k8sUtils.GetApiStatus(client, flagGetApiNamespace, args[0])
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting API", err)
+		}
+		k8sUtils.PrintApiStatus(os.Stdout, status)
+	},
+}
+
+// watchApiRollout follows the rollout of an API until it is ready and exits with an error if it fails or the timeout
+// is reached
+func watchApiRollout(client *k8sUtils.K8sClient, namespace, name string, sinceGeneration int64, timeoutSec int) {
+	fmt.Printf("Waiting for the API %s to be ready...\n", name)
+	status, err := k8sUtils.WaitForApiReady(client, namespace, name, sinceGeneration,
+		time.Duration(timeoutSec)*time.Second, func(status *k8sUtils.ApiStatus) {
+			if status.Message != "" {
+				fmt.Printf("%s\t%s\t%s\n", status.Phase, status.Ready(), status.Message)
+			} else {
+				fmt.Printf("%s\t%s\n", status.Phase, status.Ready())
+			}
+		})
+	if err != nil {
+		if status != nil {
+			k8sUtils.PrintApiStatus(os.Stdout, status)
+		}
+		utils.HandleErrorAndExit("API rollout is not successful", err)
+	}
+	fmt.Printf("API %s is ready\n", name)
+}
+
+// addWatchFlags adds the flags to follow the rollout of the API
+func addWatchFlags(cmd *cobra.Command, watch *bool, timeout *int) {
+	cmd.Flags().BoolVarP(watch, "watch", "w", false,
+		"Follow the rollout of the API until it is ready or failed")
+	cmd.Flags().IntVar(timeout, "timeout", 300, "Maximum time in seconds to wait for the API with --watch")
+}
+
+func init() {
+	getCmd.AddCommand(getApiCmd)
+	getApiCmd.Flags().StringVar(&flagGetApiNamespace, "namespace", "", "namespace of API")
+	getApiCmd.Flags().StringVar(&flagGetApiFormat, "format", "", "Pretty-print APIs using Go templates")
+	addWatchFlags(getApiCmd, &flagGetApiWatch, &flagGetApiTimeout)
+}
@@ -20,6 +20,7 @@ package k8s
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/operator/registry"
@@ -29,13 +30,20 @@ import (
 
 const K8sInstallApiOperatorCmdLiteral = "api-operator"
 const k8sInstallApiOperatorCmdShortDesc = "Install API Operator"
-const k8sInstallApiOperatorCmdLongDesc = "Install API Operator in the configured K8s cluster"
+const k8sInstallApiOperatorCmdLongDesc = "Install API Operator in the configured K8s cluster. " +
+	"With the flag --config, the version, registry and controller configs are read from an install config " +
+	"and an installed API Operator is upgraded by applying only the changed resources. " +
+	"Set \"bundle\" in the install config to a local directory of API Operator configs for air-gapped installations"
 const k8sInstallApiOperatorCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + `
 ` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` -f path/to/operator/configs
-` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` -f path/to/operator/config/file.yaml`
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` -f path/to/operator/config/file.yaml
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` --config install.yaml
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` --config install.yaml --dry-run`
 
 // flags
 var flagApiOperatorFile string
+var flagApiOperatorConfig string
+var flagApiOperatorDryRun bool
 
 // flags for installing api-operator in batch mode
 var flagBmRegistryType string
@@ -54,6 +62,20 @@ var installApiOperatorCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(fmt.Sprintf("%s%s %s called", utils.LogPrefixInfo, K8sInstallCmdLiteral, K8sInstallApiOperatorCmdLiteral))
 
+		if flagApiOperatorConfig != "" {
+			for _, flag := range []string{"from-file", "registry-type", k8sUtils.FlagBmRepository,
+				k8sUtils.FlagBmUsername, k8sUtils.FlagBmPassword, k8sUtils.FlagBmPasswordStdin, k8sUtils.FlagBmKeyFile} {
+				if cmd.Flags().Changed(flag) {
+					utils.HandleErrorAndExit("Flag \""+flag+"\" can not be used with the flag \"config\"", nil)
+				}
+			}
+			installApiOperatorFromConfig()
+			return
+		}
+		if flagApiOperatorDryRun {
+			utils.HandleErrorAndExit("Flag \"dry-run\" can only be used with the flag \"config\"", nil)
+		}
+
 		// is -f or --from-file flag specified
 		isLocalInstallation := flagApiOperatorFile != ""
 		configFile := flagApiOperatorFile
@@ -101,6 +123,113 @@ var installApiOperatorCmd = &cobra.Command{
 	},
 }
 
+// installApiOperatorFromConfig installs the API Operator or upgrades the installed API Operator with the install
+// config given with the flag "--config". Only the resources different from the installed resources are applied
+func installApiOperatorFromConfig() {
+	config, err := k8sUtils.ReadInstallConfig(flagApiOperatorConfig)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading install config", err)
+	}
+
+	installation, err := k8sUtils.GetApiOperatorInstallation()
+	if err != nil {
+		utils.HandleErrorAndExit("Error checking the installed API Operator", err)
+	}
+	if installation == nil {
+		if config.Registry == nil {
+			utils.HandleErrorAndExit("Registry configs are required in the install config to install the API Operator", nil)
+		}
+	} else if installation.Namespace != k8sUtils.ApiOpWso2Namespace {
+		// the API Operator watches its configs only in the namespace wso2-system
+		utils.HandleErrorAndExit(fmt.Sprintf("API Operator is already installed in the namespace \"%s\". "+
+			"Uninstall it before installing the API Operator in the namespace \"%s\"",
+			installation.Namespace, k8sUtils.ApiOpWso2Namespace), nil)
+	}
+
+	// read the configs from the local bundle for air-gapped installations
+	configFile := config.Bundle
+	if configFile == "" {
+		if config.Version == "" {
+			config.Version, err = k8sUtils.GetVersion(
+				"API Operator",
+				k8sUtils.ApiOperatorVersionEnvVariable,
+				k8sUtils.DefaultApiOperatorVersion,
+				k8sUtils.ApiOperatorVersionValidationUrlTemplate,
+				k8sUtils.ApiOperatorFindVersionUrl,
+			)
+			if err != nil {
+				utils.HandleErrorAndExit("Error in API Operator version", err)
+			}
+		}
+		configFile = fmt.Sprintf(k8sUtils.ApiOperatorConfigsUrlTemplate, config.Version)
+	}
+	// the version of a bundle is resolved when rendering the configs
+	objects, err := k8sUtils.RenderApiOperatorConfigs(config, k8sUtils.ReadApiOperatorConfigs(configFile))
+	if err != nil {
+		utils.HandleErrorAndExit("Error rendering API Operator configs", err)
+	}
+
+	if installation == nil {
+		fmt.Printf("Installing API Operator %s in the namespace \"%s\"\n", config.Version, k8sUtils.ApiOpWso2Namespace)
+	} else {
+		fmt.Printf("Upgrading API Operator %s in the namespace \"%s\" to %s\n", installation.Version,
+			installation.Namespace, config.Version)
+	}
+
+	// read registry configs before changing the cluster
+	registry.SetNamespace(k8sUtils.ApiOpWso2Namespace)
+	if config.Registry != nil {
+		registry.SetRegistry(config.Registry.Type)
+		flagsValues := getInstallConfigFlagsValues(config.Registry)
+		registry.ValidateFlags(flagsValues)
+		registry.ReadInputsFromFlags(flagsValues)
+	}
+
+	if config.Registry == nil {
+		// keep the registry configured in the installed API Operator
+		filtered := objects[:0]
+		for _, obj := range objects {
+			if obj.GetKind() != "ConfigMap" || obj.GetName() != k8sUtils.ApiOpRegistryConfigMap {
+				filtered = append(filtered, obj)
+			}
+		}
+		objects = filtered
+	}
+
+	if err = k8sUtils.ApplyApiOperatorConfigs(objects, flagApiOperatorDryRun, os.Stdout); err != nil {
+		utils.HandleErrorAndExit("Error applying API Operator configs", err)
+	}
+	if flagApiOperatorDryRun {
+		if config.Registry != nil {
+			fmt.Printf("registry configs of %s updated (dry run)\n", config.Registry.Type)
+		}
+		return
+	}
+	if config.Registry != nil {
+		registry.UpdateConfigsSecrets()
+	}
+
+	if err = k8sUtils.K8sWaitForDeployment(k8sUtils.ApiOperator, k8sUtils.ApiOpWso2Namespace,
+		k8sUtils.ApiOpRolloutTimeoutSec); err != nil {
+		utils.HandleErrorAndExit("Error rolling out API Operator", err)
+	}
+
+	fmt.Println("[Setting to K8s Mode]")
+	utils.SetToK8sMode()
+}
+
+// getInstallConfigFlagsValues returns the registry configs in the install config as batch mode flags
+func getInstallConfigFlagsValues(reg *k8sUtils.InstallRegistry) *map[string]registry.FlagValue {
+	flags := make(map[string]registry.FlagValue)
+	flags[k8sUtils.FlagBmRepository] = registry.FlagValue{Value: reg.Repository, IsProvided: reg.Repository != ""}
+	flags[k8sUtils.FlagBmUsername] = registry.FlagValue{Value: reg.Username, IsProvided: reg.Username != ""}
+	flags[k8sUtils.FlagBmPassword] = registry.FlagValue{Value: reg.Password, IsProvided: reg.Password != ""}
+	flags[k8sUtils.FlagBmPasswordStdin] = registry.FlagValue{Value: reg.PasswordStdin, IsProvided: reg.PasswordStdin}
+	flags[k8sUtils.FlagBmKeyFile] = registry.FlagValue{Value: reg.KeyFile, IsProvided: reg.KeyFile != ""}
+
+	return &flags
+}
+
 // getGivenFlagsValues returns flags that user given in the batch mode except the "registry type"
 func getGivenFlagsValues() *map[string]registry.FlagValue {
 	flags := make(map[string]registry.FlagValue)
@@ -116,10 +245,14 @@ func getGivenFlagsValues() *map[string]registry.FlagValue {
 func init() {
 	installCmd.AddCommand(installApiOperatorCmd)
 	installApiOperatorCmd.Flags().StringVarP(&flagApiOperatorFile, "from-file", "f", "", "Path to API Operator directory")
+	installApiOperatorCmd.Flags().StringVar(&flagApiOperatorConfig, "config", "",
+		"Path to the install config with the version, registry and controller configs")
+	installApiOperatorCmd.Flags().BoolVar(&flagApiOperatorDryRun, "dry-run", false,
+		"Print the changes to be made to the cluster without applying them. Used with the flag \"config\"")
 
 	// flags for installing api-operator in batch mode
 	// only the flag "registry-type" is required and others are registry specific flags
-	installApiOperatorCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR |GCR | HTTP")
+	installApiOperatorCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR | GCR | HTTP | HTTPS | QUAY | AZURE_ACR | HARBOR | GHCR")
 	installApiOperatorCmd.Flags().StringVarP(&flagBmRepository, k8sUtils.FlagBmRepository, "r", "", "Repository name or URI")
 	installApiOperatorCmd.Flags().StringVarP(&flagBmUsername, k8sUtils.FlagBmUsername, "u", "", "Username of the repository")
 	installApiOperatorCmd.Flags().StringVarP(&flagBmPassword, k8sUtils.FlagBmPassword, "p", "", "Password of the given user")
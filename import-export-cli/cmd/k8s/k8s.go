@@ -20,6 +20,7 @@ package k8s
 
 import (
 	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -42,7 +43,7 @@ var Cmd = &cobra.Command{
 	Long:    k8sCmdLongDesc,
 	Example: k8sCmdExamples,
 	Run: func(cmd *cobra.Command, args []string) {
-		utils.Logln(utils.LogPrefixInfo +  K8sCmdLiteral + " called")
+		utils.Logln(utils.LogPrefixInfo + K8sCmdLiteral + " called")
 
 	},
 }
@@ -50,5 +51,6 @@ var Cmd = &cobra.Command{
 // init using Cobra
 func init() {
 	Cmd.AddCommand(AddCmd)
+	Cmd.PersistentFlags().StringVar(&k8sUtils.KubeContext, "context", "",
+		"Name of the kubeconfig context to use. The current context is used if not specified")
 }
-
@@ -44,12 +44,22 @@ var uninstallApiOperatorCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		isConfirm := flagForceUninstallApiOperator
 
+		// namespace of the API Operator installed with an install config can be different
+		namespace := k8sUtils.ApiOpWso2Namespace
+		installation, err := k8sUtils.GetApiOperatorInstallation()
+		if err != nil {
+			utils.HandleErrorAndExit("Error checking the installed API Operator", err)
+		}
+		if installation != nil {
+			namespace = installation.Namespace
+		}
+
 		if !flagForceUninstallApiOperator {
 			isConfirmStr, err := utils.ReadInputString(
 				fmt.Sprintf("\nUninstall \"%s\" and all related resources: APIs, Securities, Rate Limitings and Target Endpoints\n"+
 					"[WARNING] Remove the namespace: %s\n"+
 					"Are you sure",
-					k8sUtils.ApiOperator, k8sUtils.ApiOpWso2Namespace),
+					k8sUtils.ApiOperator, namespace),
 				utils.Default{Value: "N", IsDefault: true},
 				"",
 				false,
@@ -68,17 +78,17 @@ var uninstallApiOperatorCmd = &cobra.Command{
 			// delete the namespace "wso2-system"
 			// namespace, "wso2-system" contains all the artifacts and configs
 			// deleting the namespace: "wso2-system", will remove all the artifacts and configs
-			fmt.Printf("Removing namespace: %s\nThis operation will take some minutes...\n", k8sUtils.ApiOpWso2Namespace)
+			fmt.Printf("Removing namespace: %s\nThis operation will take some minutes...\n", namespace)
 
 			deleteErrors := []error{
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.Namespace, k8sUtils.ApiOpWso2Namespace),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRole, k8sUtils.ApiOperator),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRoleBinding, k8sUtils.ApiOperator),
+				k8sUtils.K8sDeleteResource(k8sUtils.NamespaceGroupKind, "", namespace),
+				k8sUtils.K8sDeleteResource(k8sUtils.ClusterRoleGroupKind, "", k8sUtils.ApiOperator),
+				k8sUtils.K8sDeleteResource(k8sUtils.ClusterRoleBindingGroupKind, "", k8sUtils.ApiOperator),
 
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdApi),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdSecurity),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdRateLimiting),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdTargetEndpoint),
+				k8sUtils.K8sDeleteResource(k8sUtils.CrdGroupKind, "", k8sUtils.ApiOpCrdApi),
+				k8sUtils.K8sDeleteResource(k8sUtils.CrdGroupKind, "", k8sUtils.ApiOpCrdSecurity),
+				k8sUtils.K8sDeleteResource(k8sUtils.CrdGroupKind, "", k8sUtils.ApiOpCrdRateLimiting),
+				k8sUtils.K8sDeleteResource(k8sUtils.CrdGroupKind, "", k8sUtils.ApiOpCrdTargetEndpoint),
 			}
 
 			for _, err := range deleteErrors {
@@ -96,4 +106,3 @@ func init() {
 	uninstallCmd.AddCommand(uninstallApiOperatorCmd)
 	uninstallApiOperatorCmd.Flags().BoolVar(&flagForceUninstallApiOperator, "force", false, "Force uninstall API Operator")
 }
-
@@ -72,10 +72,10 @@ var uninstallWso2amOperatorCmd = &cobra.Command{
 			fmt.Printf("Removing namespace: %s\nThis operation will take some minutes...\n", k8sUtils.ApiOpWso2Namespace)
 
 			deleteErrors := []error{
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.Namespace, k8sUtils.ApiOpWso2Namespace),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRole, k8sUtils.Wso2amRole),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRoleBinding, k8sUtils.Wso2amRoleBinding),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.Wso2amOpCrdApimanager),
+				k8sUtils.K8sDeleteResource(k8sUtils.NamespaceGroupKind, "", k8sUtils.ApiOpWso2Namespace),
+				k8sUtils.K8sDeleteResource(k8sUtils.ClusterRoleGroupKind, "", k8sUtils.Wso2amRole),
+				k8sUtils.K8sDeleteResource(k8sUtils.ClusterRoleBindingGroupKind, "", k8sUtils.Wso2amRoleBinding),
+				k8sUtils.K8sDeleteResource(k8sUtils.CrdGroupKind, "", k8sUtils.Wso2amOpCrdApimanager),
 			}
 
 			for _, err := range deleteErrors {
@@ -93,4 +93,3 @@ func init() {
 	uninstallCmd.AddCommand(uninstallWso2amOperatorCmd)
 	uninstallWso2amOperatorCmd.Flags().BoolVar(&flagForceUninstallWso2amOperator, "force", false, "Force uninstall WSO2AM Operator")
 }
-
@@ -19,6 +19,7 @@
 package k8s
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const K8sUpdateCmdLiteral = "update"
@@ -52,20 +54,13 @@ var updateApiCmd = &cobra.Command{
 	Example: addApiExamples,
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + K8sUpdateCmdLiteral + " called")
+		flagReplicasChanged = cmd.Flags().Changed("replicas")
 		validateAddApiCommand()
 
-		// check the existence of the API
-		getApiErr := k8sUtils.ExecuteCommandWithoutOutputs(
-			k8sUtils.Kubectl, k8sUtils.K8sGet, k8sUtils.ApiOpCrdApi, flagApiName, "-n", flagNamespace)
-		if getApiErr != nil {
-			var errMsg string
-			if flagNamespace != "" {
-				errMsg = fmt.Sprintf("Could not find the API \"%s\" in the namespace \"%s\"",
-					flagApiName, flagNamespace)
-			} else {
-				errMsg = fmt.Sprintf("Could not find the API \"%s\"", flagApiName)
-			}
-			utils.HandleErrorAndExit(errMsg, nil)
+		// check the existence of the API. Resources are rendered offline with --dry-run and the existence of the APIs
+		// deployed with --from-dir are checked separately
+		if !flagDryRun && flagDirPath == "" {
+			checkApiExists()
 		}
 
 		//get current timestamp
@@ -74,15 +69,40 @@ var updateApiCmd = &cobra.Command{
 	},
 }
 
+// checkApiExists checks the existence of the API in the cluster and exits if not found
+func checkApiExists() {
+	if err := getApiExistsError(flagNamespace, flagApiName); err != nil {
+		utils.HandleErrorAndExit(err.Error(), nil)
+	}
+}
+
+// getApiExistsError returns an error if the API is not found in the cluster
+func getApiExistsError(namespace, apiName string) error {
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		return fmt.Errorf("Error connecting to the kubernetes cluster: %v", err)
+	}
+	_, getApiErr := client.Dynamic.Resource(k8sUtils.ApiGVR).Namespace(client.ResolveNamespace(namespace)).
+		Get(context.TODO(), strings.ToLower(apiName), metav1.GetOptions{})
+	if getApiErr != nil {
+		utils.Logln(utils.LogPrefixError + getApiErr.Error())
+		if namespace != "" {
+			return fmt.Errorf("Could not find the API \"%s\" in the namespace \"%s\"", apiName, namespace)
+		}
+		return fmt.Errorf("Could not find the API \"%s\"", apiName)
+	}
+	return nil
+}
+
 func init() {
 	Cmd.AddCommand(updateCmd)
 	updateCmd.AddCommand(updateApiCmd)
 	updateApiCmd.Flags().StringVarP(&flagApiName, "name", "n", "", "Name of the API")
-	updateApiCmd.Flags().StringVarP(&flagSwaggerFilePath, "from-file", "f", "", "Path to swagger file")
-	updateApiCmd.Flags().IntVar(&flagReplicas, "replicas", 1, "replica set")
+	updateApiCmd.Flags().StringVarP(&flagSwaggerFilePath, "from-file", "f", "", "Path to swagger file or apictl project")
 	updateApiCmd.Flags().StringVar(&flagNamespace, "namespace", "", "namespace of API")
-	updateApiCmd.Flags().StringVarP(&flagApiVersion, "version", "v", "", "Property to override the existing docker image with same name and version")
-	updateApiCmd.Flags().StringVarP(&flagApiMode, "mode", "m", "",
-		fmt.Sprintf("Property to override the deploying mode. Available modes: %v, %v", utils.PrivateJetModeConst, utils.SidecarModeConst))
+	addProjectFlags(updateApiCmd)
+	addBatchFlags(updateApiCmd)
+	addApiCrFlags(updateApiCmd)
+	addDryRunFlags(updateApiCmd)
+	addWatchFlags(updateApiCmd, &flagWatch, &flagWatchTimeout)
 }
-
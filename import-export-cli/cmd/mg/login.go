@@ -0,0 +1,98 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var mgLoginEnvironment string
+var mgLoginUsername string
+var mgLoginPassword string
+var mgLoginPasswordStdin bool
+
+const mgLoginCmdLiteral = "login"
+const mgLoginCmdShortDesc = "Login to a Microgateway"
+const mgLoginCmdLongDesc = `Login to the Microgateway adapter of an environment using credentials`
+const mgLoginCmdExamples = utils.ProjectName + " " + utils.MgCmdLiteral + " " + mgLoginCmdLiteral + " -e dev -u admin -p admin\n" +
+	utils.ProjectName + " " + utils.MgCmdLiteral + " " + mgLoginCmdLiteral + " -e dev -u admin\n" +
+	"cat ~/.mypassword | " + utils.ProjectName + " " + utils.MgCmdLiteral + " " + mgLoginCmdLiteral +
+	" -e dev -u admin --password-stdin"
+
+// MgLoginCmd represents the mg login command
+var MgLoginCmd = &cobra.Command{
+	Use:     mgLoginCmdLiteral + " --environment [environment] [flags]",
+	Short:   mgLoginCmdShortDesc,
+	Long:    mgLoginCmdLongDesc,
+	Example: mgLoginCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + utils.MgCmdLiteral + " " + mgLoginCmdLiteral + " called")
+		if mgLoginPassword != "" {
+			fmt.Println("Warning: Using --password in CLI is not secure. Use --password-stdin")
+			if mgLoginPasswordStdin {
+				fmt.Println("--password and --password-stdin are mutual exclusive")
+				os.Exit(1)
+			}
+		}
+
+		if mgLoginPasswordStdin {
+			if mgLoginUsername == "" {
+				fmt.Println("An username is required to use password-stdin")
+				os.Exit(1)
+			}
+
+			data, err := ioutil.ReadAll(os.Stdin)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			mgLoginPassword = strings.TrimRight(strings.TrimSuffix(string(data), "\n"), "\r")
+		}
+
+		store, err := credentials.GetDefaultCredentialStore()
+		if err != nil {
+			fmt.Println("Error occurred while loading credential store : ", err)
+			os.Exit(1)
+		}
+		err = credentials.RunMGLogin(store, mgLoginEnvironment, mgLoginUsername, mgLoginPassword)
+		if err != nil {
+			fmt.Println("Error occurred while login : ", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(MgLoginCmd)
+
+	MgLoginCmd.Flags().StringVarP(&mgLoginEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter")
+	MgLoginCmd.Flags().StringVarP(&mgLoginUsername, "username", "u", "", "Username for login")
+	MgLoginCmd.Flags().StringVarP(&mgLoginPassword, "password", "p", "", "Password for login")
+	MgLoginCmd.Flags().BoolVarP(&mgLoginPasswordStdin, "password-stdin", "", false, "Get password from stdin")
+	_ = MgLoginCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,59 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var mgLogoutEnvironment string
+
+const mgLogoutCmdLiteral = "logout"
+const mgLogoutCmdShortDesc = "Logout from a Microgateway"
+const mgLogoutCmdLongDesc = `Logout from the Microgateway adapter of an environment`
+const mgLogoutCmdExamples = utils.ProjectName + " " + utils.MgCmdLiteral + " " + mgLogoutCmdLiteral + " -e dev"
+
+// MgLogoutCmd represents the mg logout command
+var MgLogoutCmd = &cobra.Command{
+	Use:     mgLogoutCmdLiteral + " --environment [environment]",
+	Short:   mgLogoutCmdShortDesc,
+	Long:    mgLogoutCmdLongDesc,
+	Example: mgLogoutCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + utils.MgCmdLiteral + " " + mgLogoutCmdLiteral + " called")
+		err := credentials.RunMGLogout(mgLogoutEnvironment)
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(MgLogoutCmd)
+
+	MgLogoutCmd.Flags().StringVarP(&mgLogoutEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter")
+	_ = MgLogoutCmd.MarkFlagRequired("environment")
+}
@@ -20,15 +20,14 @@ package mg
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 // mgw command related usage Info
 const (
-	mgCmdLiteral   = "mg"
+	mgCmdLiteral   = utils.MgCmdLiteral
 	mgCmdShortDesc = "Handle Microgateway related operations"
-	mgCmdLongDesc  = `Initialize, Add, Update an apictl project to the microgateway`
-
-	MgBasepath = "/api/mgw/adapter/0.1"
+	mgCmdLongDesc  = `Login to a Microgateway environment and deploy, update, list and undeploy apictl projects in the microgateway`
 )
 
 // MgwCmd represents the export command
@@ -19,85 +19,148 @@
 package mg
 
 import (
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var (
-	mgwControlPlaneHost string
+	mgDeployEnvironment string
 	mgwImportAPIFile    string
-	username            string
-	password            string
 	mgDeploySkipCleanup bool
+
+	// deprecated flags of the mg deploy command
+	mgDeployHost     string
+	mgDeployUsername string
+	mgDeployPassword string
 )
 
 const (
 	mgDeployCmdLiteral   = "deploy"
 	mgDeployCmdShortDesc = "Deploy API"
 	mgDeployCmdLongDesc  = "Deploy the API (apictl project) in Microgateway"
-
-	mgDeployResourcePath = "/apis"
 )
 
-const mgDeployCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgDeployCmdLiteral + " -h https://localhost:9095 " +
-	"-f qa/TwitterAPI.zip -u admin -p admin\n" +
-	"cat ~/.mypassword | " + utils.ProjectName + mgCmdLiteral + " " + " " + mgDeployCmdLiteral + " -h https://localhost:9095 " +
-	"-f qa/TwitterAPI.zip -u admin"
+const mgDeployCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgDeployCmdLiteral + " -e dev " +
+	"-f qa/TwitterAPI.zip"
 
 type MgwResponse struct {
 	Message string
 }
 
-//TODO: (VirajSalaka) Introduce Add environment
 var MgDeployCmd = &cobra.Command{
-	Use: mgDeployCmdLiteral + " --host [control plane url] --file [file name] " +
-		"--username [username] --password [password]",
+	Use:     mgDeployCmdLiteral + " --environment [environment] --file [file name]",
 	Short:   "Deploy apictl project.",
 	Long:    "Deploy the apictl project in Microgateway",
 	Example: mgDeployCmdExamples,
 	Args:    cobra.MinimumNArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
-		var tempMap map[string]string
-		resourcePath := MgBasepath + mgDeployResourcePath
-		if password == "" {
-			fmt.Printf("Provide the password for the user: %v \n", username)
-			data, err := ioutil.ReadAll(os.Stdin)
-			if err != nil {
-				fmt.Println(err)
-				os.Exit(1)
-			}
-			password = strings.TrimRight(strings.TrimSuffix(string(data), "\n"), "\r")
+		utils.Logln(utils.LogPrefixInfo + mgCmdLiteral + " " + mgDeployCmdLiteral + " called")
+		if mgDeployHost != "" && mgDeployEnvironment != "" {
+			utils.HandleErrorAndExit("Error deploying the API",
+				errors.New("--host and --environment (-e) are mutually exclusive"))
 		}
-		authToken := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
-		err := impl.ImportAPIToMGW(mgwControlPlaneHost+resourcePath, mgwImportAPIFile, authToken, tempMap, mgDeploySkipCleanup)
-		if err != nil {
-			utils.HandleErrorAndExit("Error adding swagger to microgateway", err)
+		if mgDeployHost == "" && mgDeployEnvironment == "" {
+			utils.HandleErrorAndExit("Error deploying the API", errors.New("required flag \"environment\" not set"))
 		}
+		if mgDeployHost != "" || mgDeployUsername != "" || mgDeployPassword != "" {
+			deployMgwAPIWithDeprecatedFlags(mgwImportAPIFile, mgDeploySkipCleanup)
+			return
+		}
+		deployMgwAPI(mgDeployEnvironment, mgwImportAPIFile, false, mgDeploySkipCleanup)
 	},
 }
 
+// deployMgwAPI deploys an apictl project in the Microgateway of the environment. An existing API with the same
+// name and version is overridden if override is true
+func deployMgwAPI(environment, file string, override, skipCleanup bool) {
+	var tempMap map[string]string
+	cred, err := credentials.GetMGCredentials(environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	endpoint := utils.GetMgwAdapterEndpointOfResource(utils.MgwAdapterApisResource, environment,
+		utils.MainConfigFilePath)
+	if override {
+		endpoint += "?override=true"
+	}
+	err = impl.ImportAPIToMGW(endpoint, file, credentials.GetMgwBasicAuth(cred), tempMap, skipCleanup)
+	if err != nil {
+		utils.HandleErrorAndExit("Error adding swagger to microgateway", err)
+	}
+}
+
+// deployMgwAPIWithDeprecatedFlags deploys an apictl project using the deprecated --host, --username and --password
+// flags. The adapter given with --host is used instead of the adapter of an environment, and the credentials given
+// with the flags are used instead of the credentials stored for the environment
+func deployMgwAPIWithDeprecatedFlags(file string, skipCleanup bool) {
+	var tempMap map[string]string
+	var endpoint string
+	if mgDeployHost != "" {
+		endpoint = strings.TrimSuffix(mgDeployHost, "/") + "/" + utils.MgwAdapterAPIContext + "/" +
+			utils.MgwAdapterApisResource
+	} else {
+		if !utils.MgwAdapterExistsInEnv(mgDeployEnvironment, utils.MainConfigFilePath) {
+			utils.HandleErrorAndExit("Error deploying the API",
+				errors.New("MG does not exists in "+mgDeployEnvironment+". Add it using add env"))
+		}
+		endpoint = utils.GetMgwAdapterEndpointOfResource(utils.MgwAdapterApisResource, mgDeployEnvironment,
+			utils.MainConfigFilePath)
+	}
+
+	cred := credentials.MgwCredential{Username: mgDeployUsername, Password: mgDeployPassword}
+	if cred.Username == "" {
+		if mgDeployHost != "" || cred.Password != "" {
+			utils.HandleErrorAndExit("Error deploying the API", errors.New("required flag \"username\" not set"))
+		}
+		var err error
+		cred, err = credentials.GetMGCredentials(mgDeployEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+	} else if cred.Password == "" {
+		fmt.Printf("Provide the password for the user: %v \n", cred.Username)
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the password", err)
+		}
+		cred.Password = strings.TrimRight(strings.TrimSuffix(string(data), "\n"), "\r")
+	}
+
+	err := impl.ImportAPIToMGW(endpoint, file, credentials.GetMgwBasicAuth(cred), tempMap, skipCleanup)
+	if err != nil {
+		utils.HandleErrorAndExit("Error adding swagger to microgateway", err)
+	}
+}
+
 func init() {
 	MgCmd.AddCommand(MgDeployCmd)
 	//TODO: (VirajSalaka) import using just folder name
 	MgDeployCmd.Flags().StringVarP(&mgwImportAPIFile, "file", "f", "",
 		"Provide the filepath of the apictl project to be imported")
-	MgDeployCmd.Flags().StringVarP(&mgwControlPlaneHost, "host", "c", "",
+	MgDeployCmd.Flags().StringVarP(&mgDeployEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter to which the API should be deployed")
+	MgDeployCmd.Flags().BoolVarP(&mgDeploySkipCleanup, "skipCleanup", "", false, "Leave "+
+		"all temporary files created during import process")
+	MgDeployCmd.Flags().StringVarP(&mgDeployHost, "host", "c", "",
 		"Provide the host url for the control plane with port")
-	MgDeployCmd.Flags().StringVarP(&username, "username", "u", "",
+	MgDeployCmd.Flags().StringVarP(&mgDeployUsername, "username", "u", "",
 		"Provide the username")
-	MgDeployCmd.Flags().StringVarP(&password, "password", "p", "",
+	MgDeployCmd.Flags().StringVarP(&mgDeployPassword, "password", "p", "",
 		"Provide the password")
-	MgDeployCmd.Flags().BoolVarP(&mgDeploySkipCleanup, "skipCleanup", "", false, "Leave "+
-		"all temporary files created during import process")
+	_ = MgDeployCmd.Flags().MarkDeprecated("host", "add the Microgateway adapter to an environment with "+
+		"'"+utils.ProjectName+" add env --mg' and use --environment (-e) instead")
+	_ = MgDeployCmd.Flags().MarkDeprecated("username", "log in to the Microgateway with "+
+		"'"+utils.ProjectName+" "+utils.MgCmdLiteral+" login' instead")
+	_ = MgDeployCmd.Flags().MarkDeprecated("password", "log in to the Microgateway with "+
+		"'"+utils.ProjectName+" "+utils.MgCmdLiteral+" login' instead")
 
-	_ = MgDeployCmd.MarkFlagRequired("host")
 	_ = MgDeployCmd.MarkFlagRequired("file")
-	_ = MgDeployCmd.MarkFlagRequired("username")
 }
@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	mgGetCmdLiteral   = "get"
+	mgGetCmdShortDesc = "Get resources in the Microgateway"
+	mgGetCmdLongDesc  = "Display a list of resources deployed in the Microgateway of an environment"
+)
+
+const mgGetCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgGetCmdLiteral + " " +
+	mgGetApisCmdLiteral + " -e dev"
+
+// MgGetCmd represents the mg get command
+var MgGetCmd = &cobra.Command{
+	Use:     mgGetCmdLiteral,
+	Short:   mgGetCmdShortDesc,
+	Long:    mgGetCmdLongDesc,
+	Example: mgGetCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + mgCmdLiteral + " " + mgGetCmdLiteral + " called")
+		cmd.Help()
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(MgGetCmd)
+}
@@ -0,0 +1,82 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	mgGetApisEnvironment string
+	mgGetApisType        string
+	mgGetApisLimit       string
+	mgGetApisFormat      string
+)
+
+const (
+	mgGetApisCmdLiteral   = "apis"
+	mgGetApisCmdShortDesc = "Display a list of APIs in the Microgateway"
+	mgGetApisCmdLongDesc  = "Display a list of APIs deployed in the Microgateway of the environment specified by " +
+		"the flag --environment, -e"
+)
+
+const mgGetApisCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgGetCmdLiteral + " " +
+	mgGetApisCmdLiteral + " -e dev\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + mgGetCmdLiteral + " " + mgGetApisCmdLiteral + " -e dev -t http -l 100\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + mgGetCmdLiteral + " " + mgGetApisCmdLiteral +
+	" -e dev --format \"{{ jsonPretty . }}\""
+
+// MgGetApisCmd represents the mg get apis command
+var MgGetApisCmd = &cobra.Command{
+	Use:     mgGetApisCmdLiteral,
+	Short:   mgGetApisCmdShortDesc,
+	Long:    mgGetApisCmdLongDesc,
+	Example: mgGetApisCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + mgGetCmdLiteral + " " + mgGetApisCmdLiteral + " called")
+		cred, err := credentials.GetMGCredentials(mgGetApisEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		apiList, err := impl.GetMgwAPIList(mgGetApisEnvironment, credentials.GetMgwBasicAuth(cred), mgGetApisType,
+			mgGetApisLimit)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the list of APIs in the Microgateway", err)
+		}
+		impl.PrintMgwAPIs(os.Stdout, apiList.List, mgGetApisFormat)
+	},
+}
+
+func init() {
+	MgGetCmd.AddCommand(MgGetApisCmd)
+	MgGetApisCmd.Flags().StringVarP(&mgGetApisEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter")
+	MgGetApisCmd.Flags().StringVarP(&mgGetApisType, "type", "t", "", "Type of the APIs to list (http, ws)")
+	MgGetApisCmd.Flags().StringVarP(&mgGetApisLimit, "limit", "l", strconv.Itoa(utils.DefaultApisDisplayLimit),
+		"Maximum number of apis to return")
+	MgGetApisCmd.Flags().StringVarP(&mgGetApisFormat, "format", "", "", "Pretty-print apis "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	_ = MgGetApisCmd.MarkFlagRequired("environment")
+}
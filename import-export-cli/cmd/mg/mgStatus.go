@@ -0,0 +1,72 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	mgStatusEnvironment string
+	mgStatusFormat      string
+)
+
+const (
+	mgStatusCmdLiteral   = "status"
+	mgStatusCmdShortDesc = "Display the status of the Microgateway"
+	mgStatusCmdLongDesc  = "Display the health of the Microgateway adapter of an environment. Exits with a " +
+		"non-zero code if the adapter is not reachable or not healthy"
+)
+
+const mgStatusCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgStatusCmdLiteral + " -e dev\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + mgStatusCmdLiteral + " -e dev --format \"{{ jsonPretty . }}\""
+
+// MgStatusCmd represents the mg status command
+var MgStatusCmd = &cobra.Command{
+	Use:     mgStatusCmdLiteral + " --environment [environment]",
+	Short:   mgStatusCmdShortDesc,
+	Long:    mgStatusCmdLongDesc,
+	Example: mgStatusCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + mgCmdLiteral + " " + mgStatusCmdLiteral + " called")
+		cred, err := credentials.GetMGCredentials(mgStatusEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		status := impl.GetMgwStatus(mgStatusEnvironment, credentials.GetMgwBasicAuth(cred))
+		impl.PrintMgwStatus(os.Stdout, status, mgStatusFormat)
+		if !status.IsUp() {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(MgStatusCmd)
+	MgStatusCmd.Flags().StringVarP(&mgStatusEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter")
+	MgStatusCmd.Flags().StringVarP(&mgStatusFormat, "format", "", "", "Pretty-print the status "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	_ = MgStatusCmd.MarkFlagRequired("environment")
+}
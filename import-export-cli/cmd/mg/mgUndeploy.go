@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	mgUndeployCmdLiteral   = "undeploy"
+	mgUndeployCmdShortDesc = "Undeploy resources from the Microgateway"
+	mgUndeployCmdLongDesc  = "Undeploy resources from the Microgateway of an environment"
+)
+
+const mgUndeployCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgUndeployCmdLiteral + " " +
+	mgUndeployApiCmdLiteral + " -n PetstoreAPI -v 1.0.0 -e dev"
+
+// MgUndeployCmd represents the mg undeploy command
+var MgUndeployCmd = &cobra.Command{
+	Use:     mgUndeployCmdLiteral,
+	Short:   mgUndeployCmdShortDesc,
+	Long:    mgUndeployCmdLongDesc,
+	Example: mgUndeployCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + mgCmdLiteral + " " + mgUndeployCmdLiteral + " called")
+		cmd.Help()
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(MgUndeployCmd)
+}
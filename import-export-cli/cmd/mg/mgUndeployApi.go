@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	mgUndeployApiEnvironment string
+	mgUndeployApiName        string
+	mgUndeployApiVersion     string
+	mgUndeployApiVhost       string
+)
+
+const (
+	mgUndeployApiCmdLiteral   = "api"
+	mgUndeployApiCmdShortDesc = "Undeploy an API from the Microgateway"
+	mgUndeployApiCmdLongDesc  = "Undeploy an API with the given name and version from the Microgateway of an environment"
+)
+
+const mgUndeployApiCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgUndeployCmdLiteral + " " +
+	mgUndeployApiCmdLiteral + " -n PetstoreAPI -v 1.0.0 -e dev\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + mgUndeployCmdLiteral + " " + mgUndeployApiCmdLiteral +
+	" -n PetstoreAPI -v 1.0.0 --vhost pets.wso2.com -e dev"
+
+// MgUndeployApiCmd represents the mg undeploy api command
+var MgUndeployApiCmd = &cobra.Command{
+	Use:     mgUndeployApiCmdLiteral + " --name [API name] --version [API version] --environment [environment]",
+	Short:   mgUndeployApiCmdShortDesc,
+	Long:    mgUndeployApiCmdLongDesc,
+	Example: mgUndeployApiCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + mgUndeployCmdLiteral + " " + mgUndeployApiCmdLiteral + " called")
+		cred, err := credentials.GetMGCredentials(mgUndeployApiEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		err = impl.UndeployMgwAPI(mgUndeployApiEnvironment, credentials.GetMgwBasicAuth(cred), mgUndeployApiName,
+			mgUndeployApiVersion, mgUndeployApiVhost)
+		if err != nil {
+			utils.HandleErrorAndExit("Error undeploying API "+mgUndeployApiName+" "+mgUndeployApiVersion, err)
+		}
+		fmt.Printf("API %s %s undeployed from the Microgateway in %s\n", mgUndeployApiName, mgUndeployApiVersion,
+			mgUndeployApiEnvironment)
+	},
+}
+
+func init() {
+	MgUndeployCmd.AddCommand(MgUndeployApiCmd)
+	MgUndeployApiCmd.Flags().StringVarP(&mgUndeployApiName, "name", "n", "", "Name of the API to be undeployed")
+	MgUndeployApiCmd.Flags().StringVarP(&mgUndeployApiVersion, "version", "v", "",
+		"Version of the API to be undeployed")
+	MgUndeployApiCmd.Flags().StringVar(&mgUndeployApiVhost, "vhost", "", "Virtual host of the API to be undeployed")
+	MgUndeployApiCmd.Flags().StringVarP(&mgUndeployApiEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter")
+	_ = MgUndeployApiCmd.MarkFlagRequired("name")
+	_ = MgUndeployApiCmd.MarkFlagRequired("version")
+	_ = MgUndeployApiCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,65 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	mgUpdateEnvironment string
+	mgUpdateAPIFile     string
+	mgUpdateSkipCleanup bool
+)
+
+const (
+	mgUpdateCmdLiteral   = "update"
+	mgUpdateCmdShortDesc = "Update an API"
+	mgUpdateCmdLongDesc  = "Update an API (apictl project) deployed in the Microgateway. The API with the same name " +
+		"and version is overridden by the project"
+)
+
+const mgUpdateCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + mgUpdateCmdLiteral + " -e dev " +
+	"-f qa/TwitterAPI.zip"
+
+// MgUpdateCmd represents the mg update command
+var MgUpdateCmd = &cobra.Command{
+	Use:     mgUpdateCmdLiteral + " --environment [environment] --file [file name]",
+	Short:   mgUpdateCmdShortDesc,
+	Long:    mgUpdateCmdLongDesc,
+	Example: mgUpdateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + mgCmdLiteral + " " + mgUpdateCmdLiteral + " called")
+		deployMgwAPI(mgUpdateEnvironment, mgUpdateAPIFile, true, mgUpdateSkipCleanup)
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(MgUpdateCmd)
+	MgUpdateCmd.Flags().StringVarP(&mgUpdateAPIFile, "file", "f", "",
+		"Provide the filepath of the apictl project to be imported")
+	MgUpdateCmd.Flags().StringVarP(&mgUpdateEnvironment, "environment", "e", "",
+		"Environment of the Microgateway adapter in which the API should be updated")
+	MgUpdateCmd.Flags().BoolVarP(&mgUpdateSkipCleanup, "skipCleanup", "", false, "Leave "+
+		"all temporary files created during import process")
+
+	_ = MgUpdateCmd.MarkFlagRequired("environment")
+	_ = MgUpdateCmd.MarkFlagRequired("file")
+}
@@ -29,6 +29,7 @@ const addCmdShortDesc = "Add new users or loggers to a Micro Integrator instance
 const addCmdLongDesc = "Add new users or loggers to a Micro Integrator instance in the environment specified by the flag (--environment, -e)"
 
 const addCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " " + addCmdLiteral + " " + "user" + " capp-developer -e dev\n" +
+	utils.ProjectName + " " + utils.MiCmdLiteral + " " + addCmdLiteral + " " + "users" + " --from-file users.yaml -e dev\n" +
 	utils.ProjectName + " " + utils.MiCmdLiteral + " " + addCmdLiteral + " " + "log-level" + " synapse-api org.apache.synapse.rest.API DEBUG -e dev"
 
 // AddCmd represents the add command
@@ -22,17 +22,17 @@ import (
 	"bufio"
 	"fmt"
 	"os"
-	"syscall"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
-	"golang.org/x/crypto/ssh/terminal"
 )
 
 var addUserCmdEnvironment string
+var addUserCmdDomain string
 
 const addUserCmdLiteral = "user [user-name]"
 const addUserCmdShortDesc = "Add new user to a Micro Integrator"
@@ -49,6 +49,8 @@ var addUserCmd = &cobra.Command{
 	Long:    addUserCmdLongDesc,
 	Example: addUserCmdExamples,
 	Args:    cobra.ExactArgs(1),
+	// the user details are read once and passed to each node of a cluster
+	Annotations: map[string]string{impl.MIClusterAnnotation: impl.MIClusterManual},
 	Run: func(cmd *cobra.Command, args []string) {
 		handleAddUserCmdArguments(args)
 	},
@@ -57,40 +59,60 @@ var addUserCmd = &cobra.Command{
 func init() {
 	AddCmd.AddCommand(addUserCmd)
 	addUserCmd.Flags().StringVarP(&addUserCmdEnvironment, "environment", "e", "", "Environment of the micro integrator to which a new user should be added")
+	addUserCmd.Flags().StringVarP(&addUserCmdDomain, "domain", "d", "", "User store domain to which the user should be added. The primary user store is used if not given")
 	addUserCmd.MarkFlagRequired("environment")
 }
 
 func handleAddUserCmdArguments(args []string) {
 	printAddCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(addUserCmdLiteral))
+	if impl.IsMIClusterEnv(addUserCmdEnvironment) {
+		startConsoleToAddUserToNodes(args[0])
+		return
+	}
 	credentials.HandleMissingCredentials(addUserCmdEnvironment)
 	startConsoleToAddUser(args[0])
 }
 
 func startConsoleToAddUser(userName string) {
-	reader := bufio.NewReader(os.Stdin)
-
-	fmt.Printf("Is " + userName + " an admin [y/N]: ")
-	isAdmin, _ := reader.ReadString('\n')
-
-	fmt.Printf("Enter password for " + userName + ": ")
-	byteUserPassword, _ := terminal.ReadPassword(int(syscall.Stdin))
-	userPassword := string(byteUserPassword)
-	fmt.Println()
-
-	fmt.Printf("Re-Enter password for " + userName + ": ")
-	byteUserConfirmationPassword, _ := terminal.ReadPassword(int(syscall.Stdin))
-	userConfirmPassword := string(byteUserConfirmationPassword)
-	fmt.Println()
-
-	if userConfirmPassword == userPassword {
+	isAdmin, userPassword, matched := readNewUserDetails(userName)
+	if matched {
 		executeAddNewUser(userName, userPassword, isAdmin)
 	} else {
 		fmt.Println("Passwords are not matching.")
 	}
 }
 
+// startConsoleToAddUserToNodes reads the user details once and adds the user to each node of the cluster
+func startConsoleToAddUserToNodes(userName string) {
+	isAdmin, userPassword, matched := readNewUserDetails(userName)
+	if !matched {
+		fmt.Println("Passwords are not matching.")
+		return
+	}
+	input := []byte(strings.TrimSpace(isAdmin) + "\n" + userPassword + "\n" + userPassword + "\n")
+	results := impl.RunOnMINodes(addUserCmdEnvironment, os.Args[1:], input)
+	if impl.PrintMINodeResults(os.Stdout, addUserCmdEnvironment, results) > 0 {
+		os.Exit(1)
+	}
+}
+
+// readNewUserDetails prompts for the details of the new user. The details are read without prompting when they are
+// piped to the command, which is how they are passed to the nodes of a cluster
+func readNewUserDetails(userName string) (isAdmin, userPassword string, matched bool) {
+	reader := bufio.NewReader(os.Stdin)
+
+	if miUtils.IsInteractive() {
+		fmt.Printf("Is " + userName + " an admin [y/N]: ")
+	}
+	isAdmin, _ = reader.ReadString('\n')
+
+	userPassword = miUtils.ReadPassword(reader, "Enter password for "+userName+": ")
+	userConfirmPassword := miUtils.ReadPassword(reader, "Re-Enter password for "+userName+": ")
+	return isAdmin, userPassword, userConfirmPassword == userPassword
+}
+
 func executeAddNewUser(userName, userPassword, isAdmin string) {
-	resp, err := impl.AddMIUser(addUserCmdEnvironment, userName, userPassword, isAdmin)
+	resp, err := impl.AddMIUserToDomain(addUserCmdEnvironment, userName, userPassword, isAdmin, addUserCmdDomain)
 	if err != nil {
 		fmt.Println(utils.LogPrefixError+"Adding new user [ "+userName+" ]", err)
 	} else {
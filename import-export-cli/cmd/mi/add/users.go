@@ -0,0 +1,97 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package add
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var addUsersCmdEnvironment string
+var addUsersCmdFile string
+var addUsersCmdDryRun bool
+var addUsersCmdFormat string
+
+const addUsersCmdLiteral = "users"
+const addUsersCmdShortDesc = "Add users in bulk to a Micro Integrator"
+
+const addUsersCmdLongDesc = "Add the users defined in the file specified by the flag --from-file to a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"Users which already exist are skipped. The roles of the users are assigned after they are added. " +
+	"Environment variables in the file (eg: $CAPP_TESTER_PASSWORD) are substituted. The file is in the format,\n" +
+	"users:\n" +
+	"  - userId: capp-tester\n" +
+	"    password: $CAPP_TESTER_PASSWORD\n" +
+	"    isAdmin: false\n" +
+	"    domain: SECONDARY\n" +
+	"    roles: [tester, developer]"
+
+var addUsersCmdExamples = "To add the users in a file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + addCmdLiteral + " " + addUsersCmdLiteral + " --from-file users.yaml -e dev\n" +
+	"To list the users which would be added without adding them\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + addCmdLiteral + " " + addUsersCmdLiteral + " --from-file users.yaml -e dev --dry-run\n" +
+	"NOTE: The flags (--environment (-e)) and (--from-file) are mandatory"
+
+var addUsersCmd = &cobra.Command{
+	Use:     addUsersCmdLiteral,
+	Short:   addUsersCmdShortDesc,
+	Long:    addUsersCmdLongDesc,
+	Example: addUsersCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleAddUsersCmdArguments()
+	},
+}
+
+func init() {
+	AddCmd.AddCommand(addUsersCmd)
+	addUsersCmd.Flags().StringVarP(&addUsersCmdEnvironment, "environment", "e", "", "Environment of the micro integrator to which the users should be added")
+	addUsersCmd.Flags().StringVarP(&addUsersCmdFile, "from-file", "f", "", "YAML file with the users to be added")
+	addUsersCmd.Flags().BoolVar(&addUsersCmdDryRun, "dry-run", false, "Print the users which would be added without adding them")
+	addUsersCmd.Flags().StringVarP(&addUsersCmdFormat, "format", "", "", "Pretty-print the results using Go Templates. "+
+		"Use \"{{ jsonPretty . }}\" to list all fields")
+	addUsersCmd.MarkFlagRequired("environment")
+	addUsersCmd.MarkFlagRequired("from-file")
+}
+
+func handleAddUsersCmdArguments() {
+	printAddCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(addUsersCmdLiteral))
+	users, err := impl.ReadMIUsersFile(addUsersCmdFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the users", err)
+	}
+	credentials.HandleMissingCredentials(addUsersCmdEnvironment)
+	results := impl.ProvisionMIUsers(addUsersCmdEnvironment, users, addUsersCmdDryRun)
+	impl.PrintUserProvisioningResults(os.Stdout, results, addUsersCmdFormat)
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == impl.UserProvisioningFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		utils.HandleErrorAndExit(fmt.Sprintf("%d of %d users could not be added", failed, len(results)), nil)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mi
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// clusterCmdLiterals are the commands of which the sub commands are run on each node of a Micro Integrator cluster
+var clusterCmdLiterals = map[string]bool{
+	"get": true, "add": true, "delete": true, "update": true, "activate": true, "deactivate": true,
+	"deploy": true, "undeploy": true,
+}
+
+// runOnMICluster runs the command on each node in parallel when the environment is a Micro Integrator cluster,
+// prints the aggregated output of the nodes and exits without running the command on the cluster itself
+func runOnMICluster(cmd *cobra.Command, args []string) {
+	envFlag := cmd.Flags().Lookup("environment")
+	if envFlag == nil || !impl.IsMIClusterEnv(envFlag.Value.String()) {
+		return
+	}
+	env := envFlag.Value.String()
+	if cmd.Annotations[impl.MIClusterAnnotation] == impl.MIClusterSkip || !clusterCmdLiterals[cmd.Parent().Name()] {
+		utils.HandleErrorAndExit("'"+cmd.CommandPath()+"' cannot be run on all the nodes of "+env+
+			". Use "+utils.GetMINodeEnvName(env, "[node]")+" to run it on a single node", nil)
+	}
+	if cmd.Annotations[impl.MIClusterAnnotation] == impl.MIClusterManual {
+		return
+	}
+	utils.Logln(utils.LogPrefixInfo + "Running " + cmd.CommandPath() + " on the nodes of " + env)
+	results := impl.RunOnMINodes(env, os.Args[1:], nil)
+	if impl.PrintMINodeResults(os.Stdout, env, results) > 0 {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -20,23 +20,36 @@ package deactivate
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var deactivateMessageProcessorCmdEnvironment string
+var deactivateMessageProcessorCmdFor time.Duration
 
 const artifactMessageProcessor = "message processor"
 const deactivateMessageProcessorCmdLiteral = "message-processor [messageprocessor-name]"
 
+var deactivateMessageProcessorCmdLongDesc = generateDeactivateCmdLongDescForArtifact(artifactMessageProcessor, "messageprocessor-name") + "\n" +
+	"Use --for to pause the message processor. It is activated again after the given duration, or when the command is interrupted"
+
+var deactivateMessageProcessorCmdExamples = generateDeactivateCmdExamplesForArtifact(artifactMessageProcessor, miUtils.GetTrimmedCmdLiteral(deactivateMessageProcessorCmdLiteral), "TestMessageProcessor") + "\n" +
+	"To pause a message processor for 10 minutes\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + deactivateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(deactivateMessageProcessorCmdLiteral) + " TestMessageProcessor -e dev --for 10m"
+
 var deactivateMessageProcessorCmd = &cobra.Command{
 	Use:     deactivateMessageProcessorCmdLiteral,
 	Short:   generateDeactivateCmdShortDescForArtifact(artifactMessageProcessor),
-	Long:    generateDeactivateCmdLongDescForArtifact(artifactMessageProcessor, "messageprocessor-name"),
-	Example: generateDeactivateCmdExamplesForArtifact(artifactMessageProcessor, miUtils.GetTrimmedCmdLiteral(deactivateMessageProcessorCmdLiteral), "TestMessageProcessor"),
+	Long:    deactivateMessageProcessorCmdLongDesc,
+	Example: deactivateMessageProcessorCmdExamples,
 	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		handleDeactivateMessageProcessorCmdArguments(args)
@@ -46,19 +59,42 @@ var deactivateMessageProcessorCmd = &cobra.Command{
 func init() {
 	DeactivateCmd.AddCommand(deactivateMessageProcessorCmd)
 	setEnvFlag(deactivateMessageProcessorCmd, &deactivateMessageProcessorCmdEnvironment, artifactMessageProcessor)
+	deactivateMessageProcessorCmd.Flags().DurationVar(&deactivateMessageProcessorCmdFor, "for", 0,
+		"Activate the message processor again after the duration (eg: 10m)")
 }
 
 func handleDeactivateMessageProcessorCmdArguments(args []string) {
 	printDeactivateCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(deactivateMessageProcessorCmdLiteral))
+	if deactivateMessageProcessorCmdFor < 0 {
+		utils.HandleErrorAndExit("--for should be greater than 0", nil)
+	}
 	credentials.HandleMissingCredentials(deactivateMessageProcessorCmdEnvironment)
-	executeDeactivateMessageProcessor(args[0])
+	if executeDeactivateMessageProcessor(args[0]) && deactivateMessageProcessorCmdFor > 0 {
+		executeActivateMessageProcessorAfterPause(args[0])
+	}
 }
 
-func executeDeactivateMessageProcessor(messageProcessorName string) {
+func executeDeactivateMessageProcessor(messageProcessorName string) bool {
 	resp, err := impl.DeactivateMessageProcessor(deactivateMessageProcessorCmdEnvironment, messageProcessorName)
 	if err != nil {
 		printErrorForArtifact(artifactMessageProcessor, messageProcessorName, err)
-	} else {
-		fmt.Println(resp)
+		return false
+	}
+	fmt.Println(resp)
+	return true
+}
+
+func executeActivateMessageProcessorAfterPause(messageProcessorName string) {
+	fmt.Println("Message processor [ "+messageProcessorName+" ] will be activated at",
+		time.Now().Add(deactivateMessageProcessorCmdFor).Format("15:04:05")+". Press Ctrl+C to activate it now")
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	resp, err := impl.ActivateMessageProcessorAfter(deactivateMessageProcessorCmdEnvironment, messageProcessorName,
+		deactivateMessageProcessorCmdFor, interrupt)
+	if err != nil {
+		utils.HandleErrorAndExit("Error activating message processor [ "+messageProcessorName+" ]", err)
 	}
+	fmt.Println(resp)
 }
@@ -29,6 +29,7 @@ import (
 )
 
 var deleteUserCmdEnvironment string
+var deleteUserCmdDomain string
 
 const deleteUserCmdLiteral = "user [user-name]"
 const deleteUserCmdShortDesc = "Delete a user from the Micro Integrator"
@@ -53,6 +54,7 @@ var deleteUserCmd = &cobra.Command{
 func init() {
 	DeleteCmd.AddCommand(deleteUserCmd)
 	deleteUserCmd.Flags().StringVarP(&deleteUserCmdEnvironment, "environment", "e", "", "Environment of the micro integrator from which a user should be deleted")
+	deleteUserCmd.Flags().StringVarP(&deleteUserCmdDomain, "domain", "d", "", "User store domain of the user. The primary user store is used if not given")
 	deleteUserCmd.MarkFlagRequired("environment")
 }
 
@@ -63,7 +65,7 @@ func handledeleteUserCmdArguments(args []string) {
 }
 
 func executeDeleteUser(userName string) {
-	resp, err := impl.DeleteMIUser(deleteUserCmdEnvironment, userName)
+	resp, err := impl.DeleteMIUserFromDomain(deleteUserCmdEnvironment, userName, deleteUserCmdDomain)
 	if err != nil {
 		fmt.Println(utils.LogPrefixError+"deleting user [ "+userName+" ]", err)
 	} else {
@@ -0,0 +1,120 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package deploy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deployCAppCmdEnvironment string
+var deployCAppCmdWait bool
+var deployCAppCmdTimeout int
+
+// deployCAppPollInterval interval between the checks for the deployment of a carbon application
+const deployCAppPollInterval = 2 * time.Second
+
+const deployCAppCmdLiteral = "capp [file-or-directory]"
+const deployCAppCmdShortDesc = "Deploy a carbon application to a Micro Integrator"
+
+const deployCAppCmdLongDesc = "Deploy the carbon application archive specified by the command line argument [file-or-directory] to a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"If a directory is given, all the carbon application archives (.car) in it are deployed. " +
+	"Use --wait to wait until the artifacts of the carbon applications are deployed. " +
+	"A carbon application already deployed is redeployed with --wait only if the log file wso2carbon.log of the Micro Integrator can be read, since the redeployment is detected from the log"
+
+var deployCAppCmdExamples = "To deploy a carbon application\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + deployCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(deployCAppCmdLiteral) + " HealthCareCompositeApplication_1.0.0.car -e dev\n" +
+	"To deploy all the carbon applications in a directory and wait until they are deployed\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + deployCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(deployCAppCmdLiteral) + " ./capps -e dev --wait --timeout 120\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var deployCAppCmd = &cobra.Command{
+	Use:     deployCAppCmdLiteral,
+	Short:   deployCAppCmdShortDesc,
+	Long:    deployCAppCmdLongDesc,
+	Example: deployCAppCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleDeployCAppCmdArguments(args)
+	},
+}
+
+func init() {
+	DeployCmd.AddCommand(deployCAppCmd)
+	deployCAppCmd.Flags().StringVarP(&deployCAppCmdEnvironment, "environment", "e", "", "Environment of the micro integrator to which the carbon application should be deployed")
+	deployCAppCmd.Flags().BoolVarP(&deployCAppCmdWait, "wait", "w", false, "Wait until the artifacts of the carbon applications are deployed")
+	deployCAppCmd.Flags().IntVar(&deployCAppCmdTimeout, "timeout", 60, "Maximum time in seconds to wait for each carbon application with --wait")
+	deployCAppCmd.MarkFlagRequired("environment")
+}
+
+func handleDeployCAppCmdArguments(args []string) {
+	printDeployCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(deployCAppCmdLiteral))
+	carbonApps, err := impl.GetCarbonAppFiles(args[0])
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading carbon applications", err)
+	}
+	credentials.HandleMissingCredentials(deployCAppCmdEnvironment)
+	if failed := executeDeployCApps(carbonApps); failed > 0 {
+		utils.HandleErrorAndExit(fmt.Sprintf("%d of %d carbon applications could not be deployed", failed, len(carbonApps)), nil)
+	}
+}
+
+// executeDeployCApps deploys the carbon applications one after the other and returns the number of failures
+func executeDeployCApps(carbonApps []string) int {
+	failed := 0
+	for _, carbonApp := range carbonApps {
+		name, version := impl.GetCarbonAppNameAndVersion(carbonApp)
+		var previousState *impl.CarbonAppState
+		if deployCAppCmdWait {
+			// the state before deploying is required to detect a redeployment of the same carbon application
+			var err error
+			previousState, err = impl.GetCarbonAppState(deployCAppCmdEnvironment, name, version)
+			if err != nil {
+				fmt.Println(utils.LogPrefixError+"Getting the state of carbon application [ "+name+" ]", err)
+				failed++
+				continue
+			}
+		}
+		resp, err := impl.DeployCarbonApp(deployCAppCmdEnvironment, carbonApp)
+		if err != nil {
+			fmt.Println(utils.LogPrefixError+"Deploying carbon application [ "+carbonApp+" ]", err)
+			failed++
+			continue
+		}
+		fmt.Println("Deploying carbon application [ "+carbonApp+" ] status:", resp)
+		if !deployCAppCmdWait {
+			continue
+		}
+		err = impl.WaitForCarbonAppDeployment(deployCAppCmdEnvironment, name, version, previousState,
+			time.Duration(deployCAppCmdTimeout)*time.Second, deployCAppPollInterval)
+		if err != nil {
+			fmt.Println(utils.LogPrefixError+"Deploying carbon application [ "+carbonApp+" ]", err)
+			failed++
+			continue
+		}
+		fmt.Println("Carbon application [ " + name + " ] deployed")
+	}
+	return failed
+}
@@ -0,0 +1,88 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mi
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var diffCmdEnvironments []string
+var diffCmdFormat string
+
+const diffCmdLiteral = "diff [snapshot-a] [snapshot-b]"
+const diffCmdShortDesc = "Compare the artifacts of two Micro Integrators"
+const diffCmdLongDesc = "Compare two snapshots taken with the snapshot command, or the artifacts deployed in the Micro " +
+	"Integrators of two environments given with --env. Snapshot files are compared before environments.\n" +
+	"Artifacts of the first which are not in the second are reported as missing, artifacts only in the second as " +
+	"extra and artifacts with a different version or state as changed. The command exits with 1 if there are differences"
+const diffCmdExamples = "To compare two snapshots\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " diff release.json prod.json\n" +
+	"To compare two environments\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " diff --env dev --env prod\n" +
+	"To compare a snapshot with an environment\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " diff release.json --env prod"
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:     diffCmdLiteral,
+	Short:   diffCmdShortDesc,
+	Long:    diffCmdLongDesc,
+	Example: diffCmdExamples,
+	Args:    cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + utils.MiCmdLiteral + " diff called")
+		if len(args)+len(diffCmdEnvironments) != 2 {
+			utils.HandleErrorAndExit("Two snapshots or environments should be given to compare", nil)
+		}
+		var snapshots []*impl.MISnapshot
+		for _, snapshotFile := range args {
+			snapshot, err := impl.ReadMISnapshot(snapshotFile)
+			if err != nil {
+				utils.HandleErrorAndExit("Error reading the snapshot", err)
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		for _, env := range diffCmdEnvironments {
+			credentials.HandleMissingCredentials(env)
+			snapshot, err := impl.GetMISnapshot(env)
+			if err != nil {
+				utils.HandleErrorAndExit("Error taking a snapshot of "+env, err)
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		differences := impl.DiffMISnapshots(snapshots[0], snapshots[1])
+		impl.PrintMISnapshotDiff(os.Stdout, differences, diffCmdFormat)
+		if len(differences) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	MICmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringArrayVar(&diffCmdEnvironments, "env", nil, "Environment of a Micro Integrator to compare")
+	diffCmd.Flags().StringVarP(&diffCmdFormat, "format", "", "", "Pretty-print the differences using Go Templates. "+
+		"Use \"{{ jsonPretty . }}\" to list all fields")
+}
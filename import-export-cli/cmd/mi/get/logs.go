@@ -46,11 +46,12 @@ var getLogCmdExamples = "Example:\n" +
 	"NOTE: The flag (--environment (-e)) is mandatory"
 
 var getLogCmd = &cobra.Command{
-	Use:     getLogCmdLiteral,
-	Short:   getLogCmdShortDesc,
-	Long:    getLogCmdLongDesc,
-	Example: getLogCmdExamples,
-	Args:    cobra.MaximumNArgs(1),
+	Use:         getLogCmdLiteral,
+	Short:       getLogCmdShortDesc,
+	Long:        getLogCmdLongDesc,
+	Example:     getLogCmdExamples,
+	Args:        cobra.MaximumNArgs(1),
+	Annotations: map[string]string{impl.MIClusterAnnotation: impl.MIClusterSkip},
 	Run: func(cmd *cobra.Command, args []string) {
 		handleGetLogCmdArguments(args)
 	},
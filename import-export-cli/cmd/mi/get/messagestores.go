@@ -19,23 +19,37 @@
 package get
 
 import (
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getMessageStoreCmdEnvironment string
 var getMessageStoreCmdFormat string
+var getMessageStoreCmdWatch bool
+var getMessageStoreCmdInterval time.Duration
+var getMessageStoreCmdCount int
 
 const artifactMessageStores = "message stores"
 const getMessageStoreCmdLiteral = "message-stores [messagestore-name]"
 
+var getMessageStoreCmdLongDesc = generateGetCmdLongDescForArtifact(artifactMessageStores, "messagestore-name") + "\n" +
+	"Use --watch to print the number of messages in the message stores and the change since the previous poll over time"
+
+var getMessageStoreCmdExamples = generateGetCmdExamplesForArtifact(artifactMessageStores, miUtils.GetTrimmedCmdLiteral(getMessageStoreCmdLiteral), "TestMessageStore") + "\n" +
+	"To watch the number of messages in a message store every 10 seconds\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getMessageStoreCmdLiteral) + " TestMessageStore -e dev --watch --interval 10s"
+
 var getMessageStoreCmd = &cobra.Command{
 	Use:     getMessageStoreCmdLiteral,
 	Short:   generateGetCmdShortDescForArtifact(artifactMessageStores),
-	Long:    generateGetCmdLongDescForArtifact(artifactMessageStores, "messagestore-name"),
-	Example: generateGetCmdExamplesForArtifact(artifactMessageStores, miUtils.GetTrimmedCmdLiteral(getMessageStoreCmdLiteral), "TestMessageStore"),
+	Long:    getMessageStoreCmdLongDesc,
+	Example: getMessageStoreCmdExamples,
 	Args:    cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		handleGetMessageStoreCmdArguments(args)
@@ -46,12 +60,20 @@ func init() {
 	GetCmd.AddCommand(getMessageStoreCmd)
 	setEnvFlag(getMessageStoreCmd, &getMessageStoreCmdEnvironment)
 	setFormatFlag(getMessageStoreCmd, &getMessageStoreCmdFormat)
+	getMessageStoreCmd.Flags().BoolVarP(&getMessageStoreCmdWatch, "watch", "w", false,
+		"Keep printing the number of messages in the message stores")
+	getMessageStoreCmd.Flags().DurationVar(&getMessageStoreCmdInterval, "interval", 5*time.Second,
+		"Interval to poll the message stores with --watch")
+	getMessageStoreCmd.Flags().IntVar(&getMessageStoreCmdCount, "count", 0,
+		"Number of polls with --watch. Polls until interrupted if not given")
 }
 
 func handleGetMessageStoreCmdArguments(args []string) {
 	printGetCmdVerboseLogForArtifact(miUtils.GetTrimmedCmdLiteral(getMessageStoreCmdLiteral))
 	credentials.HandleMissingCredentials(getMessageStoreCmdEnvironment)
-	if len(args) == 1 {
+	if getMessageStoreCmdWatch {
+		executeWatchMessageStores(args)
+	} else if len(args) == 1 {
 		var messageStoreName = args[0]
 		executeShowMessageStore(messageStoreName)
 	} else {
@@ -76,3 +98,14 @@ func executeShowMessageStore(messageStoreName string) {
 		printErrorForArtifact(artifactMessageStores, messageStoreName, err)
 	}
 }
+
+func executeWatchMessageStores(names []string) {
+	if getMessageStoreCmdInterval <= 0 {
+		utils.HandleErrorAndExit("--interval should be greater than 0", nil)
+	}
+	err := impl.WatchMessageStoreSizes(os.Stdout, getMessageStoreCmdEnvironment, names, getMessageStoreCmdFormat,
+		getMessageStoreCmdInterval, getMessageStoreCmdCount)
+	if err != nil {
+		printErrorForArtifactList(artifactMessageStores, err)
+	}
+}
@@ -20,7 +20,10 @@ package get
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
@@ -29,15 +32,21 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
-var getTransactionReportCmdEnvironment string
+var getTransactionReportCmdEnvironments []string
 var transactionReportPath string
+var transactionReportOutput string
+var transactionReportAggregation string
+var transactionReportFormat string
 
 const getTransactionReportCmdLiteral = "transaction-reports [start] [end]"
 
 const getTransactionReportCmdShortDesc = "Generate transaction count summary report"
 const getTransactionReportCmdLongDesc = "Generate the transaction count summary report at the given location for the " +
 	"given period of time.\nIf a location not provided, generate the report in current directory.\nIf an end date " +
-	"not provided, generate the report with values upto current date of the Micro Integrator in the environment specified by the flag --environment, -e"
+	"not provided, generate the report with values upto current date of the Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"The counts can be summed for each day or month with --aggregate. Monthly reports contain the change from the previous month.\n" +
+	"Reports of several environments, given by repeating --environment, are merged into a single report.\n" +
+	"Reports in json or yaml format, in a table or formatted with a Go template are printed unless --path or --output is given"
 
 var getTransactionReportCmdExamples = "Example:\n" +
 	"To generate transaction count report consisting data within a specified time period at a specified location\n" +
@@ -46,6 +55,10 @@ var getTransactionReportCmdExamples = "Example:\n" +
 	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 -p </dir_path> -e dev\n" +
 	"To generate transaction count report at the current location with data between 2020-01 and 2020-05\n" +
 	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 2020-05 -e dev\n" +
+	"To print the monthly transaction counts of two environments with the change from the previous month\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 2020-05 -e dev -e prod --aggregate monthly --format table\n" +
+	"To write the daily transaction counts as json to a given file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 2020-05 -e dev --aggregate daily -o transactions.json\n" +
 	"NOTE: The [start] argument and the flag (--environment (-e)) is mandatory"
 
 var getTransactionReportCmd = &cobra.Command{
@@ -61,29 +74,114 @@ var getTransactionReportCmd = &cobra.Command{
 
 func init() {
 	GetCmd.AddCommand(getTransactionReportCmd)
-	setEnvFlag(getTransactionReportCmd, &getTransactionReportCmdEnvironment)
+	getTransactionReportCmd.Flags().StringArrayVarP(&getTransactionReportCmdEnvironments, "environment", "e", nil,
+		"Environment to be searched. Repeat the flag to merge the reports of several environments")
+	getTransactionReportCmd.MarkFlagRequired("environment")
 	getTransactionReportCmd.Flags().StringVarP(&transactionReportPath, "path", "p", "", "destination file location")
+	getTransactionReportCmd.Flags().StringVarP(&transactionReportOutput, "output", "o", "",
+		"Name of the report file. A timestamped name is used if not given")
+	getTransactionReportCmd.Flags().StringVarP(&transactionReportAggregation, "aggregate", "a", "",
+		"Sum the transaction counts of each period (daily or monthly)")
+	getTransactionReportCmd.Flags().StringVarP(&transactionReportFormat, "format", "", "",
+		"Format of the report (csv, json, yaml, table or a Go template). "+
+			"Inferred from the extension of --output, csv by default")
 }
 
 func handleGetTransactionReportCmdArguments(args []string) {
 	printGetCmdVerboseLogForArtifact(miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral))
-	credentials.HandleMissingCredentials(getTransactionReportCmdEnvironment)
+	var envs []string
+	for _, env := range getTransactionReportCmdEnvironments {
+		envs = append(envs, getTransactionReportEnv(env))
+	}
 	var start = args[0]
 	var end = ""
 	if len(args) == 2 {
 		end = args[1]
 	}
+	format := getTransactionReportFormat()
+	if isEmptyOrCurrentDir(transactionReportPath) && transactionReportOutput == "" &&
+		format != impl.TransactionReportFormatCSV {
+		writeMergedTransactionReport(os.Stdout, getMergedTransactionReport(envs, start, end), format)
+		return
+	}
 	if isEmptyOrCurrentDir(transactionReportPath) {
 		transactionReportPath, _ = os.Getwd()
 	}
-	executeGetTransactionReport(transactionReportPath, start, end)
+	if format == impl.TransactionReportFormatCSV && transactionReportAggregation == "" && len(envs) == 1 &&
+		transactionReportOutput == "" {
+		executeGetTransactionReport(envs[0], transactionReportPath, start, end)
+		return
+	}
+	report := getMergedTransactionReport(envs, start, end)
+	fileName := transactionReportOutput
+	if fileName == "" {
+		fileName = impl.GetTransactionReportFileName(format)
+	}
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(transactionReportPath, fileName)
+	}
+	file, err := os.Create(fileName)
+	if err != nil {
+		utils.HandleErrorAndExit("Error writing the transaction report", err)
+	}
+	defer file.Close()
+	writeMergedTransactionReport(file, report, format)
+	fmt.Println("Transaction Count Report created in", fileName)
+}
+
+// getTransactionReportEnv returns the environment from which the report is retrieved. The transaction counts of a
+// Micro Integrator cluster are kept in the database shared by the nodes, so the report is retrieved from one node
+func getTransactionReportEnv(env string) string {
+	if !impl.IsMIClusterEnv(env) {
+		credentials.HandleMissingCredentials(env)
+		return env
+	}
+	failedNodes, err := credentials.EnsureMINodeCredentials(env)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	for _, node := range utils.GetMINodesOfEnv(env, utils.MainConfigFilePath) {
+		if _, failed := failedNodes[node]; !failed {
+			return utils.GetMINodeEnvName(env, node)
+		}
+	}
+	utils.HandleErrorAndExit("Unable to login to any node of "+env, nil)
+	return ""
 }
 
-func executeGetTransactionReport(targetDirectory string, period ...string) {
-	transactionReport, err := impl.GetTransactionReport(getTransactionReportCmdEnvironment, period)
+// getTransactionReportFormat returns the format given by --format, or inferred from the extension of --output
+func getTransactionReportFormat() string {
+	if transactionReportFormat != "" {
+		return transactionReportFormat
+	}
+	switch strings.ToLower(filepath.Ext(transactionReportOutput)) {
+	case ".json":
+		return impl.TransactionReportFormatJSON
+	case ".yaml", ".yml":
+		return impl.TransactionReportFormatYAML
+	}
+	return impl.TransactionReportFormatCSV
+}
+
+func executeGetTransactionReport(env, targetDirectory string, period ...string) {
+	transactionReport, err := impl.GetTransactionReport(env, period)
 	if err == nil {
 		impl.WriteTransactionReportAsCSV(transactionReport, targetDirectory)
 	} else {
 		fmt.Println(utils.LogPrefixError+"Retrieving Transaction Reports.", err)
 	}
 }
+
+func getMergedTransactionReport(envs []string, period ...string) *impl.TransactionReport {
+	report, err := impl.GetMergedTransactionReport(envs, period, transactionReportAggregation)
+	if err != nil {
+		utils.HandleErrorAndExit("Error retrieving transaction reports", err)
+	}
+	return report
+}
+
+func writeMergedTransactionReport(w io.Writer, report *impl.TransactionReport, format string) {
+	if err := impl.WriteTransactionReport(w, report, format); err != nil {
+		utils.HandleErrorAndExit("Error writing the transaction report", err)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package get
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getVaultCmdEnvironment string
+var getVaultCmdFormat string
+
+const artifactVaults = "external vaults"
+const getVaultCmdLiteral = "vaults [vault-name]"
+
+const getVaultCmdShortDesc = "Get information about external vaults of a Micro Integrator"
+const getVaultCmdLongDesc = "Get the status of the external vault specified by command line argument [vault-name]\n" +
+	"If not specified, list all the external vaults of which the configuration can be updated through the management API of a Micro Integrator " +
+	"in the environment specified by the flag --environment, -e\n" +
+	"The management API does not expose the connection state of a vault. The status shows whether the configuration of the vault can be updated with " +
+	utils.ProjectName + " " + utils.MiCmdLiteral + " update vault"
+
+var getVaultCmdExamples = "To list all the external vaults\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getVaultCmdLiteral) + " -e dev\n" +
+	"To check the status of the HashiCorp vault\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getVaultCmdLiteral) + " " + utils.MiManagementExternalVaultHashiCorpResource + " -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var getVaultCmd = &cobra.Command{
+	Use:     getVaultCmdLiteral,
+	Short:   getVaultCmdShortDesc,
+	Long:    getVaultCmdLongDesc,
+	Example: getVaultCmdExamples,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleGetVaultCmdArguments(args)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(getVaultCmd)
+	setEnvFlag(getVaultCmd, &getVaultCmdEnvironment)
+	setFormatFlag(getVaultCmd, &getVaultCmdFormat)
+}
+
+func handleGetVaultCmdArguments(args []string) {
+	printGetCmdVerboseLogForArtifact(miUtils.GetTrimmedCmdLiteral(getVaultCmdLiteral))
+	credentials.HandleMissingCredentials(getVaultCmdEnvironment)
+	if len(args) == 1 {
+		var vaultName = args[0]
+		executeShowVault(vaultName)
+	} else {
+		executeListVaults()
+	}
+}
+
+func executeListVaults() {
+	impl.PrintExternalVaultList(impl.GetExternalVaultList(getVaultCmdEnvironment), getVaultCmdFormat)
+}
+
+func executeShowVault(vaultName string) {
+	vault, err := impl.GetExternalVault(getVaultCmdEnvironment, vaultName)
+	if err == nil {
+		impl.PrintExternalVaultDetails(vault, getVaultCmdFormat)
+	} else {
+		printErrorForArtifact(artifactVaults, vaultName, err)
+	}
+}
@@ -32,13 +32,17 @@ import (
 var loginUsername string
 var loginPassword string
 var loginPasswordStdin bool
+var loginStorePassword bool
 
 const loginCmdLiteral = "login [environment] [flags]"
 const loginCmdShortDesc = "Login to a Micro Integrator"
-const loginCmdLongDesc = `Login to a Micro Integrator using credentials`
+const loginCmdLongDesc = "Login to a Micro Integrator using credentials\n" +
+	"The access token is reused until it expires. The password is stored to renew the access token, " +
+	"unless --store-password=false is given, in which case it is prompted for when the access token expires"
 const loginCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " login dev -u admin -p admin\n" +
 	utils.ProjectName + " " + utils.MiCmdLiteral + " login dev -u admin\n" +
-	"cat ~/.mypassword | " + utils.ProjectName + " " + utils.MiCmdLiteral + " login dev -u admin"
+	"cat ~/.mypassword | " + utils.ProjectName + " " + utils.MiCmdLiteral + " login dev -u admin\n" +
+	"cat ~/.mypassword | " + utils.ProjectName + " " + utils.MiCmdLiteral + " login dev -u admin --password-stdin --store-password=false"
 
 // loginCmd represents the login command
 var loginCmd = &cobra.Command{
@@ -78,7 +82,7 @@ var loginCmd = &cobra.Command{
 			fmt.Println("Error occurred while loading credential store : ", err)
 			os.Exit(1)
 		}
-		err = credentials.RunMILogin(store, environment, loginUsername, loginPassword)
+		err = credentials.RunMILogin(store, environment, loginUsername, loginPassword, loginStorePassword)
 		if err != nil {
 			fmt.Println("Error occurred while login : ", err)
 			os.Exit(1)
@@ -92,4 +96,6 @@ func init() {
 	loginCmd.Flags().StringVarP(&loginUsername, "username", "u", "", "Username for login")
 	loginCmd.Flags().StringVarP(&loginPassword, "password", "p", "", "Password for login")
 	loginCmd.Flags().BoolVarP(&loginPasswordStdin, "password-stdin", "", false, "Get password from stdin")
+	loginCmd.Flags().BoolVarP(&loginStorePassword, "store-password", "", true,
+		"Store the password to renew the access token when it expires")
 }
@@ -0,0 +1,92 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mi
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var logsCmdEnvironment string
+var logsCmdFollow bool
+var logsCmdSince string
+var logsCmdGrep string
+var logsCmdLevel string
+var logsCmdInterval time.Duration
+
+const logsCmdLiteral = "logs [file-name]"
+const logsCmdShortDesc = "Print the logs of a Micro Integrator"
+const logsCmdLongDesc = "Print the lines of a log file (" + impl.DefaultLogFileName + " by default) of the Micro " +
+	"Integrator in the environment specified by the flag --environment, -e\n" +
+	"Use --follow to keep printing the new lines. Rotated log files are followed to the new log file"
+const logsCmdExamples = "To print the logs\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " logs -e dev\n" +
+	"To follow the errors logged in the last 10 minutes\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " logs -e dev --follow --since 10m --level ERROR\n" +
+	"To follow the lines of a log file matching a pattern\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " logs http_access.log -e dev -f --grep \"POST /orders\"\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:     logsCmdLiteral,
+	Short:   logsCmdShortDesc,
+	Long:    logsCmdLongDesc,
+	Example: logsCmdExamples,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + utils.MiCmdLiteral + " logs called")
+		fileName := impl.DefaultLogFileName
+		if len(args) == 1 {
+			fileName = args[0]
+		}
+		if logsCmdInterval <= 0 {
+			utils.HandleErrorAndExit("--interval should be greater than 0", nil)
+		}
+		filter, err := impl.NewLogFilter(logsCmdSince, logsCmdGrep, logsCmdLevel, time.Now())
+		if err != nil {
+			utils.HandleErrorAndExit("Error filtering logs", err)
+		}
+		credentials.HandleMissingCredentials(logsCmdEnvironment)
+		err = impl.TailLogFile(logsCmdEnvironment, fileName, filter, logsCmdFollow, logsCmdInterval, os.Stdout)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the log file "+fileName, err)
+		}
+	},
+}
+
+func init() {
+	MICmd.AddCommand(logsCmd)
+
+	logsCmd.Flags().StringVarP(&logsCmdEnvironment, "environment", "e", "", "Environment of the Micro Integrator")
+	logsCmd.Flags().BoolVarP(&logsCmdFollow, "follow", "f", false, "Keep printing the new lines of the log file")
+	logsCmd.Flags().StringVar(&logsCmdSince, "since", "",
+		"Print the lines logged after a duration (eg: 10m) or a timestamp (eg: \"2020-10-13 10:41:28\")")
+	logsCmd.Flags().StringVar(&logsCmdGrep, "grep", "", "Print the lines matching a regular expression")
+	logsCmd.Flags().StringVar(&logsCmdLevel, "level", "",
+		"Print the lines with the given log level or higher (TRACE, DEBUG, INFO, WARN, ERROR, FATAL)")
+	logsCmd.Flags().DurationVar(&logsCmdInterval, "interval", 2*time.Second,
+		"Interval to poll the Micro Integrator for new lines with --follow")
+	_ = logsCmd.MarkFlagRequired("environment")
+}
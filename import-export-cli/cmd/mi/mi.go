@@ -24,14 +24,16 @@ import (
 	miAddCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/add"
 	miDeactivateCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/deactivate"
 	miDeleteCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/delete"
+	miDeployCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/deploy"
 	miGetCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/get"
+	miUndeployCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/undeploy"
 	miUpdateCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/update"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 const miCmdShortDesc = "Micro Integrator related commands"
 
-const miCmdLongDesc = `Micro Integrator related commands such as login, logout, get, add, update, delete, activate, deactivate.`
+const miCmdLongDesc = `Micro Integrator related commands such as login, logout, get, add, update, delete, activate, deactivate, deploy, undeploy, snapshot, diff.`
 
 // MICmd represents the mi command
 var MICmd = &cobra.Command{
@@ -39,6 +41,7 @@ var MICmd = &cobra.Command{
 	Short: miCmdShortDesc,
 	Long:  miCmdLongDesc,
 	// Example: miCmdExamples,
+	PersistentPreRun: runOnMICluster,
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + utils.MiCmdLiteral + " called")
 		cmd.Help()
@@ -52,4 +55,6 @@ func init() {
 	MICmd.AddCommand(miUpdateCmd.UpdateCmd)
 	MICmd.AddCommand(miActivateCmd.ActivateCmd)
 	MICmd.AddCommand(miDeactivateCmd.DeactivateCmd)
+	MICmd.AddCommand(miDeployCmd.DeployCmd)
+	MICmd.AddCommand(miUndeployCmd.UndeployCmd)
 }
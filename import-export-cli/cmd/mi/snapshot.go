@@ -0,0 +1,79 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mi
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var snapshotCmdEnvironment string
+var snapshotCmdOutput string
+
+const snapshotCmdLiteral = "snapshot"
+const snapshotCmdShortDesc = "Take a snapshot of the artifacts of a Micro Integrator"
+const snapshotCmdLongDesc = "Write the names, versions and states of all the artifacts deployed in the Micro " +
+	"Integrator in the environment specified by the flag --environment, -e as JSON.\n" +
+	"Snapshots can be compared with the diff command"
+const snapshotCmdExamples = "To write a snapshot to a file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " snapshot -e prod -o snapshot.json\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+// snapshotCmd represents the snapshot command
+var snapshotCmd = &cobra.Command{
+	Use:     snapshotCmdLiteral,
+	Short:   snapshotCmdShortDesc,
+	Long:    snapshotCmdLongDesc,
+	Example: snapshotCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + utils.MiCmdLiteral + " snapshot called")
+		credentials.HandleMissingCredentials(snapshotCmdEnvironment)
+		snapshot, err := impl.GetMISnapshot(snapshotCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error taking a snapshot of "+snapshotCmdEnvironment, err)
+		}
+		out := os.Stdout
+		if snapshotCmdOutput != "" {
+			out, err = os.Create(snapshotCmdOutput)
+			if err != nil {
+				utils.HandleErrorAndExit("Error creating "+snapshotCmdOutput, err)
+			}
+			defer out.Close()
+		}
+		if err = impl.WriteMISnapshot(out, snapshot); err != nil {
+			utils.HandleErrorAndExit("Error writing the snapshot", err)
+		}
+		if snapshotCmdOutput != "" {
+			utils.Logln(utils.LogPrefixInfo + "Snapshot of " + snapshotCmdEnvironment + " written to " + snapshotCmdOutput)
+		}
+	},
+}
+
+func init() {
+	MICmd.AddCommand(snapshotCmd)
+
+	snapshotCmd.Flags().StringVarP(&snapshotCmdEnvironment, "environment", "e", "", "Environment of the Micro Integrator")
+	snapshotCmd.Flags().StringVarP(&snapshotCmdOutput, "output", "o", "", "File to write the snapshot to. Written to the standard output if not given")
+	_ = snapshotCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,71 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package undeploy
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var undeployCAppCmdEnvironment string
+
+const undeployCAppCmdLiteral = "capp [capp-name]"
+const undeployCAppCmdShortDesc = "Undeploy a carbon application from a Micro Integrator"
+
+const undeployCAppCmdLongDesc = "Undeploy the carbon application specified by the command line argument [capp-name] from a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var undeployCAppCmdExamples = "To undeploy a carbon application\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + undeployCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(undeployCAppCmdLiteral) + " HealthCareCompositeApplication -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var undeployCAppCmd = &cobra.Command{
+	Use:     undeployCAppCmdLiteral,
+	Short:   undeployCAppCmdShortDesc,
+	Long:    undeployCAppCmdLongDesc,
+	Example: undeployCAppCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUndeployCAppCmdArguments(args)
+	},
+}
+
+func init() {
+	UndeployCmd.AddCommand(undeployCAppCmd)
+	undeployCAppCmd.Flags().StringVarP(&undeployCAppCmdEnvironment, "environment", "e", "", "Environment of the micro integrator from which the carbon application should be undeployed")
+	undeployCAppCmd.MarkFlagRequired("environment")
+}
+
+func handleUndeployCAppCmdArguments(args []string) {
+	printUndeployCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(undeployCAppCmdLiteral))
+	credentials.HandleMissingCredentials(undeployCAppCmdEnvironment)
+	executeUndeployCApp(args[0])
+}
+
+func executeUndeployCApp(appName string) {
+	resp, err := impl.UndeployCarbonApp(undeployCAppCmdEnvironment, appName)
+	if err != nil {
+		utils.HandleErrorAndExit("Error undeploying carbon application [ "+appName+" ]", err)
+	}
+	fmt.Println("Undeploying carbon application [ "+appName+" ] status:", resp)
+}
@@ -0,0 +1,140 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package update
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// artifactSettingFlags holds the flags of a command enabling or disabling a setting of artifacts
+type artifactSettingFlags struct {
+	environment string
+	enable      bool
+	disable     bool
+	pattern     string
+}
+
+// newArtifactSettingCmd creates a command to enable or disable a setting (tracing or statistics) of artifacts
+// @param cmdLiteral : Literal of the command
+// @param settingName : Name of the setting used in the descriptions
+// @param setting : Setting sent to the management API
+func newArtifactSettingCmd(cmdLiteral, settingName, setting string) *cobra.Command {
+	flags := &artifactSettingFlags{}
+	artifactTypes := strings.Join(impl.GetToggleableArtifactTypes(), ", ")
+	cmd := &cobra.Command{
+		Use:   cmdLiteral + " [artifact-type] [artifact-name]",
+		Short: "Enable or disable " + settingName + " of artifacts in a Micro Integrator",
+		Long: "Enable or disable " + settingName + " of the artifact of type [artifact-type] named [artifact-name] " +
+			"in a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+			"Use --pattern instead of [artifact-name] to update all the artifacts of the type with names matching " +
+			"a regular expression\nSupported artifact types: " + artifactTypes,
+		Example: "To enable " + settingName + " of an API\n" +
+			"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + cmdLiteral +
+			" api HealthcareAPI --enable -e dev\n" +
+			"To disable " + settingName + " of all the proxy services with names starting with Order\n" +
+			"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + cmdLiteral +
+			" proxy-service --pattern \"^Order\" --disable -e dev\n" +
+			"NOTE: The flag (--environment (-e)) is mandatory",
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			printUpdateCmdVerboseLog(cmdLiteral)
+			if err := validateArtifactSettingArgs(args, flags); err != nil {
+				utils.HandleErrorAndExit("Invalid arguments", err)
+			}
+			credentials.HandleMissingCredentials(flags.environment)
+			executeUpdateArtifactSetting(args, flags, settingName, setting)
+		},
+	}
+	cmd.Flags().StringVarP(&flags.environment, "environment", "e", "",
+		"Environment of the micro integrator of which the artifacts should be updated")
+	cmd.Flags().BoolVar(&flags.enable, "enable", false, "Enable "+settingName)
+	cmd.Flags().BoolVar(&flags.disable, "disable", false, "Disable "+settingName)
+	cmd.Flags().StringVar(&flags.pattern, "pattern", "",
+		"Update all the artifacts of the type with names matching the regular expression")
+	_ = cmd.MarkFlagRequired("environment")
+	return cmd
+}
+
+func validateArtifactSettingArgs(args []string, flags *artifactSettingFlags) error {
+	if flags.enable == flags.disable {
+		return errors.New("exactly one of --enable or --disable is required")
+	}
+	if len(args) == 2 && flags.pattern != "" {
+		return errors.New("[artifact-name] and --pattern can not be used together")
+	}
+	if len(args) == 1 && flags.pattern == "" {
+		return errors.New("[artifact-name] or --pattern is required")
+	}
+	for _, artifactType := range impl.GetToggleableArtifactTypes() {
+		if artifactType == args[0] {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported artifact type %s. Use one of %s", args[0],
+		strings.Join(impl.GetToggleableArtifactTypes(), ", "))
+}
+
+func executeUpdateArtifactSetting(args []string, flags *artifactSettingFlags, settingName, setting string) {
+	artifactType := args[0]
+	if flags.pattern == "" {
+		resp, err := impl.UpdateArtifactSetting(flags.environment, artifactType, args[1], setting, flags.enable)
+		if err != nil {
+			fmt.Println(utils.LogPrefixError+"updating "+settingName+" of "+artifactType+" [ "+args[1]+" ] ", err)
+			os.Exit(1)
+		}
+		fmt.Println(resp)
+		return
+	}
+
+	pattern, err := regexp.Compile(flags.pattern)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid pattern "+flags.pattern, err)
+	}
+	names, err := impl.GetArtifactNamesMatchingPattern(flags.environment, artifactType, pattern)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting the list of "+artifactType+"s", err)
+	}
+	if len(names) == 0 {
+		fmt.Println("No " + artifactType + "s found matching " + flags.pattern)
+		return
+	}
+	failed := 0
+	for _, name := range names {
+		resp, err := impl.UpdateArtifactSetting(flags.environment, artifactType, name, setting, flags.enable)
+		if err != nil {
+			failed++
+			fmt.Println(utils.LogPrefixError+"updating "+settingName+" of "+artifactType+" [ "+name+" ] ", err)
+			continue
+		}
+		fmt.Println(resp)
+	}
+	if failed > 0 {
+		utils.HandleErrorAndExit(fmt.Sprintf("Error updating %s of %d of %d %ss", settingName, failed, len(names),
+			artifactType), nil)
+	}
+}
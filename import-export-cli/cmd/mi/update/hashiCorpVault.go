@@ -33,7 +33,8 @@ var updateHashiCorpSecretCmdEnvironment string
 const updateHashiCorpSecretCmdLiteral = "hashicorp-secret [secret-id]"
 const updateHashiCorpSecretCmdShortDesc = "Update the secret ID of HashiCorp configuration in a Micro Integrator"
 
-const updateHashiCorpSecretCmdLongDesc = "Update the secret ID of the HashiCorp configuration in a Micro Integrator in the environment specified by the flag --environment, -e"
+const updateHashiCorpSecretCmdLongDesc = "Update the secret ID of the HashiCorp configuration in a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"Use '" + updateCmdLiteral + " vault' to read the secret ID from a file or the standard input instead of the command line"
 
 var updateHashiCorpSecretCmdExamples = "To update the secret ID\n" +
 	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateHashiCorpSecretCmdLiteral) + " new_secret_id -e dev\n" +
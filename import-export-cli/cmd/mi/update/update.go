@@ -24,11 +24,13 @@ import (
 )
 
 const updateCmdLiteral = "update"
-const updateCmdShortDesc = "Update log level of Loggers in a Micro Integrator instance"
+const updateCmdShortDesc = "Update configurations and users of a Micro Integrator instance"
 
-const updateCmdLongDesc = "Update log level of Loggers in a Micro Integrator instance in the environment specified by the flag (--environment, -e)"
+const updateCmdLongDesc = "Update log level of Loggers, tracing and statistics of artifacts, roles and passwords of users and external vaults in a Micro Integrator instance in the environment specified by the flag (--environment, -e)"
 
-const updateCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + "log-level" + " org-apache-coyote DEBUG -e dev"
+const updateCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + "log-level" + " org-apache-coyote DEBUG -e dev\n" +
+	utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + "tracing" + " api HealthcareAPI --enable -e dev\n" +
+	utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + "user-roles" + " capp-tester --add tester -e dev"
 
 // UpdateCmd represents the update command
 var UpdateCmd = &cobra.Command{
@@ -0,0 +1,94 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package update
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var updateUserPasswordCmdEnvironment string
+var updateUserPasswordCmdDomain string
+
+const updateUserPasswordCmdLiteral = "user-password [user-name]"
+const updateUserPasswordCmdShortDesc = "Change the password of a user in a Micro Integrator"
+
+const updateUserPasswordCmdLongDesc = "Change the password of the user specified by the command line argument [user-name] in a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"The new password is prompted for, or read as two lines (the password and its confirmation) from the standard input when it is not a terminal"
+
+var updateUserPasswordCmdExamples = "To change the password of a user\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateUserPasswordCmdLiteral) + " capp-tester -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var updateUserPasswordCmd = &cobra.Command{
+	Use:     updateUserPasswordCmdLiteral,
+	Short:   updateUserPasswordCmdShortDesc,
+	Long:    updateUserPasswordCmdLongDesc,
+	Example: updateUserPasswordCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	// the password is read once and passed to each node of a cluster
+	Annotations: map[string]string{impl.MIClusterAnnotation: impl.MIClusterManual},
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateUserPasswordCmdArguments(args)
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(updateUserPasswordCmd)
+	updateUserPasswordCmd.Flags().StringVarP(&updateUserPasswordCmdEnvironment, "environment", "e", "", "Environment of the micro integrator of which the user should be updated")
+	updateUserPasswordCmd.Flags().StringVarP(&updateUserPasswordCmdDomain, "domain", "d", "", "User store domain of the user. The primary user store is used if not given")
+	updateUserPasswordCmd.MarkFlagRequired("environment")
+}
+
+func handleUpdateUserPasswordCmdArguments(args []string) {
+	printUpdateCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(updateUserPasswordCmdLiteral))
+	userName := args[0]
+	reader := bufio.NewReader(os.Stdin)
+	newPassword := miUtils.ReadPassword(reader, "Enter new password for "+userName+": ")
+	confirmPassword := miUtils.ReadPassword(reader, "Re-Enter new password for "+userName+": ")
+	if newPassword == "" || newPassword != confirmPassword {
+		utils.HandleErrorAndExit("Passwords are empty or not matching.", nil)
+	}
+	if impl.IsMIClusterEnv(updateUserPasswordCmdEnvironment) {
+		results := impl.RunOnMINodes(updateUserPasswordCmdEnvironment, os.Args[1:],
+			[]byte(newPassword+"\n"+newPassword+"\n"))
+		if impl.PrintMINodeResults(os.Stdout, updateUserPasswordCmdEnvironment, results) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+	credentials.HandleMissingCredentials(updateUserPasswordCmdEnvironment)
+	executeUpdateUserPassword(userName, newPassword)
+}
+
+func executeUpdateUserPassword(userName, newPassword string) {
+	resp, err := impl.UpdateMIUserPassword(updateUserPasswordCmdEnvironment, userName, updateUserPasswordCmdDomain,
+		newPassword)
+	if err != nil {
+		utils.HandleErrorAndExit("Error changing the password of user [ "+userName+" ]", err)
+	}
+	fmt.Println("Changing the password of user [ "+userName+" ] status:", resp)
+}
@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package update
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var updateUserRolesCmdEnvironment string
+var updateUserRolesCmdDomain string
+var updateUserRolesCmdAddedRoles []string
+var updateUserRolesCmdRemovedRoles []string
+
+const updateUserRolesCmdLiteral = "user-roles [user-name]"
+const updateUserRolesCmdShortDesc = "Assign and remove roles of a user in a Micro Integrator"
+
+const updateUserRolesCmdLongDesc = "Assign the roles given by --add to and remove the roles given by --remove from the user specified by the command line argument [user-name] in a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var updateUserRolesCmdExamples = "To assign roles to a user and remove a role from the user\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateUserRolesCmdLiteral) + " capp-tester --add tester,developer --remove admin -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var updateUserRolesCmd = &cobra.Command{
+	Use:     updateUserRolesCmdLiteral,
+	Short:   updateUserRolesCmdShortDesc,
+	Long:    updateUserRolesCmdLongDesc,
+	Example: updateUserRolesCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateUserRolesCmdArguments(args)
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(updateUserRolesCmd)
+	updateUserRolesCmd.Flags().StringVarP(&updateUserRolesCmdEnvironment, "environment", "e", "", "Environment of the micro integrator of which the user should be updated")
+	updateUserRolesCmd.Flags().StringVarP(&updateUserRolesCmdDomain, "domain", "d", "", "User store domain of the user. The primary user store is used if not given")
+	updateUserRolesCmd.Flags().StringSliceVar(&updateUserRolesCmdAddedRoles, "add", nil, "Roles to be assigned to the user")
+	updateUserRolesCmd.Flags().StringSliceVar(&updateUserRolesCmdRemovedRoles, "remove", nil, "Roles to be removed from the user")
+	updateUserRolesCmd.MarkFlagRequired("environment")
+}
+
+func handleUpdateUserRolesCmdArguments(args []string) {
+	printUpdateCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(updateUserRolesCmdLiteral))
+	if len(updateUserRolesCmdAddedRoles) == 0 && len(updateUserRolesCmdRemovedRoles) == 0 {
+		utils.HandleErrorAndExit("At least one of --add or --remove is required", nil)
+	}
+	credentials.HandleMissingCredentials(updateUserRolesCmdEnvironment)
+	executeUpdateUserRoles(args[0])
+}
+
+func executeUpdateUserRoles(userName string) {
+	resp, err := impl.UpdateMIUserRoles(updateUserRolesCmdEnvironment, userName, updateUserRolesCmdDomain,
+		updateUserRolesCmdAddedRoles, updateUserRolesCmdRemovedRoles)
+	if err != nil {
+		utils.HandleErrorAndExit("Error updating the roles of user [ "+userName+" ]", err)
+	}
+	fmt.Println("Updating the roles of user [ "+userName+" ] status:", resp)
+}
@@ -0,0 +1,159 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package update
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var updateVaultCmdEnvironment string
+var updateVaultCmdRoleID string
+var updateVaultCmdSecretIDFile string
+var updateVaultCmdTokenFile string
+
+const updateVaultCmdLiteral = "vault [vault-name]"
+const updateVaultCmdShortDesc = "Update the configuration of an external vault in a Micro Integrator"
+
+const updateVaultCmdLongDesc = "Update the AppRole role ID, the AppRole secret ID or the token of the external vault specified by the command line argument [vault-name] " +
+	"in a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"The secret ID and the token are read from a file, or from the standard input when the file is given as -, so that they are not kept in the shell history. " +
+	"Only the " + utils.MiManagementExternalVaultHashiCorpResource + " vault is supported"
+
+var updateVaultCmdExamples = "To update the secret ID of the HashiCorp vault read from the standard input\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateVaultCmdLiteral) + " " + utils.MiManagementExternalVaultHashiCorpResource + " --secret-id-file - -e dev\n" +
+	"To update the AppRole role ID and the secret ID read from a file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateVaultCmdLiteral) + " " + utils.MiManagementExternalVaultHashiCorpResource + " --role-id new_role_id --secret-id-file ./secret-id -e dev\n" +
+	"To update the token of the HashiCorp vault\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateVaultCmdLiteral) + " " + utils.MiManagementExternalVaultHashiCorpResource + " --token-file ./token -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var updateVaultCmd = &cobra.Command{
+	Use:     updateVaultCmdLiteral,
+	Short:   updateVaultCmdShortDesc,
+	Long:    updateVaultCmdLongDesc,
+	Example: updateVaultCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	// a secret read from the standard input is read once and passed to each node of a cluster
+	Annotations: map[string]string{impl.MIClusterAnnotation: impl.MIClusterManual},
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateVaultCmdArguments(args)
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(updateVaultCmd)
+	updateVaultCmd.Flags().StringVarP(&updateVaultCmdEnvironment, "environment", "e", "", "Environment of the micro integrator of which the external vault should be updated")
+	updateVaultCmd.Flags().StringVarP(&updateVaultCmdRoleID, "role-id", "", "", "New AppRole role ID")
+	updateVaultCmd.Flags().StringVarP(&updateVaultCmdSecretIDFile, "secret-id-file", "", "", "File to read the new AppRole secret ID from. Use - to read it from the standard input")
+	updateVaultCmd.Flags().StringVarP(&updateVaultCmdTokenFile, "token-file", "", "", "File to read the new token from. Use - to read it from the standard input")
+	updateVaultCmd.MarkFlagRequired("environment")
+}
+
+func handleUpdateVaultCmdArguments(args []string) {
+	printUpdateCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(updateVaultCmdLiteral))
+	if args[0] != utils.MiManagementExternalVaultHashiCorpResource {
+		utils.HandleErrorAndExit("Updating the external vault "+args[0]+" is not supported. Supported vaults: "+
+			utils.MiManagementExternalVaultHashiCorpResource, nil)
+	}
+	if updateVaultCmdRoleID == "" && updateVaultCmdSecretIDFile == "" && updateVaultCmdTokenFile == "" {
+		utils.HandleErrorAndExit("At least one of --role-id, --secret-id-file or --token-file is required", nil)
+	}
+	if updateVaultCmdSecretIDFile == "-" && updateVaultCmdTokenFile == "-" {
+		utils.HandleErrorAndExit("Only one of --secret-id-file and --token-file can be read from the standard input", nil)
+	}
+	reader := bufio.NewReader(os.Stdin)
+	secretID := readVaultSecret(reader, updateVaultCmdSecretIDFile, "Enter new secret ID: ")
+	token := readVaultSecret(reader, updateVaultCmdTokenFile, "Enter new token: ")
+	if impl.IsMIClusterEnv(updateVaultCmdEnvironment) {
+		executeUpdateVaultOfNodes(secretID, token)
+		return
+	}
+	credentials.HandleMissingCredentials(updateVaultCmdEnvironment)
+	executeUpdateHashiCorpVault(secretID, token)
+}
+
+// readVaultSecret reads a secret from the given file, or from the standard input when the file is -
+func readVaultSecret(reader *bufio.Reader, file, prompt string) string {
+	var secret string
+	if file == "" {
+		return ""
+	} else if file == "-" {
+		secret = miUtils.ReadPassword(reader, prompt)
+	} else {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading "+file, err)
+		}
+		secret = string(data)
+	}
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		utils.HandleErrorAndExit("The secret read from "+file+" is empty", nil)
+	}
+	return secret
+}
+
+// executeUpdateVaultOfNodes updates the vault of each node of a cluster. Only the secret read from the standard
+// input, if any, is passed to the nodes, which read the files themselves
+func executeUpdateVaultOfNodes(secretID, token string) {
+	var input []byte
+	if updateVaultCmdSecretIDFile == "-" {
+		input = []byte(secretID + "\n")
+	} else if updateVaultCmdTokenFile == "-" {
+		input = []byte(token + "\n")
+	}
+	results := impl.RunOnMINodes(updateVaultCmdEnvironment, os.Args[1:], input)
+	if impl.PrintMINodeResults(os.Stdout, updateVaultCmdEnvironment, results) > 0 {
+		os.Exit(1)
+	}
+}
+
+func executeUpdateHashiCorpVault(secretID, token string) {
+	if updateVaultCmdRoleID != "" {
+		resp, err := impl.UpdateHashiCorpRoleID(updateVaultCmdEnvironment, updateVaultCmdRoleID)
+		if err != nil {
+			utils.HandleErrorAndExit("Error updating the role ID of the HashiCorp vault", err)
+		}
+		fmt.Println(resp)
+	}
+	if secretID != "" {
+		resp, err := impl.UpdateHashiCorpSecretID(updateVaultCmdEnvironment, secretID)
+		if err != nil {
+			utils.HandleErrorAndExit("Error updating the secret ID of the HashiCorp vault", err)
+		}
+		fmt.Println(resp)
+	}
+	if token != "" {
+		resp, err := impl.UpdateHashiCorpRootToken(updateVaultCmdEnvironment, token)
+		if err != nil {
+			utils.HandleErrorAndExit("Error updating the token of the HashiCorp vault", err)
+		}
+		fmt.Println(resp)
+	}
+}
@@ -96,6 +96,13 @@ func removeEnv(envName, mainConfigFilePath, envKeysFilePath string) error {
 			}
 		}
 
+		if store.HasMG(envName) {
+			err = credentials.RunMGLogout(envName)
+			if err != nil {
+				utils.Logln("Log out is unsuccessful for MG.", err)
+			}
+		}
+
 		// remove env from mainConfig file (endpoints file)
 		err = utils.RemoveEnvFromMainConfigFile(envName, mainConfigFilePath)
 		if err != nil {
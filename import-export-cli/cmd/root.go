@@ -26,6 +26,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/ghodss/yaml"
 
@@ -229,8 +230,14 @@ func isK8sEnabled() bool {
 	}
 }
 
-//execute kubernetes commands
+// ExecuteKubernetes passes the given arguments through to kubectl. The apictl kubernetes commands (add api,
+// install api-operator, etc.) use the Go client in operator/utils, but arbitrary commands forwarded in
+// kubernetes mode (e.g. apictl get pods, apictl delete) keep the exact kubectl syntax and output, so they
+// still require kubectl on the PATH
 func ExecuteKubernetes(arg ...string) {
+	if _, err := exec.LookPath(k8sUtils.Kubectl); err != nil {
+		utils.HandleErrorAndExit("kubectl is required to run \""+strings.Join(arg, " ")+"\" in kubernetes mode", err)
+	}
 	cmd := exec.Command(
 		k8sUtils.Kubectl,
 		arg...,
@@ -0,0 +1,47 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Test command related usage Info
+const testCmdLiteral = "test"
+const testCmdShortDesc = "Run smoke tests against an API"
+const testCmdLongDesc = `Run smoke tests generated from the OpenAPI definition of a project against an API deployed in
+the environment specified by the flag (--environment, -e)`
+const testCmdExamples = utils.ProjectName + ` ` + testCmdLiteral + ` ` + testAPICmdLiteral + ` ./PetstoreAPI -e dev --junit-report report.xml`
+
+// TestCmd represents the test command
+var TestCmd = &cobra.Command{
+	Use:     testCmdLiteral,
+	Short:   testCmdShortDesc,
+	Long:    testCmdLongDesc,
+	Example: testCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + testCmdLiteral + " called")
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(TestCmd)
+}
@@ -0,0 +1,109 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var testAPIEnvironment string
+var testAPIOptions impl.ApiTestOptions
+var testAPIReportFile string
+
+// TestAPI command related usage Info
+const testAPICmdLiteral = "api"
+const testAPICmdShortDesc = "Run smoke tests against an API using its OpenAPI definition"
+const testAPICmdLongDesc = `Generate a request for each operation in the OpenAPI definition of an API project
+(Meta-information/swagger.yaml) using the examples or the schemas in the definition, invoke them through the gateway
+with an access token generated as in "get keys", and validate the responses against the declared response schemas.
+Exits with a non-zero status if any of the tests fail`
+const testAPICmdExamples = utils.ProjectName + ` ` + testCmdLiteral + ` ` + testAPICmdLiteral + ` ./PetstoreAPI -e dev
+` + utils.ProjectName + ` ` + testCmdLiteral + ` ` + testAPICmdLiteral + ` ./PetstoreAPI -e dev --gateway-env Production --junit-report report.xml
+NOTE: The flag (--environment (-e)) is mandatory.`
+
+// TestAPICmd represents the test api command
+var TestAPICmd = &cobra.Command{
+	Use:     testAPICmdLiteral + " <path-to-api-project>",
+	Short:   testAPICmdShortDesc,
+	Long:    testAPICmdLongDesc,
+	Example: testAPICmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + testCmdLiteral + " " + testAPICmdLiteral + " called")
+		cred, err := GetCredentials(testAPIEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		if !runApiTests(cred, testAPIEnvironment, []string{args[0]}, testAPIOptions, testAPIReportFile) {
+			os.Exit(1)
+		}
+	},
+}
+
+// runApiTests runs the smoke tests of the given API projects, prints the results and writes the JUnit report if a
+// report file is given
+// @return true if all the tests passed
+func runApiTests(credential credentials.Credential, environment string, projectPaths []string,
+	opts impl.ApiTestOptions, reportFile string) bool {
+	var suites []*impl.ApiTestSuite
+	passed := true
+	for _, projectPath := range projectPaths {
+		suite, err := impl.RunApiTests(credential, environment, projectPath, opts)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while testing the API in "+projectPath, err)
+		}
+		impl.PrintApiTestSuite(suite)
+		fmt.Println()
+		suites = append(suites, suite)
+		if suite.Failures() > 0 {
+			passed = false
+		}
+	}
+	if reportFile != "" {
+		if err := impl.WriteJUnitReport(suites, reportFile); err != nil {
+			utils.HandleErrorAndExit("Error while writing the test report", err)
+		}
+	}
+	return passed
+}
+
+// addApiTestFlags adds the flags used to configure the smoke tests of APIs
+func addApiTestFlags(cmd *cobra.Command, opts *impl.ApiTestOptions, reportFile *string) {
+	cmd.Flags().StringVarP(&opts.GatewayEnvironment, "gateway-env", "", "",
+		"Gateway environment to invoke the API. The first gateway environment of the API is used if not specified")
+	cmd.Flags().BoolVarP(&opts.UseHTTP, "http", "", false, "Invoke the API using the HTTP gateway URL")
+	cmd.Flags().StringVarP(&opts.AppName, "app", "", utils.DefaultCliApp,
+		"Application to subscribe to the API when generating the access token")
+	cmd.Flags().StringVarP(reportFile, "junit-report", "", "", "File to write the test results in JUnit XML format")
+}
+
+// init using Cobra
+func init() {
+	TestCmd.AddCommand(TestAPICmd)
+	TestAPICmd.Flags().StringVarP(&testAPIEnvironment, "environment", "e", "",
+		"Environment the API is deployed in")
+	addApiTestFlags(TestAPICmd, &testAPIOptions, &testAPIReportFile)
+	_ = TestAPICmd.MarkFlagRequired("environment")
+}
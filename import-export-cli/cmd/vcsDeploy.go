@@ -25,11 +25,15 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/git"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var flagVCSDeployEnvName string    // name of the environment the project changes need to be deployed
 var flagVCSDeploySkipRollback bool // specifies whether rolling back on error needs to be avoided
+var flagVCSDeployRunTests bool     // specifies whether the deployed APIs need to be tested
+var flagVCSDeployTestOptions impl.ApiTestOptions
+var flagVCSDeployTestReportFile string
 
 // deploy command related usage Info
 const deployCmdLiteral = "deploy"
@@ -37,10 +41,12 @@ const deployCmdShortDesc = "Deploys projects to the specified environment"
 const deployCmdLongDesc = `Deploys projects to the specified environment specified by --environment(-e). 
 Only the changed projects compared to the revision at the last successful deployment will be deployed. 
 If any project(s) got failed during the deployment, by default, the operation will rollback the environment to the last successful state. If this needs to be avoided, use --skipRollback=true
+If --run-tests is specified, smoke tests generated from the OpenAPI definitions are run against the deployed APIs.
 NOTE: --environment (-e) flag is mandatory`
 
 const deployCmdExamples = utils.ProjectName + ` ` + vcsCmdLiteral + ` ` + deployCmdLiteral + ` -e dev
-` + utils.ProjectName + ` ` + vcsCmdLiteral + ` ` + deployCmdLiteral + ` -e dev --skipRollback=true`
+` + utils.ProjectName + ` ` + vcsCmdLiteral + ` ` + deployCmdLiteral + ` -e dev --skipRollback=true
+` + utils.ProjectName + ` ` + vcsCmdLiteral + ` ` + deployCmdLiteral + ` -e dev --run-tests --junit-report report.xml`
 
 // deployCmd represents the deploy command
 var DeployCmd = &cobra.Command{
@@ -63,7 +69,7 @@ var DeployCmd = &cobra.Command{
 		if err != nil {
 			utils.HandleErrorAndExit("Error while getting an access token for deploying the project(s)", err)
 		}
-		failedProjects := git.DeployChangedFiles(accessOAuthToken, flagVCSDeployEnvName)
+		failedProjects, deployedApiProjects := git.DeployChangedFiles(accessOAuthToken, flagVCSDeployEnvName)
 		if failedProjects != nil && len(failedProjects) > 0 && flagVCSDeploySkipRollback == false {
 			fmt.Println("\nRolling back to the last successful revision as there are failures..")
 			err = git.Rollback(accessOAuthToken, flagVCSDeployEnvName)
@@ -73,6 +79,17 @@ var DeployCmd = &cobra.Command{
 				utils.HandleErrorAndExit("There are project deployment failures. Rolled back to the last successful revision.", err)
 			}
 		}
+		if flagVCSDeployRunTests && len(deployedApiProjects) > 0 {
+			fmt.Println("\nTesting the deployed APIs..")
+			var projectPaths []string
+			for _, projectParam := range deployedApiProjects {
+				projectPaths = append(projectPaths, projectParam.AbsolutePath)
+			}
+			if !runApiTests(credential, flagVCSDeployEnvName, projectPaths, flagVCSDeployTestOptions,
+				flagVCSDeployTestReportFile) {
+				os.Exit(1)
+			}
+		}
 	},
 }
 
@@ -83,6 +100,9 @@ func init() {
 		"environment to deploy the project(s)")
 	DeployCmd.Flags().BoolVarP(&flagVCSDeploySkipRollback, "skipRollback", "", false,
 		"Specifies whether rolling back to the last successful revision during an error situation should be skipped")
+	DeployCmd.Flags().BoolVarP(&flagVCSDeployRunTests, "run-tests", "", false, "Run smoke tests generated "+
+		"from the OpenAPI definitions against the deployed APIs")
+	addApiTestFlags(DeployCmd, &flagVCSDeployTestOptions, &flagVCSDeployTestReportFile)
 
 	_ = DeployCmd.MarkFlagRequired("environment")
 }
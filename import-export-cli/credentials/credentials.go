@@ -51,10 +51,11 @@ type Credentials struct {
 	CredStore string `json:"credStore,omitempty"`
 }
 
-// Environment containing credentials of apim and mi
+// Environment containing credentials of apim, mi and mg
 type Environment struct {
-	APIM Credential   `json:"apim"`
-	MI   MiCredential `json:"mi"`
+	APIM Credential    `json:"apim"`
+	MI   MiCredential  `json:"mi"`
+	MG   MgwCredential `json:"mg"`
 }
 
 // GetCredentialStore from file
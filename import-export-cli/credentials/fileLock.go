@@ -0,0 +1,101 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package credentials
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// storeLockTimeout is the maximum time to wait for the lock of a credential store
+const storeLockTimeout = 30 * time.Second
+
+// storeLockStaleAge is the age after which a lock is considered to be left by a process which did not release it
+const storeLockStaleAge = 10 * time.Second
+
+// lockFile locks a file among the processes by creating a lock file next to it. Locks older than storeLockStaleAge
+// are removed
+// @param path : Path of the file to be locked
+// @return function to release the lock, error
+func lockFile(path string) (func(), error) {
+	lockPath := path + ".lock"
+	token := lockToken()
+	deadline := time.Now().Add(storeLockTimeout)
+	for {
+		lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, err = lock.WriteString(token)
+			lock.Close()
+			if err != nil {
+				os.Remove(lockPath)
+				return nil, fmt.Errorf("error locking %s: %v", path, err)
+			}
+			return func() { releaseLock(lockPath, token) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("error locking %s: %v", path, err)
+		}
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > storeLockStaleAge {
+			removeStaleLock(lockPath, info)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for the lock %s", lockPath)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// releaseLock removes a lock file if it is still the lock with the given token. The lock is not removed if it was
+// removed as a stale lock and locked by another process
+func releaseLock(lockPath, token string) {
+	if content, err := ioutil.ReadFile(lockPath); err == nil && string(content) == token {
+		os.Remove(lockPath)
+	}
+}
+
+// removeStaleLock removes a lock file observed as stale. The lock file is moved aside first and removed only if it is
+// the observed file with the observed modified time, since another process may have removed the stale lock and
+// locked the file again in the meantime. A lock moved aside which is not the observed one is moved back
+// @param lockPath : Path of the lock file
+// @param stale : Info of the lock file when it was observed as stale
+func removeStaleLock(lockPath string, stale os.FileInfo) {
+	movedPath := lockPath + "." + lockToken()
+	if err := os.Rename(lockPath, movedPath); err != nil {
+		return
+	}
+	defer os.Remove(movedPath)
+	if moved, err := os.Stat(movedPath); err == nil && os.SameFile(stale, moved) &&
+		moved.ModTime().Equal(stale.ModTime()) {
+		return
+	}
+	// linking fails without replacing the lock if yet another process has locked the file
+	if err := os.Link(movedPath, lockPath); err != nil {
+		utils.Logln(utils.LogPrefixWarning+"Restoring the lock "+lockPath, err)
+	}
+}
+
+// lockToken returns a token identifying a lock created by this process
+func lockToken() string {
+	return fmt.Sprintf("%d-%d", os.Getpid(), time.Now().UnixNano())
+}
@@ -0,0 +1,98 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.json")
+
+	unlock, err := lockFile(path)
+	assert.Nil(t, err)
+	_, err = os.Stat(path + ".lock")
+	assert.Nil(t, err)
+	unlock()
+	_, err = os.Stat(path + ".lock")
+	assert.True(t, os.IsNotExist(err), "Lock should be removed when released")
+}
+
+func TestLockFileRemovesStaleLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.json")
+
+	assert.Nil(t, ioutil.WriteFile(path+".lock", []byte("1-1"), 0600))
+	staleTime := time.Now().Add(-2 * storeLockStaleAge)
+	assert.Nil(t, os.Chtimes(path+".lock", staleTime, staleTime))
+
+	unlock, err := lockFile(path)
+	assert.Nil(t, err)
+	defer unlock()
+	content, err := ioutil.ReadFile(path + ".lock")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "1-1", string(content), "Stale lock should be replaced")
+}
+
+func TestRemoveStaleLockKeepsNewLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	lockPath := filepath.Join(dir, "keys.json.lock")
+
+	assert.Nil(t, ioutil.WriteFile(lockPath, []byte("1-1"), 0600))
+	staleTime := time.Now().Add(-2 * storeLockStaleAge)
+	assert.Nil(t, os.Chtimes(lockPath, staleTime, staleTime))
+	stale, err := os.Stat(lockPath)
+	assert.Nil(t, err)
+
+	// another process removes the stale lock and locks the file before this process removes the stale lock
+	assert.Nil(t, os.Remove(lockPath))
+	assert.Nil(t, ioutil.WriteFile(lockPath, []byte("2-2"), 0600))
+
+	removeStaleLock(lockPath, stale)
+	content, err := ioutil.ReadFile(lockPath)
+	assert.Nil(t, err)
+	assert.Equal(t, "2-2", string(content), "Lock created after the stale lock was observed should be kept")
+	files, _ := ioutil.ReadDir(dir)
+	assert.Equal(t, 1, len(files), "Lock moved aside should be removed")
+}
+
+func TestReleaseLockKeepsLockOfAnotherProcess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	lockPath := filepath.Join(dir, "keys.json.lock")
+
+	assert.Nil(t, ioutil.WriteFile(lockPath, []byte("2-2"), 0600))
+	releaseLock(lockPath, "1-1")
+	_, err = os.Stat(lockPath)
+	assert.Nil(t, err, "Lock of another process should not be released")
+}
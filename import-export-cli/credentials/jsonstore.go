@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
 )
 
 // PlainTextWarnMessage warning message
@@ -66,17 +67,39 @@ func (s *JsonStore) Load() error {
 	return nil
 }
 
-// saves to disk
+// update reloads the store from the disk, applies a change and saves the store while holding the lock of the store.
+// Hence the changes saved by other processes in the meantime, such as the access tokens renewed by the commands run on
+// the nodes of a Micro Integrator cluster, are not overwritten
+func (s *JsonStore) update(change func() error) error {
+	unlock, err := lockFile(s.Path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err = s.Load(); err != nil {
+		return err
+	}
+	if s.credentials.Environments == nil {
+		s.credentials.Environments = make(map[string]Environment)
+	}
+	if err = change(); err != nil {
+		return err
+	}
+	return s.persist()
+}
+
+// saves to disk. The file is replaced with a rename so that a partially written file is never read
 func (s *JsonStore) persist() error {
 	data, err := json.MarshalIndent(s.credentials, "", "  ")
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(s.Path, data, os.ModePerm)
+	tmpPath := s.Path + "." + strconv.Itoa(os.Getpid()) + ".tmp"
+	err = ioutil.WriteFile(tmpPath, data, os.ModePerm)
 	if err != nil {
 		return err
 	}
-	return nil
+	return os.Rename(tmpPath, s.Path)
 }
 
 // GetAPIMCredentials returns credentials for apim from the store or an error
@@ -108,15 +131,17 @@ func (s *JsonStore) GetAPIMCredentials(env string) (Credential, error) {
 
 // SetAPIMCredentials sets credentials for micro integrator using username, password, clientID and client secret
 func (s *JsonStore) SetAPIMCredentials(env, username, password, clientId, clientSecret string) error {
-	environment := s.credentials.Environments[env]
-	environment.APIM = Credential{
-		Username:     Base64Encode(username),
-		Password:     Base64Encode(password),
-		ClientId:     Base64Encode(clientId),
-		ClientSecret: Base64Encode(clientSecret),
-	}
-	s.credentials.Environments[env] = environment
-	err := s.persist()
+	err := s.update(func() error {
+		environment := s.credentials.Environments[env]
+		environment.APIM = Credential{
+			Username:     Base64Encode(username),
+			Password:     Base64Encode(password),
+			ClientId:     Base64Encode(clientId),
+			ClientSecret: Base64Encode(clientSecret),
+		}
+		s.credentials.Environments[env] = environment
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -140,23 +165,30 @@ func (s *JsonStore) GetMICredentials(env string) (MiCredential, error) {
 			return MiCredential{}, err
 		}
 		credential := MiCredential{
-			username, password, accessToken,
+			Username:          username,
+			Password:          password,
+			AccessToken:       accessToken,
+			AccessTokenExpiry: environment.MI.AccessTokenExpiry,
 		}
 		return credential, nil
 	}
 	return MiCredential{}, fmt.Errorf("credentials not found for Mi in %s, use login", env)
 }
 
-// SetMICredentials set credentials for mi using username, password, accessToken
+// SetMICredentials set credentials for mi using username, password, accessToken. The password is not stored if
+// it is empty. The expiry time of the access token is stored with it
 func (s *JsonStore) SetMICredentials(env, username, password, accessToken string) error {
-	environment := s.credentials.Environments[env]
-	environment.MI = MiCredential{
-		Username:    Base64Encode(username),
-		Password:    Base64Encode(password),
-		AccessToken: Base64Encode(accessToken),
-	}
-	s.credentials.Environments[env] = environment
-	err := s.persist()
+	err := s.update(func() error {
+		environment := s.credentials.Environments[env]
+		environment.MI = MiCredential{
+			Username:          Base64Encode(username),
+			Password:          Base64Encode(password),
+			AccessToken:       Base64Encode(accessToken),
+			AccessTokenExpiry: GetMIAccessTokenExpiry(accessToken),
+		}
+		s.credentials.Environments[env] = environment
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -166,36 +198,96 @@ func (s *JsonStore) SetMICredentials(env, username, password, accessToken string
 
 // EraseAPIM remove apim credentials from the store
 func (s *JsonStore) EraseAPIM(env string) error {
-	environment, ok := s.credentials.Environments[env]
-	if !ok {
-		return fmt.Errorf("%s was not found", env)
-	}
-	if !miCredentialsExists(environment.MI) {
-		// delete the environment
-		delete(s.credentials.Environments, env)
-	} else {
-		// remove only apim credentials
-		environment.APIM = Credential{}
+	return s.update(func() error {
+		environment, ok := s.credentials.Environments[env]
+		if !ok {
+			return fmt.Errorf("%s was not found", env)
+		}
+		if !miCredentialsExists(environment.MI) && !mgwCredentialsExists(environment.MG) {
+			// delete the environment
+			delete(s.credentials.Environments, env)
+		} else {
+			// remove only apim credentials
+			environment.APIM = Credential{}
+			s.credentials.Environments[env] = environment
+		}
+		return nil
+	})
+}
+
+// GetMGCredentials returns credentials for microgateway from the store or an error
+func (s *JsonStore) GetMGCredentials(env string) (MgwCredential, error) {
+	if environment, ok := s.credentials.Environments[env]; ok {
+		username, err := Base64Decode(environment.MG.Username)
+		if err != nil {
+			return MgwCredential{}, err
+		}
+		password, err := Base64Decode(environment.MG.Password)
+		if err != nil {
+			return MgwCredential{}, err
+		}
+		credential := MgwCredential{
+			username, password,
+		}
+		return credential, nil
+	}
+	return MgwCredential{}, fmt.Errorf("credentials not found for MG in %s, use login", env)
+}
+
+// SetMGCredentials set credentials for microgateway using username and password
+func (s *JsonStore) SetMGCredentials(env, username, password string) error {
+	err := s.update(func() error {
+		environment := s.credentials.Environments[env]
+		environment.MG = MgwCredential{
+			Username: Base64Encode(username),
+			Password: Base64Encode(password),
+		}
 		s.credentials.Environments[env] = environment
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	return s.persist()
+	fmt.Printf(PlainTextWarnMessage, s.Path)
+	return nil
 }
 
 // EraseMI remove mi credentials from the store
 func (s *JsonStore) EraseMI(env string) error {
-	environment, ok := s.credentials.Environments[env]
-	if !ok {
-		return fmt.Errorf("%s was not found", env)
-	}
-	if !apimCredentialsExists(environment.APIM) {
-		// delete the environment
-		delete(s.credentials.Environments, env)
-	} else {
-		// remove only mi credentials
-		environment.MI = MiCredential{}
-		s.credentials.Environments[env] = environment
-	}
-	return s.persist()
+	return s.update(func() error {
+		environment, ok := s.credentials.Environments[env]
+		if !ok {
+			return fmt.Errorf("%s was not found", env)
+		}
+		if !apimCredentialsExists(environment.APIM) && !mgwCredentialsExists(environment.MG) {
+			// delete the environment
+			delete(s.credentials.Environments, env)
+		} else {
+			// remove only mi credentials
+			environment.MI = MiCredential{}
+			s.credentials.Environments[env] = environment
+		}
+		return nil
+	})
+}
+
+// EraseMG remove microgateway credentials from the store
+func (s *JsonStore) EraseMG(env string) error {
+	return s.update(func() error {
+		environment, ok := s.credentials.Environments[env]
+		if !ok {
+			return fmt.Errorf("%s was not found", env)
+		}
+		if !apimCredentialsExists(environment.APIM) && !miCredentialsExists(environment.MI) {
+			// delete the environment
+			delete(s.credentials.Environments, env)
+		} else {
+			// remove only microgateway credentials
+			environment.MG = MgwCredential{}
+			s.credentials.Environments[env] = environment
+		}
+		return nil
+	})
 }
 
 // IsKeychainEnabled returns if another store is activated
@@ -219,10 +311,24 @@ func (s *JsonStore) HasMI(env string) bool {
 	return false
 }
 
+// HasMG return the existance of microgateway credentials in the store for a given environment
+func (s *JsonStore) HasMG(env string) bool {
+	if environment, ok := s.credentials.Environments[env]; ok {
+		return mgwCredentialsExists(environment.MG)
+	}
+	return false
+}
+
+// miCredentialsExists returns true if an access token exists. The password is optional, in which case it is
+// prompted for when the access token has to be renewed
 func miCredentialsExists(miCred MiCredential) bool {
-	return miCred.AccessToken != "" && miCred.Username != "" && miCred.Password != ""
+	return miCred.AccessToken != "" && miCred.Username != ""
 }
 
 func apimCredentialsExists(apimCred Credential) bool {
 	return apimCred.ClientId != "" && apimCred.ClientSecret != "" && apimCred.Username != "" && apimCred.Password != ""
 }
+
+func mgwCredentialsExists(mgwCred MgwCredential) bool {
+	return mgwCred.Username != "" && mgwCred.Password != ""
+}
@@ -0,0 +1,112 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package credentials
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// MgwCredential for storing microgateway adapter user details
+type MgwCredential struct {
+	// Username of microgateway adapter user
+	Username string `json:"username"`
+	// Password of microgateway adapter user
+	Password string `json:"password"`
+}
+
+// GetMGCredentials returns credentials for microgateway
+func GetMGCredentials(env string) (MgwCredential, error) {
+	store, err := GetDefaultCredentialStore()
+	if err != nil {
+		return MgwCredential{}, err
+	}
+
+	if !utils.MgwAdapterExistsInEnv(env, utils.MainConfigFilePath) {
+		return MgwCredential{}, fmt.Errorf("MG does not exists in %s. Add it using add env", env)
+	}
+
+	if !store.HasMG(env) {
+		fmt.Println("Login to MG in", env)
+		err = RunMGLogin(store, env, "", "")
+		if err != nil {
+			return MgwCredential{}, err
+		}
+		fmt.Println()
+	}
+	return store.GetMGCredentials(env)
+}
+
+// GetMgwBasicAuth returns basic auth username:password of the microgateway adapter user encoded in base64
+func GetMgwBasicAuth(credential MgwCredential) string {
+	return Base64Encode(credential.Username + ":" + credential.Password)
+}
+
+// RunMGLogin prompt user to input microgateway adapter username and password and store them
+func RunMGLogin(store Store, environment, username, password string) error {
+	if !utils.MgwAdapterExistsInEnv(environment, utils.MainConfigFilePath) {
+		return fmt.Errorf("MG does not exists in %s. Add it using add env", environment)
+	}
+	if username == "" {
+		fmt.Print("Username:")
+		scanner := bufio.NewScanner(os.Stdin)
+		if scanner.Scan() {
+			username = scanner.Text()
+		}
+	}
+
+	if password == "" {
+		fmt.Print("Password:")
+		pass, err := terminal.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return err
+		}
+		password = string(pass)
+		fmt.Println()
+	}
+
+	err := store.SetMGCredentials(environment, username, password)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Logged into MG in", environment, "environment")
+	return nil
+}
+
+// RunMGLogout removes microgateway credentials from the store
+func RunMGLogout(environment string) error {
+	store, err := GetDefaultCredentialStore()
+	if err != nil {
+		return err
+	}
+	if !store.HasMG(environment) {
+		return fmt.Errorf("not logged into MG in %s environment", environment)
+	}
+	err = store.EraseMG(environment)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Logged out from MG in", environment, "environment")
+	return nil
+}
@@ -20,12 +20,16 @@ package credentials
 
 import (
 	"bufio"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"golang.org/x/crypto/ssh/terminal"
@@ -39,8 +43,14 @@ type MiCredential struct {
 	Password string `json:"password"`
 	// AccessToken of mi
 	AccessToken string `json:"accessToken"`
+	// AccessTokenExpiry is the time at which the access token expires in seconds since the epoch. It is zero if the
+	// expiry time of the access token is not known
+	AccessTokenExpiry int64 `json:"accessTokenExpiry,omitempty"`
 }
 
+// miAccessTokenExpiryMargin is the time before the expiry of an access token from which it is renewed before use
+const miAccessTokenExpiryMargin = 30 * time.Second
+
 // GetMICredentials returns credentials for mi
 func GetMICredentials(env string) (MiCredential, error) {
 
@@ -57,7 +67,7 @@ func GetMICredentials(env string) (MiCredential, error) {
 	if !store.HasMI(env) {
 
 		fmt.Println("Login to MI in", env)
-		err = RunMILogin(store, env, "", "")
+		err = RunMILogin(store, env, "", "", true)
 		if err != nil {
 			return MiCredential{}, err
 		}
@@ -84,6 +94,84 @@ func UpdateMIAccessToken(env, accessToken string) error {
 	return store.SetMICredentials(env, cred.Username, cred.Password, accessToken)
 }
 
+// GetMIAccessToken returns the access token of mi in an environment. The access token is renewed if it has expired
+func GetMIAccessToken(env string) (string, error) {
+	cred, err := GetMICredentials(env)
+	if err != nil {
+		return "", err
+	}
+	if !IsMIAccessTokenExpired(cred) {
+		return cred.AccessToken, nil
+	}
+	utils.Logln(utils.LogPrefixInfo + "MI access token of " + env + " has expired")
+	return RenewMIAccessToken(env)
+}
+
+// RenewMIAccessToken gets a new access token for mi in an environment and stores it. The stored password is used, or
+// the password is prompted for if it is not stored
+func RenewMIAccessToken(env string) (string, error) {
+	cred, err := GetMICredentials(env)
+	if err != nil {
+		return "", err
+	}
+	password, err := getMIPassword(env, cred)
+	if err != nil {
+		return "", err
+	}
+	accessToken, err := GetOAuthAccessTokenForMI(cred.Username, password, env)
+	if err != nil {
+		return "", err
+	}
+	return accessToken, UpdateMIAccessToken(env, accessToken)
+}
+
+// IsMIAccessTokenExpired returns true if the access token of the credentials has expired or expires shortly. An
+// access token of which the expiry time is not known is considered valid until it is rejected
+func IsMIAccessTokenExpired(cred MiCredential) bool {
+	if cred.AccessTokenExpiry == 0 {
+		return false
+	}
+	return time.Now().Add(miAccessTokenExpiryMargin).Unix() >= cred.AccessTokenExpiry
+}
+
+// GetMIAccessTokenExpiry returns the expiry time of a mi access token in seconds since the epoch, which is read from
+// the exp claim of the token. It returns zero if the token is not a JWT or does not have an expiry time
+func GetMIAccessTokenExpiry(accessToken string) int64 {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return 0
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return 0
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return 0
+	}
+	return claims.Exp
+}
+
+// getMIPassword returns the stored password of the credentials, or prompts for the password if it is not stored
+func getMIPassword(env string, cred MiCredential) (string, error) {
+	if cred.Password != "" {
+		return cred.Password, nil
+	}
+	if !terminal.IsTerminal(int(syscall.Stdin)) {
+		return "", errors.New("the MI access token of " + env + " is no longer valid and the password is not stored. " +
+			"Execute '" + utils.ProjectName + " " + utils.MiCmdLiteral + " login " + env + "' to login again")
+	}
+	fmt.Print("Password for ", cred.Username, " in ", env, ":")
+	pass, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	return string(pass), nil
+}
+
 // GetOAuthAccessTokenForMI returns access token for mi
 func GetOAuthAccessTokenForMI(username, password, env string) (string, error) {
 
@@ -94,7 +182,7 @@ func GetOAuthAccessTokenForMI(username, password, env string) (string, error) {
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " + b64encodedCredentials
 
-	resp, err := utils.InvokeGETRequest(tokenEndpoint, headers)
+	resp, err := utils.InvokeMIRequest(env, http.MethodGet, tokenEndpoint, headers, nil, nil)
 	utils.Logln(utils.LogPrefixInfo + "connecting to " + tokenEndpoint)
 
 	if err != nil {
@@ -125,7 +213,7 @@ func RevokeAccessTokenForMI(env, token string) error {
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + token
 
-	resp, err := utils.InvokeGETRequest(tokenRevokeEndpoint, headers)
+	resp, err := utils.InvokeMIRequest(env, http.MethodGet, tokenRevokeEndpoint, headers, nil, nil)
 	utils.Logln(utils.LogPrefixInfo + "connecting to " + tokenRevokeEndpoint)
 
 	if err != nil {
@@ -139,8 +227,9 @@ func RevokeAccessTokenForMI(env, token string) error {
 	return nil
 }
 
-// RunMILogin prompt user to input MI management API username and password
-func RunMILogin(store Store, environment, username, password string) error {
+// RunMILogin prompt user to input MI management API username and password. The password is stored with the access
+// token only if storePassword is true. Otherwise it is prompted for when the access token has to be renewed
+func RunMILogin(store Store, environment, username, password string, storePassword bool) error {
 	if !utils.MIExistsInEnv(environment, utils.MainConfigFilePath) {
 		fmt.Println("MI does not exists in", environment, "Add it using add env")
 		os.Exit(1)
@@ -163,12 +252,19 @@ func RunMILogin(store Store, environment, username, password string) error {
 		fmt.Println()
 	}
 
+	if nodes := getMINodesOfCluster(environment); len(nodes) > 0 {
+		return runMIClusterLogin(store, environment, nodes, username, password, storePassword)
+	}
+
 	accessToken, err := GetOAuthAccessTokenForMI(username, password, environment)
 	if err != nil {
 		return err
 	}
 
 	fmt.Println("Logged into MI in", environment, "environment")
+	if !storePassword {
+		password = ""
+	}
 	err = store.SetMICredentials(environment, username, password, accessToken)
 	if err != nil {
 		return err
@@ -176,8 +272,89 @@ func RunMILogin(store Store, environment, username, password string) error {
 	return nil
 }
 
+// runMIClusterLogin logs into each node of a Micro Integrator cluster and stores the credentials of the nodes.
+// Credentials of the cluster are stored with the access token of the first node which could be logged into, so that
+// the nodes which failed can be logged into later by EnsureMINodeCredentials
+func runMIClusterLogin(store Store, environment string, nodes []string, username, password string,
+	storePassword bool) error {
+	var clusterAccessToken string
+	storedPassword := password
+	if !storePassword {
+		storedPassword = ""
+	}
+	var loggedInNodes, failedNodes []string
+	for _, node := range nodes {
+		nodeEnv := utils.GetMINodeEnvName(environment, node)
+		accessToken, err := GetOAuthAccessTokenForMI(username, password, nodeEnv)
+		if err != nil {
+			fmt.Println("Error logging into MI node", node, "in", environment, "environment:", err)
+			failedNodes = append(failedNodes, node)
+			continue
+		}
+		err = store.SetMICredentials(nodeEnv, username, storedPassword, accessToken)
+		if err != nil {
+			return err
+		}
+		loggedInNodes = append(loggedInNodes, node)
+		if clusterAccessToken == "" {
+			clusterAccessToken = accessToken
+		}
+	}
+	if len(loggedInNodes) == 0 {
+		return errors.New("Unable to login to any MI node in " + environment)
+	}
+	fmt.Println("Logged into MI nodes", strings.Join(loggedInNodes, ", "), "in", environment, "environment")
+	err := store.SetMICredentials(environment, username, storedPassword, clusterAccessToken)
+	if err != nil {
+		return err
+	}
+	if len(failedNodes) > 0 {
+		return errors.New(strconv.Itoa(len(failedNodes)) + " of " + strconv.Itoa(len(nodes)) +
+			" MI nodes could not be logged into: " + strings.Join(failedNodes, ", "))
+	}
+	return nil
+}
+
+// EnsureMINodeCredentials logs into the nodes of a Micro Integrator cluster which do not have credentials in the store
+// using the credentials of the cluster. Nodes which could not be logged into are returned with the errors
+func EnsureMINodeCredentials(environment string) (map[string]error, error) {
+	cred, err := GetMICredentials(environment)
+	if err != nil {
+		return nil, err
+	}
+	store, err := GetDefaultCredentialStore()
+	if err != nil {
+		return nil, err
+	}
+	failedNodes := make(map[string]error)
+	var password string
+	for _, node := range getMINodesOfCluster(environment) {
+		nodeEnv := utils.GetMINodeEnvName(environment, node)
+		if store.HasMI(nodeEnv) {
+			continue
+		}
+		if password == "" {
+			if password, err = getMIPassword(environment, cred); err != nil {
+				return nil, err
+			}
+		}
+		accessToken, err := GetOAuthAccessTokenForMI(cred.Username, password, nodeEnv)
+		if err == nil {
+			err = store.SetMICredentials(nodeEnv, cred.Username, cred.Password, accessToken)
+		}
+		if err != nil {
+			utils.Logln(utils.LogPrefixWarning+"Unable to login to node "+node+" in "+environment, err)
+			failedNodes[node] = err
+		}
+	}
+	return failedNodes, nil
+}
+
 // RunMILogout revoke mi management token and remove credentials from the store
 func RunMILogout(environment string) error {
+	if nodes := getMINodesOfCluster(environment); len(nodes) > 0 {
+		return runMIClusterLogout(environment, nodes)
+	}
 	cred, err := GetMICredentials(environment)
 	if err != nil {
 		return err
@@ -194,6 +371,52 @@ func RunMILogout(environment string) error {
 	return store.EraseMI(environment)
 }
 
+// runMIClusterLogout logs out from each node of a Micro Integrator cluster and removes the credentials of the nodes
+// and the cluster from the store
+func runMIClusterLogout(environment string, nodes []string) error {
+	store, err := GetDefaultCredentialStore()
+	if err != nil {
+		return err
+	}
+	var failedNodes []string
+	for _, node := range nodes {
+		nodeEnv := utils.GetMINodeEnvName(environment, node)
+		if !store.HasMI(nodeEnv) {
+			continue
+		}
+		cred, err := store.GetMICredentials(nodeEnv)
+		if err == nil {
+			err = RevokeAccessTokenForMI(nodeEnv, cred.AccessToken)
+		}
+		if err != nil {
+			utils.Logln(utils.LogPrefixWarning+"Unable to logout from node "+node+" in "+environment, err)
+			failedNodes = append(failedNodes, node)
+		}
+		if err = store.EraseMI(nodeEnv); err != nil {
+			return err
+		}
+	}
+	if store.HasMI(environment) {
+		if err = store.EraseMI(environment); err != nil {
+			return err
+		}
+	}
+	if len(failedNodes) > 0 {
+		return errors.New("Error logging out of MI nodes " + strings.Join(failedNodes, ", ") + " in " + environment +
+			". Their credentials were removed from the store")
+	}
+	fmt.Println("Logged out from MI in", environment, "environment")
+	return nil
+}
+
+// getMINodesOfCluster returns the nodes of an environment if it is a Micro Integrator cluster
+func getMINodesOfCluster(environment string) []string {
+	if _, node := utils.SplitMINodeEnvName(environment); node != "" {
+		return nil
+	}
+	return utils.GetMINodesOfEnv(environment, utils.MainConfigFilePath)
+}
+
 // HandleMissingCredentials check for missing credentials and prompt to enter credentials or print error and exit
 func HandleMissingCredentials(env string) {
 	_, err := GetMICredentials(env)
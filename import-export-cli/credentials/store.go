@@ -23,18 +23,26 @@ type Store interface {
 	HasAPIM(env string) bool
 	// HasMI return the existance of mi credentials in the store for a given environment
 	HasMI(env string) bool
+	// HasMG return the existance of microgateway credentials in the store for a given environment
+	HasMG(env string) bool
 	// GetAPIMCredentials returns credentials for apim from the store or an error
 	GetAPIMCredentials(env string) (Credential, error)
 	// GetMICredentials returns credentials for micro integrator from the store or an error
 	GetMICredentials(env string) (MiCredential, error)
+	// GetMGCredentials returns credentials for microgateway from the store or an error
+	GetMGCredentials(env string) (MgwCredential, error)
 	// SetAPIMCredentials sets credentials for micro integrator using username, password, clientID and client secret
 	SetAPIMCredentials(env, username, password, clientID, clientSecret string) error
 	// SetMICredentials sets credentials for micro integrator using username, password and access token
 	SetMICredentials(env, username, password, accessToken string) error
+	// SetMGCredentials sets credentials for microgateway using username and password
+	SetMGCredentials(env, username, password string) error
 	// Erase apim credentials in a given environment
 	EraseAPIM(env string) error
 	// Erase mi credentials in a given environment
 	EraseMI(env string) error
+	// Erase microgateway credentials in a given environment
+	EraseMG(env string) error
 	// Load store
 	Load() error
 }
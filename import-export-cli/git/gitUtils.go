@@ -335,10 +335,10 @@ func handleIfError(err error, failedProjects map[string][]*params.ProjectParams,
 //  failed during the deployment
 func deployUpdatedProjects(accessToken, repoId, environment string, totalProjectsToUpdate int,
         updatedProjectsPerType map[string][]*params.ProjectParams) (bool, map[string][]*params.ProjectParams,
-        map[string][]*params.ProjectParams) {
+        map[string][]*params.ProjectParams, []*params.ProjectParams) {
     if totalProjectsToUpdate == 0 {
         fmt.Println("Everything is up-to-date")
-        return false, nil, nil
+        return false, nil, nil, nil
     }
 
     fmt.Println("Deploying Projects (" + strconv.Itoa(totalProjectsToUpdate) + ")..." )
@@ -346,6 +346,7 @@ func deployUpdatedProjects(accessToken, repoId, environment string, totalProject
     var failedProjects = make(map[string][]*params.ProjectParams)
     var hasDeletedProjects bool
     var deletedProjectsPerType =make(map[string][]*params.ProjectParams)
+    var deployedApiProjects []*params.ProjectParams
 
     // deploying API projects
     apiProjects := updatedProjectsPerType[utils.ProjectTypeApi]
@@ -365,6 +366,8 @@ func deployUpdatedProjects(accessToken, repoId, environment string, totalProject
             if err != nil {
                 fmt.Println("Error... ", err)
                 failedProjects[projectParam.Type] = append(failedProjects[projectParam.Type], projectParam)
+            } else {
+                deployedApiProjects = append(deployedApiProjects, projectParam)
             }
         }
     }
@@ -421,7 +424,7 @@ func deployUpdatedProjects(accessToken, repoId, environment string, totalProject
         updateVCSConfig(repoId, environment, failedProjects)
     }
 
-    return hasDeletedProjects, deletedProjectsPerType, failedProjects
+    return hasDeletedProjects, deletedProjectsPerType, failedProjects, deployedApiProjects
 }
 
 // This method is responsible for updating the vcs configuration file at the end of the deployment
@@ -468,11 +471,12 @@ func handleProjectDeletion(i int, projectParam *params.ProjectParams, deletedPro
 
 // Scan and detects all the changes in projects by comparing the current revision with the last successful revision.
 // Deploy all the changes to the specified environment.
+// Returns the failed projects and the successfully deployed API projects.
 // accesstoken is the access token to access the APIM product REST APIs
 // environment is the environment name
-func DeployChangedFiles(accessToken, environment string) map[string][]*params.ProjectParams {
+func DeployChangedFiles(accessToken, environment string) (map[string][]*params.ProjectParams, []*params.ProjectParams) {
     repoId, totalProjectsToUpdate, updatedProjectsPerType := GetStatus(environment, FromRevTypeLastAttempted)
-    hasDeletedProjects, deletedProjectsPerType, failedProjects :=
+    hasDeletedProjects, deletedProjectsPerType, failedProjects, deployedApiProjects :=
         deployUpdatedProjects(accessToken, repoId, environment, totalProjectsToUpdate, updatedProjectsPerType)
 
     if hasDeletedProjects {
@@ -488,7 +492,7 @@ func DeployChangedFiles(accessToken, environment string) map[string][]*params.Pr
         if !hasEnv || len(envVCSConfig.LastSuccessfulRev) == 0 {
             utils.HandleErrorAndExit("Error: there are projects to delete but no last successful "+
                 "revision available in vcs config (vcs_config.yaml)", nil)
-            return nil
+            return nil, nil
         }
         currentBranch := getCurrentBranch()
         lastSuccessfulRev := envVCSConfig.LastSuccessfulRev[0]
@@ -503,7 +507,7 @@ func DeployChangedFiles(accessToken, environment string) map[string][]*params.Pr
         // Update the VCS config with failed projects, last attempted and last successful revisions
         updateVCSConfig(repoId, environment, failedProjects)
     }
-    return failedProjects
+    return failedProjects, deployedApiProjects
 }
 
 // Create 'vcs.yaml' in the repository root folder with a unique id (uuid) for the repository.
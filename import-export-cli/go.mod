@@ -20,6 +20,7 @@ require (
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
 	github.com/mitchellh/mapstructure v1.3.2
 	github.com/operator-framework/operator-lifecycle-manager v0.0.0-20200321030439-57b580e57e88 // indirect
+	github.com/pavel-v-chernykh/keystore-go/v4 v4.1.0
 	github.com/renstrom/dedent v1.0.0
 	github.com/spf13/cast v1.3.1
 	github.com/spf13/cobra v1.1.1
@@ -27,9 +28,11 @@ require (
 	github.com/wso2/k8s-api-operator/api-operator v0.0.0-20210125090919-4d9482a2f6b8
 	golang.org/x/crypto v0.0.0-20200414173820-0848c9571904
 	gopkg.in/yaml.v2 v2.3.0
+	k8s.io/api v0.18.2
+	k8s.io/apimachinery v0.18.2
+	k8s.io/client-go v12.0.0+incompatible
 	sigs.k8s.io/testing_frameworks v0.1.1 // indirect
 	sigs.k8s.io/yaml v1.2.0 // indirect
-	github.com/pavel-v-chernykh/keystore-go/v4 v4.1.0
 )
 
 replace k8s.io/client-go => k8s.io/client-go v0.18.2
@@ -19,8 +19,11 @@
 package impl
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -40,7 +43,7 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 		return errors.New("Name of the environment cannot be blank")
 	}
 
-	if !utils.HasOnlyMIEndpoint(envEndpoints) && envEndpoints.TokenEndpoint == "" {
+	if !utils.HasOnlyMIOrMgwEndpoint(envEndpoints) && envEndpoints.TokenEndpoint == "" {
 		// If token endpoint string is empty,then assign the default value
 		if envEndpoints.ApiManagerEndpoint != "" && !isDefaultTokenEndpointSet {
 			isDefaultTokenEndpointSet = true
@@ -53,7 +56,7 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 	}
 
 	if envEndpoints.ApiManagerEndpoint == "" {
-		if !utils.HasOnlyMIEndpoint(envEndpoints) {
+		if !utils.HasOnlyMIOrMgwEndpoint(envEndpoints) {
 			if !utils.RequiredAPIMEndpointsExists(envEndpoints) {
 				utils.ShowHelpCommandTip(addEnvCmdLiteral)
 				return errors.New("Endpoint(s) cannot be blank")
@@ -61,6 +64,27 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 		}
 	}
 
+	if envEndpoints.MiManagementEndpoint != "" && len(envEndpoints.MiManagementNodes) > 0 {
+		return errors.New("Micro Integrator endpoint and nodes cannot be added to the same environment")
+	}
+	for node := range envEndpoints.MiManagementNodes {
+		if node == "" || strings.Contains(node, utils.MiNodeEnvSeparator) {
+			return errors.New("Invalid Micro Integrator node name '" + node + "'")
+		}
+	}
+
+	if (envEndpoints.MiClientCertificate == "") != (envEndpoints.MiClientKey == "") {
+		return errors.New("Both the Micro Integrator client certificate and its key are required")
+	}
+	if envEndpoints.MiClientCertificate != "" {
+		if envEndpoints.MiManagementEndpoint == "" && len(envEndpoints.MiManagementNodes) == 0 {
+			return errors.New("Micro Integrator client certificate cannot be added without a Micro Integrator endpoint")
+		}
+		if _, err := tls.LoadX509KeyPair(envEndpoints.MiClientCertificate, envEndpoints.MiClientKey); err != nil {
+			return errors.New("Invalid Micro Integrator client certificate: " + err.Error())
+		}
+	}
+
 	if utils.EnvExistsInMainConfigFile(envName, mainConfigFilePath) {
 		// environment already exists
 		return errors.New("Environment '" + envName + "' already exists in " + mainConfigFilePath)
@@ -96,6 +120,20 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 		validatedEnvEndpoints.MiManagementEndpoint = envEndpoints.MiManagementEndpoint
 	}
 
+	if len(envEndpoints.MiManagementNodes) > 0 {
+		validatedEnvEndpoints.MiManagementNodes = envEndpoints.MiManagementNodes
+	}
+
+	if envEndpoints.MiClientCertificate != "" {
+		// the files are referred by absolute paths since commands can be run from any directory
+		validatedEnvEndpoints.MiClientCertificate, _ = filepath.Abs(envEndpoints.MiClientCertificate)
+		validatedEnvEndpoints.MiClientKey, _ = filepath.Abs(envEndpoints.MiClientKey)
+	}
+
+	if envEndpoints.MgwAdapterEndpoint != "" {
+		validatedEnvEndpoints.MgwAdapterEndpoint = envEndpoints.MgwAdapterEndpoint
+	}
+
 	mainConfig.Environments[envName] = validatedEnvEndpoints
 	utils.WriteConfigFile(mainConfig, mainConfigFilePath)
 
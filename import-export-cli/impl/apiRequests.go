@@ -0,0 +1,447 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ApiRequest is an HTTP request to a resource of an API built using its OpenAPI definition
+type ApiRequest struct {
+	Method      string
+	Path        string
+	QueryParams map[string]string
+	Headers     map[string]string
+	Body        string
+}
+
+// ApiRequestOverrides holds the parameter values and the body provided by the user for a resource
+type ApiRequestOverrides struct {
+	PathParams  map[string]string
+	QueryParams map[string]string
+	Headers     map[string]string
+	Body        string
+}
+
+// maxSampleDepth is the maximum depth of nested schemas populated when generating sample payloads
+const maxSampleDepth = 5
+
+// swagger 2.0 references which need to be pointed to the components of the converted OpenAPI 3 definition
+var swagger2RefReplacer = strings.NewReplacer(
+	`"#/definitions/`, `"#/components/schemas/`,
+	`"#/parameters/`, `"#/components/parameters/`,
+	`"#/responses/`, `"#/components/responses/`,
+)
+
+// LoadOpenAPIDefinition loads a swagger 2.0 or an OpenAPI 3 definition in JSON or YAML format as an OpenAPI 3
+// definition with all the local references resolved
+// @param content : Content of the definition
+// @return OpenAPI 3 definition, error
+func LoadOpenAPIDefinition(content []byte) (*openapi3.Swagger, error) {
+	jsonContent, err := utils.YamlToJson(content)
+	if err != nil {
+		return nil, err
+	}
+	var version struct {
+		Swagger string `json:"swagger"`
+		OpenAPI string `json:"openapi"`
+	}
+	if err = json.Unmarshal(jsonContent, &version); err != nil {
+		return nil, err
+	}
+
+	if version.Swagger != "" {
+		// Security definitions are not required to build requests and the scopes of OAuth2 security definitions
+		// cannot be unmarshalled to openapi2.SecurityScheme. Hence they are removed before the conversion
+		var definition map[string]interface{}
+		if err = json.Unmarshal([]byte(swagger2RefReplacer.Replace(string(jsonContent))), &definition); err != nil {
+			return nil, err
+		}
+		delete(definition, "securityDefinitions")
+		delete(definition, "security")
+		jsonContent, err = json.Marshal(definition)
+		if err != nil {
+			return nil, err
+		}
+		swagger2 := &openapi2.Swagger{}
+		if err = json.Unmarshal(jsonContent, swagger2); err != nil {
+			return nil, err
+		}
+		swagger3, err := openapi2conv.ToV3Swagger(swagger2)
+		if err != nil {
+			return nil, err
+		}
+		jsonContent, err = json.Marshal(swagger3)
+		if err != nil {
+			return nil, err
+		}
+	} else if version.OpenAPI == "" {
+		return nil, errors.New("neither swagger nor openapi version is specified in the definition")
+	}
+	return openapi3.NewSwaggerLoader().LoadSwaggerFromData(jsonContent)
+}
+
+// ParseResource parses a resource given in the format <METHOD>:<path>. Eg: GET:/pets/{id}
+// @param resource : Resource to be parsed
+// @return method, path, error
+func ParseResource(resource string) (string, string, error) {
+	parts := strings.SplitN(resource, ":", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || !strings.HasPrefix(strings.TrimSpace(parts[1]), "/") {
+		return "", "", errors.New("invalid resource: " + resource + ". Resource should be in the format " +
+			"<METHOD>:<path>. Eg: GET:/pets/{id}")
+	}
+	return strings.ToUpper(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1]), nil
+}
+
+// GetOperation returns the operation of the definition for the given method and resource path
+func GetOperation(swagger *openapi3.Swagger, method, path string) (*openapi3.PathItem, *openapi3.Operation, error) {
+	pathItem := swagger.Paths.Find(path)
+	if pathItem == nil {
+		return nil, nil, errors.New("resource " + path + " is not available in the API definition")
+	}
+	operation := pathItem.GetOperation(method)
+	if operation == nil {
+		return nil, nil, errors.New("method " + method + " is not available for the resource " + path)
+	}
+	return pathItem, operation, nil
+}
+
+// BuildApiRequest builds the request for a resource of an API using the examples in its OpenAPI definition.
+// Parameter values and the body given in the overrides take precedence over the examples
+// @param swagger : OpenAPI definition of the API
+// @param method : HTTP method of the resource
+// @param path : Path of the resource as in the definition
+// @param overrides : Values provided by the user
+// @return request, error
+func BuildApiRequest(swagger *openapi3.Swagger, method, path string, overrides ApiRequestOverrides) (*ApiRequest,
+	error) {
+	return buildApiRequest(swagger, method, path, overrides, false)
+}
+
+// BuildSampleApiRequest builds a request for a resource of an API using the examples in its OpenAPI definition.
+// Values of the parameters and the body without examples are generated using their schemas
+// @param swagger : OpenAPI definition of the API
+// @param method : HTTP method of the resource
+// @param path : Path of the resource as in the definition
+// @return request, error
+func BuildSampleApiRequest(swagger *openapi3.Swagger, method, path string) (*ApiRequest, error) {
+	return buildApiRequest(swagger, method, path, ApiRequestOverrides{}, true)
+}
+
+// buildApiRequest builds the request for a resource of an API. If generateSamples is true, values are generated
+// using the schemas when examples are not available in the definition
+func buildApiRequest(swagger *openapi3.Swagger, method, path string, overrides ApiRequestOverrides,
+	generateSamples bool) (*ApiRequest, error) {
+	pathItem, operation, err := GetOperation(swagger, method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &ApiRequest{
+		Method:      method,
+		Path:        path,
+		QueryParams: make(map[string]string),
+		Headers:     make(map[string]string),
+	}
+	for _, parameter := range mergeParameters(pathItem.Parameters, operation.Parameters) {
+		switch parameter.In {
+		case openapi3.ParameterInPath:
+			value, ok := overrides.PathParams[parameter.Name]
+			if !ok {
+				value, ok = getParameterExample(parameter, generateSamples)
+			}
+			if !ok {
+				return nil, errors.New("value for the path parameter " + parameter.Name + " is not provided and " +
+					"an example is not available in the API definition")
+			}
+			request.Path = strings.Replace(request.Path, "{"+parameter.Name+"}", url.PathEscape(value), -1)
+		case openapi3.ParameterInQuery:
+			if value, ok := getParameterValue(parameter, overrides.QueryParams, generateSamples); ok {
+				request.QueryParams[parameter.Name] = value
+			}
+		case openapi3.ParameterInHeader:
+			if value, ok := getParameterValue(parameter, overrides.Headers, generateSamples); ok {
+				request.Headers[parameter.Name] = value
+			}
+		}
+	}
+	// Values of the parameters which are not in the definition are also sent as given by the user
+	for name, value := range overrides.QueryParams {
+		request.QueryParams[name] = value
+	}
+	for name, value := range overrides.Headers {
+		request.Headers[name] = value
+	}
+
+	request.Body = overrides.Body
+	if request.Body == "" && operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		contentType, example, ok := getRequestBodyExample(operation.RequestBody.Value, generateSamples)
+		if ok {
+			request.Body, err = formatBodyExample(example)
+			if err != nil {
+				return nil, err
+			}
+			request.Headers[utils.HeaderContentType] = contentType
+		}
+	}
+	if request.Body != "" {
+		if _, ok := request.Headers[utils.HeaderContentType]; !ok {
+			request.Headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+		}
+	}
+	return request, nil
+}
+
+// mergeParameters merges the path level parameters with the operation level parameters. Operation level parameters
+// override the path level parameters with the same name and location
+func mergeParameters(pathParameters, operationParameters openapi3.Parameters) []*openapi3.Parameter {
+	var parameters []*openapi3.Parameter
+	for _, parameterRef := range operationParameters {
+		if parameterRef != nil && parameterRef.Value != nil {
+			parameters = append(parameters, parameterRef.Value)
+		}
+	}
+	for _, parameterRef := range pathParameters {
+		if parameterRef == nil || parameterRef.Value == nil {
+			continue
+		}
+		if operationParameters.GetByInAndName(parameterRef.Value.In, parameterRef.Value.Name) == nil {
+			parameters = append(parameters, parameterRef.Value)
+		}
+	}
+	return parameters
+}
+
+// getParameterValue returns the value provided by the user for a parameter, or an example if the parameter is
+// mandatory
+func getParameterValue(parameter *openapi3.Parameter, provided map[string]string, generateSamples bool) (string,
+	bool) {
+	if value, ok := provided[parameter.Name]; ok {
+		return value, true
+	}
+	if parameter.Required {
+		return getParameterExample(parameter, generateSamples)
+	}
+	return "", false
+}
+
+// getParameterExample returns an example value of a parameter from the definition. If generateSamples is true, a
+// value is generated using the schema when an example is not available
+func getParameterExample(parameter *openapi3.Parameter, generateSamples bool) (string, bool) {
+	if parameter.Example != nil {
+		return fmt.Sprint(parameter.Example), true
+	}
+	if example, ok := getFirstExample(parameter.Examples); ok {
+		return fmt.Sprint(example), true
+	}
+	if parameter.Schema != nil && parameter.Schema.Value != nil {
+		schema := parameter.Schema.Value
+		if schema.Example != nil {
+			return fmt.Sprint(schema.Example), true
+		}
+		if schema.Default != nil {
+			return fmt.Sprint(schema.Default), true
+		}
+		if len(schema.Enum) > 0 {
+			return fmt.Sprint(schema.Enum[0]), true
+		}
+		if generateSamples {
+			return fmt.Sprint(generateSchemaSample(schema, 0)), true
+		}
+	}
+	return "", false
+}
+
+// getRequestBodyExample returns the content type and an example of a request body. JSON content is preferred. If
+// generateSamples is true, a JSON payload is generated using the schema when an example is not available
+func getRequestBodyExample(requestBody *openapi3.RequestBody, generateSamples bool) (string, interface{}, bool) {
+	var contentTypes []string
+	for contentType := range requestBody.Content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	// JSON content types first, then by name, so the same content type is chosen for every run
+	sort.SliceStable(contentTypes, func(i, j int) bool {
+		isJSONi, isJSONj := strings.Contains(contentTypes[i], "json"), strings.Contains(contentTypes[j], "json")
+		if isJSONi != isJSONj {
+			return isJSONi
+		}
+		return contentTypes[i] < contentTypes[j]
+	})
+	for _, contentType := range contentTypes {
+		mediaType := requestBody.Content[contentType]
+		if mediaType == nil {
+			continue
+		}
+		if mediaType.Example != nil {
+			return contentType, mediaType.Example, true
+		}
+		if example, ok := getFirstExample(mediaType.Examples); ok {
+			return contentType, example, true
+		}
+		if mediaType.Schema != nil && mediaType.Schema.Value != nil && mediaType.Schema.Value.Example != nil {
+			return contentType, mediaType.Schema.Value.Example, true
+		}
+	}
+	if generateSamples {
+		for _, contentType := range contentTypes {
+			mediaType := requestBody.Content[contentType]
+			if strings.Contains(contentType, "json") && mediaType != nil && mediaType.Schema != nil &&
+				mediaType.Schema.Value != nil {
+				return contentType, generateSchemaSample(mediaType.Schema.Value, 0), true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+// generateSchemaSample generates a sample value which conforms to a schema. Examples, default values and enums of
+// the schema are used when available
+// @param schema : Schema of the value
+// @param depth : Depth of the schema from the root schema
+// @return sample value
+func generateSchemaSample(schema *openapi3.Schema, depth int) interface{} {
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if schema.Default != nil {
+		return schema.Default
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+	if len(schema.AllOf) > 0 {
+		sample := make(map[string]interface{})
+		for _, schemaRef := range schema.AllOf {
+			if schemaRef == nil || schemaRef.Value == nil {
+				continue
+			}
+			if properties, ok := generateSchemaSample(schemaRef.Value, depth).(map[string]interface{}); ok {
+				for name, value := range properties {
+					sample[name] = value
+				}
+			}
+		}
+		return sample
+	}
+	for _, schemaRefs := range [][]*openapi3.SchemaRef{schema.OneOf, schema.AnyOf} {
+		if len(schemaRefs) > 0 && schemaRefs[0] != nil && schemaRefs[0].Value != nil {
+			return generateSchemaSample(schemaRefs[0].Value, depth)
+		}
+	}
+
+	switch schema.Type {
+	case "array":
+		if schema.Items == nil || schema.Items.Value == nil || depth >= maxSampleDepth {
+			return []interface{}{}
+		}
+		return []interface{}{generateSchemaSample(schema.Items.Value, depth+1)}
+	case "integer":
+		if schema.Min != nil {
+			return int64(*schema.Min)
+		}
+		return 1
+	case "number":
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return 1.0
+	case "boolean":
+		return true
+	case "string":
+		return generateStringSample(schema)
+	}
+
+	// Schemas without a type are considered as objects if they have properties
+	sample := make(map[string]interface{})
+	if depth >= maxSampleDepth {
+		return sample
+	}
+	for name, property := range schema.Properties {
+		if property == nil || property.Value == nil || property.Value.ReadOnly {
+			continue
+		}
+		sample[name] = generateSchemaSample(property.Value, depth+1)
+	}
+	return sample
+}
+
+// generateStringSample generates a sample string based on the format of a string schema
+func generateStringSample(schema *openapi3.Schema) string {
+	var sample string
+	switch schema.Format {
+	case "date":
+		sample = "2020-01-01"
+	case "date-time":
+		sample = "2020-01-01T00:00:00Z"
+	case "email":
+		sample = "user@example.com"
+	case "uuid":
+		sample = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case "uri", "url":
+		sample = "http://example.com"
+	case "byte":
+		sample = "c3RyaW5n"
+	default:
+		sample = "string"
+	}
+	for uint64(len(sample)) < schema.MinLength {
+		sample += sample
+	}
+	if schema.MaxLength != nil && uint64(len(sample)) > *schema.MaxLength {
+		sample = sample[:*schema.MaxLength]
+	}
+	return sample
+}
+
+// getFirstExample returns the value of the first example in alphabetical order of the example names
+func getFirstExample(examples map[string]*openapi3.ExampleRef) (interface{}, bool) {
+	var names []string
+	for name, example := range examples {
+		if example != nil && example.Value != nil && example.Value.Value != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, false
+	}
+	sort.Strings(names)
+	return examples[names[0]].Value.Value, true
+}
+
+// formatBodyExample converts an example of a request body into the request payload
+func formatBodyExample(example interface{}) (string, error) {
+	if str, ok := example.(string); ok {
+		return str, nil
+	}
+	content, err := json.Marshal(example)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
@@ -0,0 +1,126 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestParseResource(t *testing.T) {
+	method, path, err := ParseResource("get:/pets/{petId}")
+	assert.Nil(t, err)
+	assert.Equal(t, "GET", method)
+	assert.Equal(t, "/pets/{petId}", path)
+
+	_, _, err = ParseResource("/pets")
+	assert.NotNil(t, err, "Resource without a method should not be accepted")
+}
+
+func TestBuildApiRequestFromOpenAPI3Examples(t *testing.T) {
+	content, err := ioutil.ReadFile("testdata/swaggers/petstore_openapi3.yaml")
+	assert.Nil(t, err)
+	swagger, err := LoadOpenAPIDefinition(content)
+	assert.Nil(t, err)
+
+	request, err := BuildApiRequest(swagger, "GET", "/pets/{petId}", ApiRequestOverrides{})
+	assert.Nil(t, err)
+	assert.Equal(t, "/pets/1", request.Path)
+
+	request, err = BuildApiRequest(swagger, "GET", "/pets", ApiRequestOverrides{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"status": "available"}, request.QueryParams,
+		"Only the mandatory query parameters should be sent")
+
+	request, err = BuildApiRequest(swagger, "POST", "/pets", ApiRequestOverrides{})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"id": 10, "name": "doggie"}`, request.Body)
+	assert.Equal(t, utils.HeaderValueApplicationJSON, request.Headers[utils.HeaderContentType])
+}
+
+func TestBuildApiRequestWithOverrides(t *testing.T) {
+	content, err := ioutil.ReadFile("testdata/swaggers/petstore_openapi3.yaml")
+	assert.Nil(t, err)
+	swagger, err := LoadOpenAPIDefinition(content)
+	assert.Nil(t, err)
+
+	request, err := BuildApiRequest(swagger, "GET", "/pets/{petId}", ApiRequestOverrides{
+		PathParams: map[string]string{"petId": "25"},
+		Headers:    map[string]string{"X-Request-Id": "1234"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "/pets/25", request.Path)
+	assert.Equal(t, "1234", request.Headers["X-Request-Id"])
+
+	_, err = BuildApiRequest(swagger, "DELETE", "/pets/{petId}", ApiRequestOverrides{})
+	assert.NotNil(t, err, "Undefined operations should not be accepted")
+}
+
+func TestLoadSwagger2Definition(t *testing.T) {
+	content, err := ioutil.ReadFile("../specs/v2/testdata/petstore_swagger2.yaml")
+	assert.Nil(t, err)
+	swagger, err := LoadOpenAPIDefinition(content)
+	assert.Nil(t, err)
+
+	_, operation, err := GetOperation(swagger, "GET", "/pet/{petId}")
+	assert.Nil(t, err)
+	assert.NotNil(t, operation)
+}
+
+func TestBuildSampleApiRequestFromSchemas(t *testing.T) {
+	content, err := ioutil.ReadFile("testdata/swaggers/petstore_openapi3.yaml")
+	assert.Nil(t, err)
+	swagger, err := LoadOpenAPIDefinition(content)
+	assert.Nil(t, err)
+
+	_, err = BuildApiRequest(swagger, "PUT", "/pets/{petId}", ApiRequestOverrides{})
+	assert.Nil(t, err)
+
+	request, err := BuildSampleApiRequest(swagger, "PUT", "/pets/{petId}")
+	assert.Nil(t, err)
+	assert.Equal(t, "/pets/1", request.Path)
+	assert.JSONEq(t, `{"id": 1, "name": "string", "tags": ["string"], "status": "available"}`, request.Body)
+}
+
+func TestGetRequestBodyExamplePrefersJSONContentTypes(t *testing.T) {
+	requestBody := &openapi3.RequestBody{Content: openapi3.Content{
+		"text/plain":               &openapi3.MediaType{Example: "plain"},
+		"application/xml":          &openapi3.MediaType{Example: "<xml/>"},
+		"application/vnd.pet+json": &openapi3.MediaType{Example: "vnd"},
+		"application/json":         &openapi3.MediaType{Example: "json"},
+	}}
+
+	for i := 0; i < 10; i++ {
+		contentType, example, ok := getRequestBodyExample(requestBody, false)
+		assert.True(t, ok)
+		assert.Equal(t, "application/json", contentType)
+		assert.Equal(t, "json", example)
+	}
+
+	delete(requestBody.Content, "application/json")
+	delete(requestBody.Content, "application/vnd.pet+json")
+	contentType, example, ok := getRequestBodyExample(requestBody, false)
+	assert.True(t, ok)
+	assert.Equal(t, "application/xml", contentType)
+	assert.Equal(t, "<xml/>", example)
+}
@@ -0,0 +1,359 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// AppKeyOptions holds the parameters used when generating, updating or mapping keys of an application
+type AppKeyOptions struct {
+	KeyType        string
+	KeyManager     string
+	GrantTypes     []string
+	CallbackURL    string
+	Scopes         []string
+	ValidityTime   int
+	ConsumerKey    string
+	ConsumerSecret string
+}
+
+// AppKeysOutput is the representation of application keys written to the console or to a file
+type AppKeysOutput struct {
+	Application         string   `json:"application"`
+	KeyType             string   `json:"keyType"`
+	KeyManager          string   `json:"keyManager"`
+	KeyMappingID        string   `json:"keyMappingId"`
+	ConsumerKey         string   `json:"consumerKey"`
+	ConsumerSecret      string   `json:"consumerSecret"`
+	SupportedGrantTypes []string `json:"supportedGrantTypes"`
+	CallbackURL         string   `json:"callbackUrl,omitempty"`
+}
+
+// ValidateKeyType checks whether the given key type is either PRODUCTION or SANDBOX
+// @param keyType : Key type provided by the user
+// @return key type in upper case, error
+func ValidateKeyType(keyType string) (string, error) {
+	keyType = strings.ToUpper(strings.TrimSpace(keyType))
+	if keyType != utils.KeyTypeProduction && keyType != utils.KeyTypeSandbox {
+		return "", errors.New("invalid key type: " + keyType + ". Key type should be either " +
+			utils.KeyTypeProduction + " or " + utils.KeyTypeSandbox)
+	}
+	return keyType, nil
+}
+
+// GetDevPortalAppId searches the DevPortal for an application of the logged in user with the exact given name
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment to search the application
+// @param appName : Name of the application
+// @return appId, error
+func GetDevPortalAppId(accessToken, environment, appName string) (string, error) {
+	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequestWithQueryParam("query", appName, applicationEndpoint, headers)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		appData := &utils.AppList{}
+		err = json.Unmarshal(resp.Body(), &appData)
+		if err != nil {
+			return "", err
+		}
+		for _, app := range appData.List {
+			if app.Name == appName {
+				return app.ApplicationID, nil
+			}
+		}
+		return "", errors.New("Cannot find the application: " + appName)
+	}
+	return "", handleAppKeysErrorResponse(resp, "searching the application: "+appName)
+}
+
+// GetAppKeys retrieves all the OAuth keys generated or mapped for an application
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @return list of application keys, error
+func GetAppKeys(accessToken, environment, appId string) ([]utils.ApplicationKey, error) {
+	oauthKeysEndpoint := getOAuthKeysEndpoint(environment, appId)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(oauthKeysEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		keyList := &utils.AppKeyList{}
+		err = json.Unmarshal(resp.Body(), &keyList)
+		return keyList.List, err
+	}
+	return nil, handleAppKeysErrorResponse(resp, "retrieving the keys of application: "+appId)
+}
+
+// GetAppKey retrieves the OAuth key of an application for the given key type and key manager
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @param keyType : PRODUCTION or SANDBOX
+// @param keyManager : Name of the key manager
+// @return application key, error
+func GetAppKey(accessToken, environment, appId, keyType, keyManager string) (*utils.ApplicationKey, error) {
+	keys, err := GetAppKeys(accessToken, environment, appId)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		if strings.EqualFold(key.KeyType, keyType) && (keyManager == "" || key.KeyManager == keyManager) {
+			return &keys[i], nil
+		}
+	}
+	return nil, errors.New("no " + keyType + " keys found for the key manager: " + keyManager)
+}
+
+// GenerateAppKeys generates production or sandbox keys of an application
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @param opts : Key type, key manager, grant types, callback URL and validity time
+// @return key generation response, error
+func GenerateAppKeys(accessToken, environment, appId string, opts AppKeyOptions) (*utils.KeygenResponse, error) {
+	generateKeysEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId + "/generate-keys"
+	grantTypes := opts.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = utils.DefaultGrantTypes
+	}
+	validityTime := opts.ValidityTime
+	if validityTime == 0 {
+		validityTime = utils.DefaultTokenValidityPeriod
+	}
+	generateKeyReq := utils.KeygenRequest{
+		KeyType:                 opts.KeyType,
+		KeyManager:              opts.KeyManager,
+		GrantTypesToBeSupported: grantTypes,
+		CallbackURL:             opts.CallbackURL,
+		Scopes:                  opts.Scopes,
+		ValidityTime:            validityTime,
+	}
+	body, err := json.Marshal(generateKeyReq)
+	if err != nil {
+		return nil, err
+	}
+	return invokeKeygenRequest(accessToken, generateKeysEndpoint, string(body), "generating keys of application: "+appId)
+}
+
+// MapAppKeys maps the consumer key and secret of an externally created OAuth client to an application
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @param opts : Key type, key manager, consumer key and consumer secret
+// @return key mapping response, error
+func MapAppKeys(accessToken, environment, appId string, opts AppKeyOptions) (*utils.KeygenResponse, error) {
+	mapKeysEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId + "/map-keys"
+	mapKeysReq := utils.KeyMappingRequest{
+		ConsumerKey:    opts.ConsumerKey,
+		ConsumerSecret: opts.ConsumerSecret,
+		KeyType:        opts.KeyType,
+		KeyManager:     opts.KeyManager,
+	}
+	body, err := json.Marshal(mapKeysReq)
+	if err != nil {
+		return nil, err
+	}
+	return invokeKeygenRequest(accessToken, mapKeysEndpoint, string(body), "mapping keys of application: "+appId)
+}
+
+// UpdateAppKeys updates the grant types and the callback URL of existing application keys
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @param key : Existing application key to be updated
+// @param opts : Grant types and callback URL to be set
+// @return updated application key, error
+func UpdateAppKeys(accessToken, environment, appId string, key *utils.ApplicationKey,
+	opts AppKeyOptions) (*utils.ApplicationKey, error) {
+	oauthKeyEndpoint := getOAuthKeysEndpoint(environment, appId) + "/" + key.KeyMappingID
+	if len(opts.GrantTypes) > 0 {
+		key.SupportedGrantTypes = opts.GrantTypes
+	}
+	if opts.CallbackURL != "" {
+		key.CallbackURL = opts.CallbackURL
+	}
+	body, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePutRequest(nil, oauthKeyEndpoint, headers, string(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		updatedKey := &utils.ApplicationKey{}
+		err = json.Unmarshal(resp.Body(), &updatedKey)
+		return updatedKey, err
+	}
+	return nil, handleAppKeysErrorResponse(resp, "updating the keys of application: "+appId)
+}
+
+// RegenerateAppConsumerSecret regenerates the consumer secret of an application key
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @param keyMappingId : Key mapping ID of the key
+// @return consumer key and the new consumer secret, error
+func RegenerateAppConsumerSecret(accessToken, environment, appId,
+	keyMappingId string) (*utils.ConsumerSecretRegenResponse, error) {
+	regenerateEndpoint := getOAuthKeysEndpoint(environment, appId) + "/" + keyMappingId + "/regenerate-secret"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokePOSTRequestWithoutBody(regenerateEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		regenResponse := &utils.ConsumerSecretRegenResponse{}
+		err = json.Unmarshal(resp.Body(), &regenResponse)
+		return regenResponse, err
+	}
+	return nil, handleAppKeysErrorResponse(resp, "regenerating the consumer secret of application: "+appId)
+}
+
+// CleanUpAppKeys removes the keys of an application, so that the keys can be generated or mapped again
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the application
+// @param appId : Application ID
+// @param keyMappingId : Key mapping ID of the key
+// @return error
+func CleanUpAppKeys(accessToken, environment, appId, keyMappingId string) error {
+	cleanUpEndpoint := getOAuthKeysEndpoint(environment, appId) + "/" + keyMappingId + "/clean-up"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokePOSTRequestWithoutBody(cleanUpEndpoint, headers)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		return nil
+	}
+	return handleAppKeysErrorResponse(resp, "cleaning up the keys of application: "+appId)
+}
+
+// NewAppKeysOutput creates the output representation of application keys
+func NewAppKeysOutput(appName, keyType, keyManager, keyMappingId, consumerKey, consumerSecret string,
+	grantTypes []string, callbackURL interface{}) *AppKeysOutput {
+	output := &AppKeysOutput{
+		Application:         appName,
+		KeyType:             keyType,
+		KeyManager:          keyManager,
+		KeyMappingID:        keyMappingId,
+		ConsumerKey:         consumerKey,
+		ConsumerSecret:      consumerSecret,
+		SupportedGrantTypes: grantTypes,
+	}
+	if callbackURL != nil {
+		output.CallbackURL = fmt.Sprint(callbackURL)
+	}
+	return output
+}
+
+// PrintAppKeys writes the application keys to the given output file with owner only permissions, or prints them
+// to the console when an output file is not given
+// @param keys : Application keys to be written
+// @param outputFile : Path of the file to write the keys
+// @return error
+func PrintAppKeys(keys *AppKeysOutput, outputFile string) error {
+	if outputFile != "" {
+		content, err := json.MarshalIndent(keys, "", "  ")
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(outputFile, content, 0600)
+		if err != nil {
+			return err
+		}
+		fmt.Println("Keys of the application " + keys.Application + " were written to " + outputFile)
+		return nil
+	}
+	fmt.Println("Key Type: " + keys.KeyType)
+	fmt.Println("Key Manager: " + keys.KeyManager)
+	fmt.Println("Consumer Key: " + keys.ConsumerKey)
+	fmt.Println("Consumer Secret: " + keys.ConsumerSecret)
+	if len(keys.SupportedGrantTypes) > 0 {
+		fmt.Println("Grant Types: " + strings.Join(keys.SupportedGrantTypes, ","))
+	}
+	if keys.CallbackURL != "" {
+		fmt.Println("Callback URL: " + keys.CallbackURL)
+	}
+	return nil
+}
+
+// invokeKeygenRequest posts a key generation or a key mapping request and parses the response
+func invokeKeygenRequest(accessToken, endpoint, body, action string) (*utils.KeygenResponse, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePOSTRequest(endpoint, headers, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
+		keygenResponse := &utils.KeygenResponse{}
+		err = json.Unmarshal(resp.Body(), &keygenResponse)
+		return keygenResponse, err
+	}
+	return nil, handleAppKeysErrorResponse(resp, action)
+}
+
+// getOAuthKeysEndpoint returns the oauth-keys resource of an application
+func getOAuthKeysEndpoint(environment, appId string) string {
+	return utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId + "/oauth-keys"
+}
+
+// handleAppKeysErrorResponse logs an erroneous response and creates an error out of it
+func handleAppKeysErrorResponse(resp *resty.Response, action string) error {
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return fmt.Errorf("authorization failed while " + action)
+	}
+	return errors.New("Request didn't respond 200 OK for " + action + ". Status: " + resp.Status())
+}
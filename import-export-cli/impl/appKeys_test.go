@@ -0,0 +1,140 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// setupDevPortalEnv writes a main config with an API Manager environment named "dev" pointing to the server
+func setupDevPortalEnv(t *testing.T, server *httptest.Server) func() {
+	dir, err := ioutil.TempDir("", "devportal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainConfig := &utils.MainConfig{Environments: map[string]utils.EnvEndpoints{
+		"dev": {ApiManagerEndpoint: server.URL, TokenEndpoint: server.URL + "/oauth2/token"},
+	}}
+	configPath := utils.MainConfigFilePath
+	utils.MainConfigFilePath = filepath.Join(dir, utils.MainConfigFileName)
+	utils.WriteConfigFile(mainConfig, utils.MainConfigFilePath)
+	return func() {
+		utils.MainConfigFilePath = configPath
+		server.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestGetKeyManagerListFromEnv(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/am/devportal/v2/key-managers", r.URL.Path)
+		assert.Equal(t, "Bearer access-token", r.Header.Get(utils.HeaderAuthorization))
+		_, _ = w.Write([]byte(`{"count": 2, "list": [
+			{"name": "Resident Key Manager", "type": "default", "enabled": true,
+				"availableGrantTypes": ["password", "client_credentials"]},
+			{"name": "Keycloak", "type": "KeyCloak", "enabled": false, "availableGrantTypes": []}]}`))
+	}))
+	defer setupDevPortalEnv(t, server)()
+
+	keyManagers, err := GetKeyManagerListFromEnv("access-token", "dev")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keyManagers))
+	assert.Equal(t, "Resident Key Manager", keyManagers[0].Name)
+	assert.Equal(t, []string{"password", "client_credentials"}, keyManagers[0].AvailableGrantTypes)
+	assert.False(t, keyManagers[1].Enabled)
+}
+
+func TestGetKeyManagerListFromEnvUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer setupDevPortalEnv(t, server)()
+
+	_, err := GetKeyManagerListFromEnv("expired-token", "dev")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "authorization failed")
+}
+
+func TestGenerateAppKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/am/devportal/v2/applications/app-1/generate-keys", r.URL.Path)
+		assert.Equal(t, utils.HeaderValueApplicationJSON, r.Header.Get(utils.HeaderContentType))
+
+		request := &utils.KeygenRequest{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(request))
+		assert.Equal(t, utils.KeyTypeSandbox, request.KeyType)
+		assert.Equal(t, "Resident Key Manager", request.KeyManager)
+		assert.Equal(t, utils.DefaultGrantTypes, request.GrantTypesToBeSupported,
+			"Default grant types should be requested when grant types are not given")
+		assert.Equal(t, utils.DefaultTokenValidityPeriod, request.ValidityTime)
+
+		_, _ = w.Write([]byte(`{"keyMappingId": "mapping-1", "keyManager": "Resident Key Manager",
+			"consumerKey": "key", "consumerSecret": "secret", "keyType": "SANDBOX",
+			"supportedGrantTypes": ["refresh_token", "password", "client_credentials"]}`))
+	}))
+	defer setupDevPortalEnv(t, server)()
+
+	keys, err := GenerateAppKeys("access-token", "dev", "app-1", AppKeyOptions{
+		KeyType:    utils.KeyTypeSandbox,
+		KeyManager: "Resident Key Manager",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "mapping-1", keys.KeyMappingID)
+	assert.Equal(t, "key", keys.ConsumerKey)
+	assert.Equal(t, "secret", keys.ConsumerSecret)
+}
+
+func TestGenerateAppKeysConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer setupDevPortalEnv(t, server)()
+
+	_, err := GenerateAppKeys("access-token", "dev", "app-1", AppKeyOptions{KeyType: utils.KeyTypeProduction})
+	assert.NotNil(t, err, "Generating keys which already exist should fail")
+	assert.Contains(t, err.Error(), "409")
+}
+
+func TestGetAppKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/am/devportal/v2/applications/app-1/oauth-keys", r.URL.Path)
+		_, _ = w.Write([]byte(`{"count": 2, "list": [
+			{"keyMappingId": "mapping-1", "keyManager": "Resident Key Manager", "keyType": "PRODUCTION"},
+			{"keyMappingId": "mapping-2", "keyManager": "Keycloak", "keyType": "PRODUCTION"}]}`))
+	}))
+	defer setupDevPortalEnv(t, server)()
+
+	key, err := GetAppKey("access-token", "dev", "app-1", utils.KeyTypeProduction, "Keycloak")
+	assert.Nil(t, err)
+	assert.Equal(t, "mapping-2", key.KeyMappingID)
+
+	_, err = GetAppKey("access-token", "dev", "app-1", utils.KeyTypeSandbox, "")
+	assert.NotNil(t, err, "Keys of a key type that is not generated should not be found")
+}
@@ -0,0 +1,600 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	docIdHeader         = "ID"
+	docNameHeader       = "NAME"
+	docTypeHeader       = "TYPE"
+	docSourceTypeHeader = "SOURCE TYPE"
+	docVisibilityHeader = "VISIBILITY"
+
+	defaultDocTableFormat = "table {{.Id}}\t{{.Name}}\t{{.Type}}\t{{.SourceType}}\t{{.Visibility}}"
+)
+
+// DocsSyncResult holds the names of the documents changed when syncing the documents of a project
+type DocsSyncResult struct {
+	Added     []string
+	Updated   []string
+	Deleted   []string
+	Unchanged []string
+}
+
+// projectDocument is a document entry of the Docs/docs.yaml (or docs.json) file of an API project
+type projectDocument struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Summary       string `json:"summary"`
+	SourceType    string `json:"sourceType"`
+	SourceURL     string `json:"sourceUrl"`
+	OtherTypeName string `json:"otherTypeName"`
+	Visibility    string `json:"visibility"`
+	FilePath      string `json:"filePath"`
+}
+
+// document contains information about utils.Document
+type document struct {
+	id         string
+	name       string
+	docType    string
+	sourceType string
+	visibility string
+}
+
+// creates a new document definition from utils.Document
+func newDocumentDefinition(d utils.Document) *document {
+	return &document{d.DocumentID, d.Name, d.Type, d.SourceType, d.Visibility}
+}
+
+// Id of document
+func (d document) Id() string {
+	return d.id
+}
+
+// Name of document
+func (d document) Name() string {
+	return d.name
+}
+
+// Type of document
+func (d document) Type() string {
+	return d.docType
+}
+
+// SourceType of document
+func (d document) SourceType() string {
+	return d.sourceType
+}
+
+// Visibility of document
+func (d document) Visibility() string {
+	return d.visibility
+}
+
+// MarshalJSON marshals document using custom marshaller which uses methods instead of fields
+func (d *document) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(d)
+}
+
+// ValidateDocument validates the type, the source type and the visibility of a document
+// @param doc : Document to be validated
+// @return error
+func ValidateDocument(doc *utils.Document) error {
+	doc.Type = strings.ToUpper(doc.Type)
+	doc.SourceType = strings.ToUpper(doc.SourceType)
+	doc.Visibility = strings.ToUpper(doc.Visibility)
+	if doc.Name == "" {
+		return errors.New("name of the document is not specified")
+	}
+	if !containsString(utils.ValidDocTypes, doc.Type) {
+		return fmt.Errorf("invalid document type %s. Valid types: %v", doc.Type, utils.ValidDocTypes)
+	}
+	if !containsString(utils.ValidDocSourceTypes, doc.SourceType) {
+		return fmt.Errorf("invalid source type %s. Valid source types: %v", doc.SourceType,
+			utils.ValidDocSourceTypes)
+	}
+	if !containsString(utils.ValidDocVisibilities, doc.Visibility) {
+		return fmt.Errorf("invalid visibility %s. Valid visibilities: %v", doc.Visibility,
+			utils.ValidDocVisibilities)
+	}
+	if doc.SourceType == utils.DocSourceTypeURL && doc.SourceURL == "" {
+		return errors.New("source URL is required for the documents of source type " + utils.DocSourceTypeURL)
+	}
+	if doc.Type == "OTHER" && doc.OtherTypeName == "" {
+		return errors.New("other type name is required for the documents of type OTHER")
+	}
+	return nil
+}
+
+// GetAPIDocuments retrieves the documents of an API from the Publisher
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @return array of documents, error
+func GetAPIDocuments(accessToken, environment, apiId string) ([]utils.Document, error) {
+	docsEndpoint := getDocumentsEndpoint(environment, apiId)
+	utils.Logln(utils.LogPrefixInfo+"URL:", docsEndpoint)
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(map[string]string{"limit": "1000"}, docsEndpoint,
+		getBearerHeaders(accessToken))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+	}
+	docList := &utils.DocumentList{}
+	err = json.Unmarshal(resp.Body(), docList)
+	return docList.List, err
+}
+
+// GetAPIDocumentByName retrieves a document of an API using the name of the document
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @param docName : Name of the document
+// @return document, error
+func GetAPIDocumentByName(accessToken, environment, apiId, docName string) (*utils.Document, error) {
+	docs, err := GetAPIDocuments(accessToken, environment, apiId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range docs {
+		if docs[i].Name == docName {
+			return &docs[i], nil
+		}
+	}
+	return nil, errors.New("document " + docName + " is not available in the API")
+}
+
+// AddAPIDocument adds a document to an API
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @param doc : Document to be added
+// @return added document, error
+func AddAPIDocument(accessToken, environment, apiId string, doc *utils.Document) (*utils.Document, error) {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	headers := getBearerHeaders(accessToken)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePOSTRequest(getDocumentsEndpoint(environment, apiId), headers, string(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+	}
+	addedDoc := &utils.Document{}
+	err = json.Unmarshal(resp.Body(), addedDoc)
+	return addedDoc, err
+}
+
+// UpdateAPIDocument updates the metadata of a document of an API
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @param doc : Document to be updated including its ID
+// @return updated document, error
+func UpdateAPIDocument(accessToken, environment, apiId string, doc *utils.Document) (*utils.Document, error) {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	headers := getBearerHeaders(accessToken)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePutRequest(nil, getDocumentsEndpoint(environment, apiId)+"/"+doc.DocumentID, headers,
+		string(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+	}
+	updatedDoc := &utils.Document{}
+	err = json.Unmarshal(resp.Body(), updatedDoc)
+	return updatedDoc, err
+}
+
+// DeleteAPIDocument deletes a document of an API
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @param docId : ID of the document
+// @return error
+func DeleteAPIDocument(accessToken, environment, apiId, docId string) error {
+	resp, err := utils.InvokeDELETERequest(getDocumentsEndpoint(environment, apiId)+"/"+docId,
+		getBearerHeaders(accessToken))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+	}
+	return nil
+}
+
+// GetAPIDocumentContent retrieves the inline content or the file of a document
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @param docId : ID of the document
+// @return content, error
+func GetAPIDocumentContent(accessToken, environment, apiId, docId string) ([]byte, error) {
+	resp, err := utils.InvokeGETRequest(getDocumentsEndpoint(environment, apiId)+"/"+docId+"/content",
+		getBearerHeaders(accessToken))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+	}
+	return resp.Body(), nil
+}
+
+// SetAPIDocumentContent uploads the inline content of an INLINE or MARKDOWN document, or the file of a FILE document
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @param doc : Document to upload the content
+// @param content : Inline content of the document, or the path to the file for FILE documents
+// @return error
+func SetAPIDocumentContent(accessToken, environment, apiId string, doc *utils.Document, content string) error {
+	contentEndpoint := getDocumentsEndpoint(environment, apiId) + "/" + doc.DocumentID + "/content"
+	utils.Logln(utils.LogPrefixInfo+"URL:", contentEndpoint)
+	var err error
+	var statusCode int
+	var respBody []byte
+	if doc.SourceType == utils.DocSourceTypeFile {
+		resp, err := ExecuteNewFileUploadRequest(contentEndpoint, nil, "file", content, accessToken, true)
+		if err != nil {
+			return err
+		}
+		statusCode, respBody = resp.StatusCode(), resp.Body()
+	} else {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		if err = writer.WriteField("inlineContent", content); err != nil {
+			return err
+		}
+		if err = writer.Close(); err != nil {
+			return err
+		}
+		headers := getBearerHeaders(accessToken)
+		headers[utils.HeaderContentType] = writer.FormDataContentType()
+		resp, err := utils.InvokePOSTRequest(contentEndpoint, headers, body.Bytes())
+		if err != nil {
+			return err
+		}
+		statusCode, respBody = resp.StatusCode(), resp.Body()
+	}
+	if statusCode != http.StatusCreated && statusCode != http.StatusOK {
+		return errors.New(strconv.Itoa(statusCode) + ":<" + string(respBody) + ">")
+	}
+	return nil
+}
+
+// SyncAPIDocuments reconciles the documents in the Docs directory of an API project against the documents of the
+// API in the environment. Missing documents are added and the changed documents are updated. Documents which are
+// not in the project are deleted only if prune is true
+// @param accessToken : Access token to call the Publisher REST API
+// @param environment : Environment of the API
+// @param projectPath : Path to the API project directory or archive
+// @param prune : Whether to delete the documents which are not in the project
+// @return sync result, error
+func SyncAPIDocuments(accessToken, environment, projectPath string, prune bool) (*DocsSyncResult, error) {
+	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedApisDirName)
+	resolvedProjectPath, err := resolveImportFilePath(projectPath, exportDirectory)
+	if err != nil {
+		return nil, err
+	}
+	tmpPath, err := utils.GetTempCloneFromDirOrZip(resolvedProjectPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		utils.Logln(utils.LogPrefixInfo+"Deleting", tmpPath)
+		if err := os.RemoveAll(tmpPath); err != nil {
+			utils.Logln(utils.LogPrefixError + err.Error())
+		}
+	}()
+	if err = replaceEnvVariables(tmpPath); err != nil {
+		return nil, err
+	}
+
+	apiDefinition, _, err := GetAPIDefinition(tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	projectDocs, err := readProjectDocuments(tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	apiId, err := GetAPIId(accessToken, environment, apiDefinition.ID.APIName, apiDefinition.ID.Version,
+		apiDefinition.ID.ProviderName)
+	if err != nil {
+		return nil, err
+	}
+	liveDocs, err := GetAPIDocuments(accessToken, environment, apiId)
+	if err != nil {
+		return nil, err
+	}
+	liveDocsByName := make(map[string]utils.Document)
+	for _, liveDoc := range liveDocs {
+		liveDocsByName[liveDoc.Name] = liveDoc
+	}
+
+	result := &DocsSyncResult{}
+	projectDocNames := make(map[string]bool)
+	for _, projectDoc := range projectDocs {
+		projectDocNames[projectDoc.Name] = true
+		doc, content, err := resolveProjectDocument(tmpPath, projectDoc)
+		if err != nil {
+			return result, err
+		}
+		liveDoc, exists := liveDocsByName[doc.Name]
+		if !exists {
+			utils.Logln(utils.LogPrefixInfo + "Adding document " + doc.Name)
+			addedDoc, err := AddAPIDocument(accessToken, environment, apiId, doc)
+			if err != nil {
+				return result, err
+			}
+			if err = setProjectDocumentContent(accessToken, environment, apiId, addedDoc, content); err != nil {
+				return result, err
+			}
+			result.Added = append(result.Added, doc.Name)
+			continue
+		}
+
+		changed := false
+		doc.DocumentID = liveDoc.DocumentID
+		if isDocumentMetadataChanged(&liveDoc, doc) {
+			utils.Logln(utils.LogPrefixInfo + "Updating document " + doc.Name)
+			if _, err = UpdateAPIDocument(accessToken, environment, apiId, doc); err != nil {
+				return result, err
+			}
+			changed = true
+		}
+		if content != "" {
+			contentChanged, err := isDocumentContentChanged(accessToken, environment, apiId, doc, content)
+			if err != nil {
+				return result, err
+			}
+			if contentChanged {
+				utils.Logln(utils.LogPrefixInfo + "Updating the content of document " + doc.Name)
+				if err = setProjectDocumentContent(accessToken, environment, apiId, doc, content); err != nil {
+					return result, err
+				}
+				changed = true
+			}
+		}
+		if changed {
+			result.Updated = append(result.Updated, doc.Name)
+		} else {
+			result.Unchanged = append(result.Unchanged, doc.Name)
+		}
+	}
+
+	if prune {
+		for _, liveDoc := range liveDocs {
+			if projectDocNames[liveDoc.Name] {
+				continue
+			}
+			utils.Logln(utils.LogPrefixInfo + "Deleting document " + liveDoc.Name)
+			if err = DeleteAPIDocument(accessToken, environment, apiId, liveDoc.DocumentID); err != nil {
+				return result, err
+			}
+			result.Deleted = append(result.Deleted, liveDoc.Name)
+		}
+	}
+	return result, nil
+}
+
+// readProjectDocuments reads the document entries of the Docs/docs.yaml (or docs.json) file of an API project.
+// Returns an empty list if the project does not have documents
+func readProjectDocuments(projectPath string) ([]projectDocument, error) {
+	docsFile := filepath.Join(projectPath, "Docs", "docs")
+	if _, err := os.Stat(docsFile + ".yaml"); os.IsNotExist(err) {
+		if _, err := os.Stat(docsFile + ".json"); os.IsNotExist(err) {
+			return nil, nil
+		}
+	}
+	_, content, err := resolveYamlOrJSON(docsFile)
+	if err != nil {
+		return nil, err
+	}
+	var docs []projectDocument
+	err = json.Unmarshal(content, &docs)
+	return docs, err
+}
+
+// resolveProjectDocument converts a document entry of a project to a document of the Publisher REST API and
+// resolves its content. Content is the inline content for INLINE and MARKDOWN documents and the path to the file
+// for FILE documents
+func resolveProjectDocument(projectPath string, projectDoc projectDocument) (*utils.Document, string, error) {
+	doc := &utils.Document{
+		Name:          projectDoc.Name,
+		Type:          projectDoc.Type,
+		Summary:       projectDoc.Summary,
+		SourceType:    projectDoc.SourceType,
+		SourceURL:     projectDoc.SourceURL,
+		OtherTypeName: projectDoc.OtherTypeName,
+		Visibility:    projectDoc.Visibility,
+	}
+	if doc.Visibility == "" {
+		doc.Visibility = utils.DefaultDocVisibility
+	}
+	if err := ValidateDocument(doc); err != nil {
+		return nil, "", errors.New("invalid document " + projectDoc.Name + " in the project: " + err.Error())
+	}
+
+	switch doc.SourceType {
+	case utils.DocSourceTypeInline, utils.DocSourceTypeMarkdown:
+		content, err := ioutil.ReadFile(filepath.Join(projectPath, "Docs", "InlineContents", doc.Name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return doc, "", nil
+			}
+			return nil, "", err
+		}
+		return doc, string(content), nil
+	case utils.DocSourceTypeFile:
+		if projectDoc.FilePath == "" {
+			return nil, "", errors.New("file path of the document " + doc.Name + " is not specified")
+		}
+		filePath := filepath.Join(projectPath, "Docs", "FileContents", filepath.Base(projectDoc.FilePath))
+		if _, err := os.Stat(filePath); err != nil {
+			return nil, "", err
+		}
+		return doc, filePath, nil
+	}
+	return doc, "", nil
+}
+
+// setProjectDocumentContent uploads the content of a document of a project if it has a content
+func setProjectDocumentContent(accessToken, environment, apiId string, doc *utils.Document, content string) error {
+	if content == "" {
+		return nil
+	}
+	return SetAPIDocumentContent(accessToken, environment, apiId, doc, content)
+}
+
+// isDocumentMetadataChanged checks whether the metadata of a document in a project differ from the live document
+func isDocumentMetadataChanged(liveDoc, doc *utils.Document) bool {
+	return liveDoc.Type != doc.Type || liveDoc.Summary != doc.Summary || liveDoc.SourceType != doc.SourceType ||
+		liveDoc.SourceURL != doc.SourceURL || liveDoc.OtherTypeName != doc.OtherTypeName ||
+		liveDoc.Visibility != doc.Visibility
+}
+
+// isDocumentContentChanged checks whether the content of a document in a project differs from the live content
+func isDocumentContentChanged(accessToken, environment, apiId string, doc *utils.Document, content string) (bool,
+	error) {
+	liveContent, err := GetAPIDocumentContent(accessToken, environment, apiId, doc.DocumentID)
+	if err != nil {
+		return false, err
+	}
+	if doc.SourceType == utils.DocSourceTypeFile {
+		fileContent, err := ioutil.ReadFile(content)
+		if err != nil {
+			return false, err
+		}
+		return !bytes.Equal(liveContent, fileContent), nil
+	}
+	return string(liveContent) != content, nil
+}
+
+// PrintDocsSyncResult prints the documents changed when syncing the documents of a project
+func PrintDocsSyncResult(result *DocsSyncResult) {
+	printDocNames := func(action string, names []string) {
+		for _, name := range names {
+			fmt.Println(action + ": " + name)
+		}
+	}
+	printDocNames("Added", result.Added)
+	printDocNames("Updated", result.Updated)
+	printDocNames("Deleted", result.Deleted)
+	printDocNames("Unchanged", result.Unchanged)
+}
+
+// PrintDocsSyncSummary prints the number of documents changed by a successful sync
+func PrintDocsSyncSummary(result *DocsSyncResult) {
+	fmt.Printf("Documents synced successfully. Added: %d, Updated: %d, Deleted: %d, Unchanged: %d\n",
+		len(result.Added), len(result.Updated), len(result.Deleted), len(result.Unchanged))
+}
+
+// PrintDocuments prints the documents of an API using the given format
+func PrintDocuments(docs []utils.Document, format string) {
+	if format == "" {
+		format = defaultDocTableFormat
+	}
+	// create new document context with standard output
+	docContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection of documents
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, d := range docs {
+			if err := t.Execute(w, newDocumentDefinition(d)); err != nil {
+				return err
+			}
+			// write a new line after executing template
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	docTableHeaders := map[string]string{
+		"Id":         docIdHeader,
+		"Name":       docNameHeader,
+		"Type":       docTypeHeader,
+		"SourceType": docSourceTypeHeader,
+		"Visibility": docVisibilityHeader,
+	}
+
+	// execute context
+	if err := docContext.Write(renderer, docTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// getDocumentsEndpoint returns the endpoint of the documents of an API
+func getDocumentsEndpoint(environment, apiId string) string {
+	return utils.AppendSlashToString(utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)) + apiId +
+		"/documents"
+}
+
+// getBearerHeaders returns the headers with the bearer access token to call the REST APIs
+func getBearerHeaders(accessToken string) map[string]string {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	return headers
+}
+
+// containsString checks whether a slice contains a string
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestValidateDocument(t *testing.T) {
+	doc := &utils.Document{Name: "Guide", Type: "howto", SourceType: "inline", Visibility: "api_level"}
+	assert.Nil(t, ValidateDocument(doc))
+	assert.Equal(t, utils.DocSourceTypeInline, doc.SourceType)
+
+	doc = &utils.Document{Name: "Guide", Type: "GUIDE", SourceType: "INLINE", Visibility: "API_LEVEL"}
+	assert.NotNil(t, ValidateDocument(doc), "Invalid document types should not be accepted")
+
+	doc = &utils.Document{Name: "Forum", Type: "PUBLIC_FORUM", SourceType: "URL", Visibility: "API_LEVEL"}
+	assert.NotNil(t, ValidateDocument(doc), "URL documents without a source URL should not be accepted")
+}
+
+func TestReadProjectDocuments(t *testing.T) {
+	projectPath := filepath.Join("testdata", "PizzaShackAPIDocs")
+	projectDocs, err := readProjectDocuments(projectPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(projectDocs))
+
+	doc, content, err := resolveProjectDocument(projectPath, projectDocs[0])
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocSourceTypeMarkdown, doc.SourceType)
+	assert.Equal(t, "# PizzaShack API\nOrder pizzas using the API.\n", content)
+
+	doc, content, err = resolveProjectDocument(projectPath, projectDocs[1])
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DefaultDocVisibility, doc.Visibility)
+	assert.Equal(t, "", content)
+
+	_, content, err = resolveProjectDocument(projectPath, projectDocs[2])
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(projectPath, "Docs", "FileContents", "samples.txt"), content)
+
+	projectDocs, err = readProjectDocuments(filepath.Join("testdata", "swaggers"))
+	assert.Nil(t, err)
+	assert.Empty(t, projectDocs, "Projects without documents should not have any documents to sync")
+}
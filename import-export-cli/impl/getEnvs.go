@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"text/template"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
@@ -37,6 +38,7 @@ const (
 	envsApiManagerEndpoint         = "API MANAGER ENDPOINT"
 	envsApplicationEndpoint        = "DEVPORTAL ENDPOINT"
 	envsMiManagementEndpoint       = "MI MANAGEMENT ENDPOINT"
+	envsMgwAdapterEndpoint         = "MG ADAPTER ENDPOINT"
 )
 
 // endpoint contains information about endpoint of API Manager
@@ -49,9 +51,18 @@ type endpoints struct {
 	apiManagerEndpoint   string
 	applicationEndpoint  string
 	miManagementEndpoint string
+	mgwAdapterEndpoint   string
 }
 
 func newEndpointFromEnvEndpoints(name string, e utils.EnvEndpoints) *endpoints {
+	miManagementEndpoint := e.MiManagementEndpoint
+	if len(e.MiManagementNodes) > 0 {
+		var nodes []string
+		for _, node := range utils.GetMINodesOfEnv(name, utils.MainConfigFilePath) {
+			nodes = append(nodes, node+"="+e.MiManagementNodes[node])
+		}
+		miManagementEndpoint = strings.Join(nodes, ",")
+	}
 	return &endpoints{
 		name:                 name,
 		adminEndpoint:        e.AdminEndpoint,
@@ -60,7 +71,8 @@ func newEndpointFromEnvEndpoints(name string, e utils.EnvEndpoints) *endpoints {
 		publisherEndpoint:    e.PublisherEndpoint,
 		registrationEndpoint: e.RegistrationEndpoint,
 		tokenEndpoint:        e.TokenEndpoint,
-		miManagementEndpoint: e.MiManagementEndpoint,
+		miManagementEndpoint: miManagementEndpoint,
+		mgwAdapterEndpoint:   e.MgwAdapterEndpoint,
 	}
 }
 
@@ -104,6 +116,11 @@ func (e endpoints) MiManagementEndpoint() string {
 	return e.miManagementEndpoint
 }
 
+// MgwAdapterEndpoint
+func (e endpoints) MgwAdapterEndpoint() string {
+	return e.mgwAdapterEndpoint
+}
+
 // MarshalJSON returns marshaled methods
 func (e *endpoints) MarshalJSON() ([]byte, error) {
 	return formatter.MarshalJSON(e)
@@ -139,6 +156,7 @@ func PrintEnvs(envData map[string]utils.EnvEndpoints, format, defaulEnvsTableFor
 		"ApiManagerEndpoint":   envsApiManagerEndpoint,
 		"ApplicationEndpoint":  envsApplicationEndpoint,
 		"MiManagementEndpoint": envsMiManagementEndpoint,
+		"MgwAdapterEndpoint":   envsMgwAdapterEndpoint,
 	}
 
 	// execute context
@@ -0,0 +1,136 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	keyManagerNameHeader       = "NAME"
+	keyManagerTypeHeader       = "TYPE"
+	keyManagerEnabledHeader    = "ENABLED"
+	keyManagerGrantTypesHeader = "GRANT TYPES"
+
+	defaultKeyManagerTableFormat = "table {{.Name}}\t{{.Type}}\t{{.Enabled}}\t{{.GrantTypes}}"
+)
+
+// keyManager contains information about utils.KeyManager
+type keyManager struct {
+	name       string
+	kmType     string
+	enabled    bool
+	grantTypes string
+}
+
+// creates a new key manager definition from utils.KeyManager
+func newKeyManagerDefinition(k utils.KeyManager) *keyManager {
+	return &keyManager{k.Name, k.Type, k.Enabled, strings.Join(k.AvailableGrantTypes, ",")}
+}
+
+// Name of key manager
+func (k keyManager) Name() string {
+	return k.name
+}
+
+// Type of key manager
+func (k keyManager) Type() string {
+	return k.kmType
+}
+
+// Enabled status of key manager
+func (k keyManager) Enabled() bool {
+	return k.enabled
+}
+
+// GrantTypes available in key manager
+func (k keyManager) GrantTypes() string {
+	return k.grantTypes
+}
+
+// MarshalJSON marshals key manager using custom marshaller which uses methods instead of fields
+func (k *keyManager) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(k)
+}
+
+// GetKeyManagerListFromEnv retrieves the key managers available in the DevPortal of an environment
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment to get the list of key managers
+// @return array of key managers, error
+func GetKeyManagerListFromEnv(accessToken, environment string) ([]utils.KeyManager, error) {
+	keyManagersEndpoint := utils.GetDevPortalKeyManagersEndpointOfEnv(environment, utils.MainConfigFilePath)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	utils.Logln(utils.LogPrefixInfo+"URL:", keyManagersEndpoint)
+	resp, err := utils.InvokeGETRequest(keyManagersEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
+	if resp.StatusCode() == http.StatusOK {
+		keyManagerList := &utils.KeyManagerList{}
+		err = json.Unmarshal(resp.Body(), &keyManagerList)
+		return keyManagerList.List, err
+	}
+	return nil, handleAppKeysErrorResponse(resp, "retrieving the key managers")
+}
+
+// PrintKeyManagers prints the key managers using the given format
+func PrintKeyManagers(keyManagers []utils.KeyManager, format string) {
+	if format == "" {
+		format = defaultKeyManagerTableFormat
+	}
+	// create new key manager context with standard output
+	keyManagerContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection of key managers
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, k := range keyManagers {
+			if err := t.Execute(w, newKeyManagerDefinition(k)); err != nil {
+				return err
+			}
+			// write a new line after executing template
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	keyManagerTableHeaders := map[string]string{
+		"Name":       keyManagerNameHeader,
+		"Type":       keyManagerTypeHeader,
+		"Enabled":    keyManagerEnabledHeader,
+		"GrantTypes": keyManagerGrantTypesHeader,
+	}
+
+	// execute context
+	if err := keyManagerContext.Write(renderer, keyManagerTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
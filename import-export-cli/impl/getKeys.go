@@ -23,7 +23,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/renstrom/dedent"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
@@ -38,15 +41,74 @@ var apiVersion string
 var apiProvider string
 var keyGenEnv string
 var keyGenTokenEndpoint string
+var keyGenValidityPeriod int
+
+// descriptions of the applications created to generate keys
+const (
+	defaultCliAppDescription = "Default application for apictl testing purposes"
+	cliAppDescription        = "Application created by apictl"
+)
+
+// KeyGenOptions holds the optional parameters used when generating an access token for an API or API Product
+type KeyGenOptions struct {
+	// Name of the application to subscribe to the API or API Product. Defaults to utils.DefaultCliApp
+	AppName string
+	// Subscription tier to use. Defaults to the first tier available for the API or API Product
+	SubscriptionTier string
+	// Scopes to request. Defaults to all the scopes of the subscribed APIs and API Products
+	Scopes []string
+	// Validity period of the access token in seconds. Defaults to utils.DefaultTokenValidityPeriod
+	ValidityPeriod int
+	// Token type of the application (JWT or OAUTH). Defaults to the token type in the main config
+	TokenType string
+	// Output format of the token. Prints only the access token if empty
+	OutputFormat string
+}
+
+// KeyGenOutput is the JSON representation of a generated access token
+type KeyGenOutput struct {
+	AccessToken string   `json:"accessToken"`
+	TokenType   string   `json:"tokenType"`
+	Scopes      []string `json:"scopes"`
+	ExpiresIn   int      `json:"expiresIn"`
+	ExpiresAt   string   `json:"expiresAt"`
+}
 
 //Subscribe the given API or API Product to the default application and generate an access token
 func GetKeys(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string) {
+	GetKeysWithOptions(cred, envName, name, version, provider, tokenEndpoint, KeyGenOptions{})
+}
+
+//Subscribe the given API or API Product to the application given in the options and print an access token
+func GetKeysWithOptions(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string,
+	opts KeyGenOptions) {
+	token := GenerateAccessToken(cred, envName, name, version, provider, tokenEndpoint, opts)
+	printToken(token, opts.OutputFormat)
+}
+
+//Subscribe the given API or API Product to the application given in the options and generate an access token
+func GenerateAccessToken(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string,
+	opts KeyGenOptions) *utils.TokenResponse {
 	keyGenEnv = envName
 	apiName = name
 	apiVersion = version
 	apiProvider = provider
 	keyGenTokenEndpoint = tokenEndpoint
 
+	appName := opts.AppName
+	if appName == "" {
+		appName = utils.DefaultCliApp
+	}
+	keyGenValidityPeriod = opts.ValidityPeriod
+	if keyGenValidityPeriod <= 0 {
+		keyGenValidityPeriod = utils.DefaultTokenValidityPeriod
+	}
+	var err error
+	tokenType, err = resolveTokenType(opts.TokenType)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid token type", err)
+	}
+
 	//generating access token for the env based on the credentials
 	accessToken, err := credentials.GetOAuthAccessToken(cred, keyGenEnv)
 	if err != nil {
@@ -59,7 +121,10 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 	if tiers != nil && err == nil {
 		utils.Logln(utils.LogPrefixInfo+"Retrieved available subscription tiers of the API or API Product: ", tiers)
 		// Needs an available subscription tier when subscribing to the particular API or API Product using the application
-		subscriptionThrottlingTier = tiers[0]
+		subscriptionThrottlingTier, err = selectSubscriptionTier(tiers, opts.SubscriptionTier)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid subscription tier", err)
+		}
 	} else {
 		utils.HandleErrorAndExit("Internal error occurred", err)
 	}
@@ -70,15 +135,15 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 		utils.HandleErrorAndExit("Internal error occurred", err)
 	}
 	utils.Logln(utils.LogPrefixInfo+"Retrieved application throttling policy successfully: ", applicationThrottlingPolicy)
-	//search if the default cli application already exists
-	appId, err := searchApplication(utils.DefaultCliApp, accessToken)
+	//search if the application already exists
+	appId, err := searchApplication(appName, accessToken)
 	if err != nil {
 		utils.HandleErrorAndExit("Internal error occurred", err)
 	}
 	utils.Logln(utils.LogPrefixInfo + "Searched if application exists.")
 	//if the application exists
 	if appId != "" {
-		utils.Logln(utils.LogPrefixInfo + "CLI application already exists")
+		utils.Logln(utils.LogPrefixInfo + "Application " + appName + " already exists")
 		// Subscribe API or API Product to a given application
 		subId, err := subscribe(appId, accessToken)
 		// If subscription fails
@@ -90,53 +155,74 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 		//retrieve application specific details
 		appDetails, err := getApplicationDetails(appId, accessToken)
 		if appDetails != nil {
-			//Reading configuration to check if the application needs to be updated
-			configVars := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
-			tokenType = configVars.Config.TokenType
+			//Checking if the application needs to be updated with the requested token type
+			if !strings.EqualFold(appDetails.TokenType, tokenType) {
+				if opts.TokenType == "" {
+					// Token type was not requested explicitly, hence keep the token type of the existing application
+					utils.Logln(utils.LogPrefixInfo + "Using the token type " + appDetails.TokenType +
+						" of the existing application " + appName)
+					tokenType = appDetails.TokenType
+				} else {
+					err = updateApplicationTokenType(appDetails, accessToken)
+					if err != nil {
+						utils.HandleErrorAndExit("Error occurred while updating the token type of the application.", err)
+					}
+				}
+			}
+			scopes = filterRequestedScopes(scopes, opts.Scopes)
 
 			//retrieve keys of application to see if there are already generated keys
 			appKeys, keysErr := getApplicationKeys(appId, accessToken)
 			if keysErr != nil {
-				utils.HandleErrorAndExit("Error occurred while getting CLI application keys.", keysErr)
+				utils.HandleErrorAndExit("Error occurred while getting application keys.", keysErr)
 			}
 
 			//if keys have been already generated before, then update the consumer key and secret
 			if appKeys.Count != 0 {
 				//If the keys have not been generated and the application is updated
-				token, err := getNewToken(&appKeys.List[0], scopes)
+				appKey, err := getResidentProductionKey(appKeys.List)
+				if err != nil {
+					utils.HandleErrorAndExit("Error occurred while getting application keys.", err)
+				}
+				token, err := getNewToken(appKey, scopes)
 				//Assert token endpoint related fails and errors
 				if err != nil {
 					utils.HandleErrorAndExit("Error while generating token. ", err)
 				}
-
-				if accessToken != "" {
-					// Access Token generated successfully.
-					fmt.Println(token)
-				} else {
-					utils.HandleErrorAndExit("Error while generating token: ", err)
-				}
+				return token
 			} else {
 				//If the application is already created but the keys have not generated in the first time
 				keygenResponse, err := generateApplicationKeys(appId, accessToken)
 				if keygenResponse == nil && err != nil {
-					utils.HandleErrorAndExit("Error occurred while generating CLI application keys.", err)
+					utils.HandleErrorAndExit("Error occurred while generating application keys.", err)
 				}
-				// Access Token generated successfully.
-				fmt.Println(keygenResponse.Token.AccessToken)
+				appKey := &utils.ApplicationKey{}
+				appKey.ConsumerKey = keygenResponse.ConsumerKey
+				appKey.ConsumerSecret = keygenResponse.ConsumerSecret
+				token, err := getNewToken(appKey, scopes)
+				if err != nil {
+					utils.HandleErrorAndExit("Error while generating token: ", err)
+				}
+				return token
 			}
 		} else {
-			utils.HandleErrorAndExit("Error while retrieving the CLI application:", err)
+			utils.HandleErrorAndExit("Error while retrieving the application:", err)
 		}
 	} else {
-		//If the default cli appId does not exist in the environment
+		//If the application does not exist in the environment
 		//Create the application
-		createdAppId, appName, err := createApplication(accessToken, applicationThrottlingPolicy)
+		description := defaultCliAppDescription
+		if opts.AppName != "" {
+			description = cliAppDescription
+		}
+		createdAppId, createdAppName, err := createApplication(appName, description, accessToken,
+			applicationThrottlingPolicy)
 		appId = createdAppId
-		if createdAppId != "" || appName != "" {
-			utils.Logln(utils.LogPrefixInfo+"Created CLI application: ", appName)
+		if createdAppId != "" || createdAppName != "" {
+			utils.Logln(utils.LogPrefixInfo+"Created application: ", createdAppName)
 		} else {
 			//if error occurred while creating the application, then
-			utils.HandleErrorAndExit("Error while creating the CLI application:", err)
+			utils.HandleErrorAndExit("Error while creating the application:", err)
 		}
 		//Search the if the given API or API Product is present to subscribe
 		subId, err := subscribe(appId, accessToken)
@@ -149,22 +235,137 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 		if scopes == nil && err != nil {
 			utils.HandleErrorAndExit("Error while retrieving scopes ", err)
 		}
+		scopes = filterRequestedScopes(scopes, opts.Scopes)
 		//Generate the tokens
 		keygenResponse, err := generateApplicationKeys(appId, accessToken)
 		if err != nil {
-			utils.HandleErrorAndExit("Error while generating CLI application keys", err)
+			utils.HandleErrorAndExit("Error while generating application keys", err)
 		}
 		appKey := &utils.ApplicationKey{}
 		appKey.ConsumerKey = keygenResponse.ConsumerKey
 		appKey.ConsumerSecret = keygenResponse.ConsumerSecret
 		token, err := getNewToken(appKey, scopes)
-		if token != "" {
-			// Access Token generated successfully.
-			fmt.Println(token)
-		} else {
+		if token == nil || token.AccessToken == "" {
 			utils.HandleErrorAndExit("Error while generating token: ", err)
 		}
+		// Access Token generated successfully.
+		return token
 	}
+	return nil
+}
+
+// Resolve the token type of the application from the given value or the main config
+// @param requestedTokenType : Token type given by the user. JWT, OAUTH and OPAQUE are accepted
+// @return tokenType, error
+func resolveTokenType(requestedTokenType string) (string, error) {
+	if requestedTokenType == "" {
+		configVars := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
+		requestedTokenType = configVars.Config.TokenType
+	}
+	if requestedTokenType == "" {
+		return utils.DefaultTokenType, nil
+	}
+	switch strings.ToUpper(requestedTokenType) {
+	case utils.DefaultTokenType:
+		return utils.DefaultTokenType, nil
+	case utils.TokenTypeOAuth, utils.TokenTypeOpaque:
+		return utils.TokenTypeOAuth, nil
+	}
+	return "", errors.New("unsupported token type: " + requestedTokenType + ". Token type should be either " +
+		utils.DefaultTokenType + " or " + utils.TokenTypeOAuth)
+}
+
+// Select the subscription tier to subscribe to the API or API Product
+// @param tiers : Tiers available for the API or API Product
+// @param requestedTier : Tier given by the user. The first available tier is selected if empty
+// @return tier, error
+func selectSubscriptionTier(tiers []string, requestedTier string) (string, error) {
+	if len(tiers) == 0 {
+		return "", errors.New("no subscription tiers are available for the API or API Product")
+	}
+	if requestedTier == "" {
+		return tiers[0], nil
+	}
+	for _, tier := range tiers {
+		if tier == requestedTier {
+			return tier, nil
+		}
+	}
+	return "", errors.New("subscription tier " + requestedTier + " is not available. Available tiers: " +
+		strings.Join(tiers, ", "))
+}
+
+// Filter the subscribed scopes to keep only the requested scopes
+// @param subscribedScopes : Scopes of the APIs and API Products subscribed to the application
+// @param requestedScopes : Scopes requested by the user. All the subscribed scopes are kept if empty
+// @return scopes[]
+func filterRequestedScopes(subscribedScopes, requestedScopes []string) []string {
+	if len(requestedScopes) == 0 {
+		return subscribedScopes
+	}
+	var scopes []string
+	for _, requestedScope := range requestedScopes {
+		found := false
+		for _, subscribedScope := range subscribedScopes {
+			if subscribedScope == requestedScope {
+				found = true
+				break
+			}
+		}
+		if found {
+			scopes = append(scopes, requestedScope)
+		} else {
+			fmt.Fprintln(os.Stderr, "Scope "+requestedScope+" is not available in the subscribed APIs and API "+
+				"Products. It will not be requested.")
+		}
+	}
+	return scopes
+}
+
+// Update the token type of an existing application
+// @param appDetails : Details of the application
+// @param accessToken : Access token to call the devportal REST API
+// @return error
+func updateApplicationTokenType(appDetails *utils.AppDetails, accessToken string) error {
+	// Send back all the details of the application so that only the token type is changed
+	appUpdateReq := *appDetails
+	appUpdateReq.TokenType = tokenType
+	body, err := json.Marshal(appUpdateReq)
+	if err != nil {
+		return err
+	}
+	_, err = updateApplicationDetails(appDetails.ApplicationID, string(body), accessToken)
+	if err == nil {
+		utils.Logln(utils.LogPrefixInfo + "Updated the token type of the application to " + tokenType)
+	}
+	return err
+}
+
+// Print the generated access token
+// @param token : Token endpoint response
+// @param outputFormat : Prints the access token only if empty, or the token with its expiry if json
+func printToken(token *utils.TokenResponse, outputFormat string) {
+	if strings.EqualFold(outputFormat, utils.JSONOutputFormat) {
+		var scopes []string
+		if token.Scope != "" {
+			scopes = strings.Split(token.Scope, " ")
+		}
+		output := KeyGenOutput{
+			AccessToken: token.AccessToken,
+			TokenType:   tokenType,
+			Scopes:      scopes,
+			ExpiresIn:   int(token.ExpiresIn),
+			ExpiresAt:   time.Now().Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339),
+		}
+		content, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			utils.HandleErrorAndExit("Error while formatting the token", err)
+		}
+		fmt.Println(string(content))
+		return
+	}
+	// Access Token generated successfully.
+	fmt.Println(token.AccessToken)
 }
 
 // Retrieve an available throttling tiers of the API or API Product
@@ -346,6 +547,21 @@ func searchApiOrProduct(accessToken string) (string, error) {
 	}
 }
 
+// Search the ID of the API or API Product in the given environment
+// @param accessToken : Access token to call the REST API
+// @param envName : Environment to search the API or API Product
+// @param name : Name of the API or API Product
+// @param version : Version of the API or API Product
+// @param provider : Provider of the API or API Product
+// @return apiId, error
+func GetApiOrProductId(accessToken, envName, name, version, provider string) (string, error) {
+	keyGenEnv = envName
+	apiName = name
+	apiVersion = version
+	apiProvider = provider
+	return searchApiOrProduct(accessToken)
+}
+
 // Subscribe API or API Product to a given application
 // @param appId : Application ID to subscribe the API or API Product
 // @param accessToken : Token to call REST API
@@ -558,22 +774,38 @@ func updateApplicationDetails(appId string, body string, accessToken string) (*u
 	}
 }
 
-// Create application with a default name in a given environment
+// getResidentProductionKey returns the PRODUCTION key of the resident key manager among the keys of an application.
+// Keys listed without a key manager are of the resident key manager, which is the only key manager of older versions
+// @param keys : Keys of the application
+// @return application key, error if the application does not have the key
+func getResidentProductionKey(keys []utils.ApplicationKey) (*utils.ApplicationKey, error) {
+	for i, key := range keys {
+		if strings.EqualFold(key.KeyType, utils.KeyTypeProduction) &&
+			(key.KeyManager == "" || key.KeyManager == utils.DefaultKeyManager) {
+			return &keys[i], nil
+		}
+	}
+	return nil, errors.New("the application does not have " + utils.KeyTypeProduction + " keys of the " +
+		utils.DefaultKeyManager)
+}
+
+// Create application with the given name in a given environment
+// @param appName : Name of the application
+// @param description : Description of the application
 // @param accessToken : Access token to call the devportal REST API
 // @param throttlingPolicy : Throttling policy to create the application
 // @return client_id, client_secret, error
-func createApplication(accessToken string, throttlingPolicy string) (string, string, error) {
+func createApplication(appName, description, accessToken, throttlingPolicy string) (string, string, error) {
 
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
-	conf := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 	appUpdateReq := utils.AppCreateRequest{
-		Name:             utils.DefaultCliApp,
+		Name:             appName,
 		ThrottlingPolicy: throttlingPolicy,
-		Description:      "Default application for apictl testing purposes",
-		TokenType:        conf.Config.TokenType,
+		Description:      description,
+		TokenType:        tokenType,
 	}
 	body, err := json.Marshal(appUpdateReq)
 	if body == nil && err != nil {
@@ -602,15 +834,16 @@ func createApplication(accessToken string, throttlingPolicy string) (string, str
 // Calling token endpoint to get access token for the already created application
 // @param key : Details of the particular key
 // @param scopes[] : Scopes to generate the token
-// @return accessToken, error
-func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
+// @return token response, error
+func getNewToken(key *utils.ApplicationKey, scopes []string) (*utils.TokenResponse, error) {
 	var tokenEndpoint string
 	if keyGenTokenEndpoint == "" {
 		tokenEndpoint = utils.GetTokenEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	} else {
 		tokenEndpoint = keyGenTokenEndpoint
 	}
-	body := "grant_type=client_credentials&scope=" + strings.Join(scopes, " ")
+	body := "grant_type=client_credentials&scope=" + strings.Join(scopes, " ") +
+		"&validity_period=" + strconv.Itoa(keyGenValidityPeriod)
 
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " +
@@ -622,7 +855,7 @@ func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
 	resp, err := utils.InvokePOSTRequest(tokenEndpoint, headers, body)
 
 	if err != nil {
-		return "", errors.New("Token Endpoint is not valid. " + err.Error())
+		return nil, errors.New("Token Endpoint is not valid. " + err.Error())
 	}
 
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
@@ -630,18 +863,16 @@ func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
 		keygenResponse := &utils.TokenResponse{}
 		data := []byte(resp.Body())
 		err = json.Unmarshal(data, &keygenResponse)
-
-		accessToken := keygenResponse.AccessToken
-		return accessToken, err
+		return keygenResponse, err
 
 	} else {
 		utils.Logf("Error: %s\n", resp.Error())
 		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", fmt.Errorf("authorization failed while generating a token for the CLI application")
+			return nil, fmt.Errorf("authorization failed while generating a token for the CLI application")
 		}
-		return "", errors.New("Request didn't respond 200 OK for generating a new token. Status: " + resp.Status())
+		return nil, errors.New("Request didn't respond 200 OK for generating a new token. Status: " + resp.Status())
 	}
 
 }
@@ -682,7 +913,7 @@ func generateApplicationKeys(appId string, token string) (*utils.KeygenResponse,
 	generateKeyReq := utils.KeygenRequest{
 		KeyType:                 "PRODUCTION",
 		GrantTypesToBeSupported: []string{"refresh_token", "password", "client_credentials"},
-		ValidityTime:            utils.DefaultTokenValidityPeriod,
+		ValidityTime:            keyGenValidityPeriod,
 	}
 	body, err := json.Marshal(generateKeyReq)
 	if body == nil && err != nil {
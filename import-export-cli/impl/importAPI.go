@@ -249,13 +249,44 @@ func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPat
 	}
 	utils.Logln(utils.LogPrefixInfo+"API Location:", resolvedAPIFilePath)
 
-	utils.Logln(utils.LogPrefixInfo + "Creating workspace")
-	tmpPath, err := utils.GetTempCloneFromDirOrZip(resolvedAPIFilePath)
+	apiFilePath, cleanupFunc, err := CreateAPIProjectArchive(resolvedAPIFilePath, apiParamsPath, importEnvironment,
+		importAPISkipCleanup)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if importAPISkipCleanup {
+	//cleanup the temporary artifacts once consuming the zip file
+	defer cleanupFunc()
+
+	extraParams := map[string]string{}
+	publisherEndpoint += "/apis/import"
+	if importAPIUpdate {
+		publisherEndpoint += "?overwrite=" + strconv.FormatBool(true) + "&preserveProvider=" +
+			strconv.FormatBool(preserveProvider)
+	} else {
+		publisherEndpoint += "?preserveProvider=" + strconv.FormatBool(preserveProvider)
+	}
+	utils.Logln(utils.LogPrefixInfo + "Import URL: " + publisherEndpoint)
+
+	err = importAPI(publisherEndpoint, apiFilePath, accessOAuthToken, extraParams, true)
+	return err
+}
+
+// CreateAPIProjectArchive creates the archive of an API project to be imported. Environment variables in the project
+// are substituted and the configs of the environment in the params file are added to the archive
+// @param projectPath : Path to the API project directory or archive
+// @param apiParamsPath : Path to the params file or directory. Ignored if it is the default params file and not found
+// @param environment : Environment of the configs in the params file
+// @param skipCleanup : Leave the temporary files created
+// @return path to the archive, function to cleanup the temporary files once the archive is consumed, error
+func CreateAPIProjectArchive(projectPath, apiParamsPath, environment string, skipCleanup bool) (string, func(),
+	error) {
+	utils.Logln(utils.LogPrefixInfo + "Creating workspace")
+	tmpPath, err := utils.GetTempCloneFromDirOrZip(projectPath)
+	if err != nil {
+		return "", nil, err
+	}
+	cleanupWorkspace := func() {
+		if skipCleanup {
 			utils.Logln(utils.LogPrefixInfo+"Leaving", tmpPath)
 			return
 		}
@@ -264,51 +295,42 @@ func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPat
 		if err != nil {
 			utils.Logln(utils.LogPrefixError + err.Error())
 		}
-	}()
-	apiFilePath := tmpPath
+	}
 
 	utils.Logln(utils.LogPrefixInfo + "Substituting environment variables in API files...")
-	err = replaceEnvVariables(apiFilePath)
+	err = replaceEnvVariables(tmpPath)
 	if err != nil {
-		return err
+		cleanupWorkspace()
+		return "", nil, err
 	}
 
 	utils.Logln(utils.LogPrefixInfo + "Attempting to process environment configurations directory or file")
-	paramsPath, err := resolveAPIParamsPath(resolvedAPIFilePath, apiParamsPath)
+	paramsPath, err := resolveAPIParamsPath(projectPath, apiParamsPath)
 	if err != nil && apiParamsPath != utils.ParamFileAPI && apiParamsPath != "" {
-		return err
+		cleanupWorkspace()
+		return "", nil, err
 	}
 	if paramsPath != "" {
 		//Reading API params file and add configurations into temp artifact
-		err := handleCustomizedParameters(apiFilePath, paramsPath, importEnvironment)
+		err := handleCustomizedParameters(tmpPath, paramsPath, environment)
 		if err != nil {
-			return err
+			cleanupWorkspace()
+			return "", nil, err
 		}
 	}
 
-	// if apiFilePath contains a directory, zip it. Otherwise, leave it as it is.
-	apiFilePath, err, cleanupFunc := utils.CreateZipFileFromProject(apiFilePath, importAPISkipCleanup)
+	// if tmpPath contains a directory, zip it. Otherwise, leave it as it is.
+	archivePath, err, cleanupArchive := utils.CreateZipFileFromProject(tmpPath, skipCleanup)
 	if err != nil {
-		return err
-	}
-
-	//cleanup the temporary artifacts once consuming the zip file
-	if cleanupFunc != nil {
-		defer cleanupFunc()
+		cleanupWorkspace()
+		return "", nil, err
 	}
-
-	extraParams := map[string]string{}
-	publisherEndpoint += "/apis/import"
-	if importAPIUpdate {
-		publisherEndpoint += "?overwrite=" + strconv.FormatBool(true) + "&preserveProvider=" +
-			strconv.FormatBool(preserveProvider)
-	} else {
-		publisherEndpoint += "?preserveProvider=" + strconv.FormatBool(preserveProvider)
-	}
-	utils.Logln(utils.LogPrefixInfo + "Import URL: " + publisherEndpoint)
-
-	err = importAPI(publisherEndpoint, apiFilePath, accessOAuthToken, extraParams, true)
-	return err
+	return archivePath, func() {
+		if cleanupArchive != nil {
+			cleanupArchive()
+		}
+		cleanupWorkspace()
+	}, nil
 }
 
 // envParamsFileProcess function is used to process the environment parameters when they are provided as a file
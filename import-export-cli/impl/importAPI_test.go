@@ -65,12 +65,12 @@ func TestImportAPI1(t *testing.T) {
 
 func TestNewFileUploadRequest(t *testing.T) {
 	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPut {
-			t.Errorf("Expected '%s', got '%s' instead\n", http.MethodPut, r.Method)
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected '%s', got '%s' instead\n", http.MethodPost, r.Method)
 		}
 
-		if !strings.Contains(r.Header.Get(utils.HeaderAccept), utils.HeaderValueMultiPartFormData) {
-			t.Errorf("Expected '%s', got '%s' instead\n", utils.HeaderValueApplicationZip,
+		if !strings.Contains(r.Header.Get(utils.HeaderContentType), utils.HeaderValueMultiPartFormData) {
+			t.Errorf("Expected '%s', got '%s' instead\n", utils.HeaderValueMultiPartFormData,
 				r.Header.Get(utils.HeaderContentType))
 		}
 
@@ -89,7 +89,7 @@ func TestNewFileUploadRequest(t *testing.T) {
 	extraParams := map[string]string{}
 	filePath := filepath.FromSlash(utils.GetRelativeTestDataPathFromImpl() + "sampleapi.zip")
 	accessToken := "access-token"
-	_, err := newFileUploadRequest(server.URL, http.MethodPost, extraParams, "file", filePath, accessToken)
+	_, err := ExecuteNewFileUploadRequest(server.URL, extraParams, "file", filePath, accessToken, true)
 	if err != nil {
 		t.Errorf("Error: %s\n", err.Error())
 	}
@@ -155,14 +155,14 @@ func TestExtractAPIInfoWithMalformedJSON(t *testing.T) {
 }
 
 func TestGetAPIInfoCorrectDirectoryStructure(t *testing.T) {
-	api, _, err := GetAPIDefinition("testdata/PizzaShackAPI-1.0.0")
+	api, _, err := GetAPIDefinition(utils.GetRelativeTestDataPathFromImpl() + "PizzaShackAPI-1.0.0")
 	assert.Nil(t, err, "Should return nil error on reading correct directories")
 	assert.Equal(t, v2.ID{APIName: "PizzaShackAPI", Version: "1.0.0", ProviderName: "admin"}, api.ID,
 		"Should return correct values for ID info")
 }
 
 func TestGetAPIInfoMalformedDirectory(t *testing.T) {
-	api, _, err := GetAPIDefinition("testdata/PizzaShackAPI_1.0.0-malformed")
+	api, _, err := GetAPIDefinition(utils.GetRelativeTestDataPathFromImpl() + "PizzaShackAPI_1.0.0-malformed")
 	assert.Error(t, err, "Should return error on reading malformed directories")
 	assert.True(t, os.IsNotExist(err), "File not found error must be thrown")
 	assert.Nil(t, api,
@@ -63,12 +63,12 @@ func TestImportApplication1(t *testing.T) {
 
 func TestNewAppFileUploadRequest(t *testing.T) {
 	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPut {
-			t.Errorf("Expected '%s', got '%s' instead\n", http.MethodPut, r.Method)
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected '%s', got '%s' instead\n", http.MethodPost, r.Method)
 		}
 
-		if !strings.Contains(r.Header.Get(utils.HeaderAccept), utils.HeaderValueMultiPartFormData) {
-			t.Errorf("Expected '%s', got '%s' instead\n", utils.HeaderValueApplicationZip,
+		if !strings.Contains(r.Header.Get(utils.HeaderContentType), utils.HeaderValueMultiPartFormData) {
+			t.Errorf("Expected '%s', got '%s' instead\n", utils.HeaderValueMultiPartFormData,
 				r.Header.Get(utils.HeaderContentType))
 		}
 
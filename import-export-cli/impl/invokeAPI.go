@@ -0,0 +1,141 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// GetDevPortalAPI retrieves the details of an API from the DevPortal including its gateway URLs
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @return API details, error
+func GetDevPortalAPI(accessToken, environment, apiId string) (*utils.DevPortalAPI, error) {
+	apiEndpoint := utils.GetDevPortalApisEndpointOfEnv(environment, utils.MainConfigFilePath) + "/" + apiId
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(apiEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		api := &utils.DevPortalAPI{}
+		err = json.Unmarshal(resp.Body(), api)
+		return api, err
+	}
+	return nil, handleAppKeysErrorResponse(resp, "retrieving the details of the API: "+apiId)
+}
+
+// GetDevPortalAPIDefinition retrieves the OpenAPI definition of an API from the DevPortal
+// @param accessToken : Access token to call the DevPortal REST API
+// @param environment : Environment of the API
+// @param apiId : ID of the API
+// @return definition, error
+func GetDevPortalAPIDefinition(accessToken, environment, apiId string) ([]byte, error) {
+	definitionEndpoint := utils.GetDevPortalApisEndpointOfEnv(environment, utils.MainConfigFilePath) + "/" +
+		apiId + "/swagger"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(definitionEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		return resp.Body(), nil
+	}
+	return nil, handleAppKeysErrorResponse(resp, "retrieving the definition of the API: "+apiId)
+}
+
+// ResolveGatewayURL selects the gateway URL of an API
+// @param api : API details retrieved from the DevPortal
+// @param gatewayEnvironment : Name of the gateway environment. The first environment is used if empty
+// @param useHTTP : Whether to use the HTTP URL instead of the HTTPS URL
+// @return gateway URL, error
+func ResolveGatewayURL(api *utils.DevPortalAPI, gatewayEnvironment string, useHTTP bool) (string, error) {
+	var availableEnvironments []string
+	for _, endpointURL := range api.EndpointURLs {
+		availableEnvironments = append(availableEnvironments, endpointURL.EnvironmentName)
+		if gatewayEnvironment != "" && endpointURL.EnvironmentName != gatewayEnvironment {
+			continue
+		}
+		gatewayURL := endpointURL.URLs.HTTPS
+		if useHTTP || gatewayURL == "" {
+			gatewayURL = endpointURL.URLs.HTTP
+		}
+		if gatewayURL != "" {
+			return strings.TrimSuffix(gatewayURL, "/"), nil
+		}
+	}
+	if gatewayEnvironment != "" {
+		return "", errors.New("gateway environment " + gatewayEnvironment + " is not available for the API " +
+			api.Name + ". Available gateway environments: " + strings.Join(availableEnvironments, ", "))
+	}
+	return "", errors.New("the API " + api.Name + " is not deployed in any gateway environment")
+}
+
+// InvokeApiResource sends a request to a resource of an API through the gateway
+// @param gatewayURL : Gateway URL of the API including its context and version
+// @param request : Request to be sent
+// @param accessToken : Access token to invoke the API
+// @return response, error
+func InvokeApiResource(gatewayURL string, request *ApiRequest, accessToken string) (*resty.Response, error) {
+	headers := make(map[string]string)
+	for name, value := range request.Headers {
+		headers[name] = value
+	}
+	if accessToken != "" {
+		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	}
+	resourceURL := gatewayURL + request.Path
+	utils.Logln(utils.LogPrefixInfo+"Invoking:", request.Method, resourceURL)
+	return utils.InvokeRequest(request.Method, resourceURL, headers, request.QueryParams, request.Body)
+}
+
+// PrintApiInvocationResponse prints the status, the latency and the body of an API invocation response
+func PrintApiInvocationResponse(resp *resty.Response) {
+	fmt.Println("Status: " + resp.Status())
+	fmt.Println("Latency: " + resp.Time().String())
+	if utils.VerboseModeEnabled() {
+		for name, values := range resp.Header() {
+			utils.Logln(utils.LogPrefixInfo+name+":", strings.Join(values, ", "))
+		}
+	}
+	body := resp.Body()
+	if len(body) == 0 {
+		return
+	}
+	fmt.Println()
+	var prettyBody bytes.Buffer
+	if json.Indent(&prettyBody, body, "", "  ") == nil {
+		fmt.Println(prettyBody.String())
+	} else {
+		fmt.Println(string(body))
+	}
+}
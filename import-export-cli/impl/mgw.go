@@ -0,0 +1,280 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"text/template"
+
+	"github.com/go-resty/resty"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	mgwApiNameHeader     = "NAME"
+	mgwApiVersionHeader  = "VERSION"
+	mgwApiTypeHeader     = "TYPE"
+	mgwApiBasePathHeader = "BASE PATH"
+
+	defaultMgwApiTableFormat = "table {{.Name}}\t{{.Version}}\t{{.Type}}\t{{.BasePath}}"
+
+	mgwStatusEnvironmentHeader = "ENVIRONMENT"
+	mgwStatusEndpointHeader    = "ENDPOINT"
+	mgwStatusStatusHeader      = "STATUS"
+	mgwStatusMessageHeader     = "MESSAGE"
+
+	defaultMgwStatusTableFormat = "table {{.Environment}}\t{{.Endpoint}}\t{{.Status}}\t{{.Message}}"
+)
+
+// Statuses of a Microgateway adapter
+const (
+	MgwStatusUp   = "UP"
+	MgwStatusDown = "DOWN"
+)
+
+// MgwAPI represents an API deployed in the Microgateway
+type MgwAPI struct {
+	APIName  string `json:"apiName"`
+	Version  string `json:"version"`
+	APIType  string `json:"apiType"`
+	BasePath string `json:"basePath"`
+}
+
+// MgwAPIList represents the list of APIs returned from the Microgateway adapter
+type MgwAPIList struct {
+	Count int      `json:"count"`
+	List  []MgwAPI `json:"list"`
+}
+
+// MgwStatus represents the status of the Microgateway adapter of an environment
+type MgwStatus struct {
+	environment string
+	endpoint    string
+	status      string
+	message     string
+}
+
+// Environment of the Microgateway adapter
+func (s MgwStatus) Environment() string {
+	return s.environment
+}
+
+// Endpoint of the Microgateway adapter
+func (s MgwStatus) Endpoint() string {
+	return s.endpoint
+}
+
+// Status of the Microgateway adapter
+func (s MgwStatus) Status() string {
+	return s.status
+}
+
+// Message with the details of the status
+func (s MgwStatus) Message() string {
+	return s.message
+}
+
+// IsUp returns true if the Microgateway adapter is reachable and healthy
+func (s MgwStatus) IsUp() bool {
+	return s.status == MgwStatusUp
+}
+
+// MarshalJSON marshals the status using custom marshaller which uses methods instead of fields
+func (s *MgwStatus) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(s)
+}
+
+// mgwApi holds information about an API in the Microgateway for outputting
+type mgwApi struct {
+	name     string
+	version  string
+	apiType  string
+	basePath string
+}
+
+// creates a new mgwApi from MgwAPI
+func newMgwApiDefinitionFromMgwAPI(a MgwAPI) *mgwApi {
+	return &mgwApi{a.APIName, a.Version, a.APIType, a.BasePath}
+}
+
+// Name of the api
+func (a mgwApi) Name() string {
+	return a.name
+}
+
+// Version of the api
+func (a mgwApi) Version() string {
+	return a.version
+}
+
+// Type of the api
+func (a mgwApi) Type() string {
+	return a.apiType
+}
+
+// BasePath of the api
+func (a mgwApi) BasePath() string {
+	return a.basePath
+}
+
+// MarshalJSON marshals mgwApi using custom marshaller which uses methods instead of fields
+func (a *mgwApi) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(a)
+}
+
+// GetMgwAPIList returns the APIs deployed in the Microgateway of an environment
+// @param environment : Environment of the Microgateway adapter
+// @param basicAuthToken : Base64 encoded username:password of the adapter user
+// @param apiType : Type of the APIs to return. All the APIs are returned if empty
+// @param limit : Maximum number of APIs to return
+// @return list of APIs, error
+func GetMgwAPIList(environment, basicAuthToken, apiType, limit string) (*MgwAPIList, error) {
+	queryParams := make(map[string]string)
+	if apiType != "" {
+		queryParams["apiType"] = apiType
+	}
+	if limit != "" {
+		queryParams["limit"] = limit
+	}
+	resp, err := invokeMgwRequest(http.MethodGet, environment, utils.MgwAdapterApisResource, basicAuthToken,
+		queryParams)
+	if err != nil {
+		return nil, err
+	}
+	apiList := &MgwAPIList{}
+	if err = json.Unmarshal(resp.Body(), apiList); err != nil {
+		return nil, err
+	}
+	return apiList, nil
+}
+
+// UndeployMgwAPI undeploys an API from the Microgateway of an environment
+// @param environment : Environment of the Microgateway adapter
+// @param basicAuthToken : Base64 encoded username:password of the adapter user
+// @param name : Name of the API
+// @param version : Version of the API
+// @param vhost : Virtual host of the API. Ignored if empty
+// @return error
+func UndeployMgwAPI(environment, basicAuthToken, name, version, vhost string) error {
+	queryParams := map[string]string{"apiName": name, "version": version}
+	if vhost != "" {
+		queryParams["vhost"] = vhost
+	}
+	_, err := invokeMgwRequest(http.MethodDelete, environment, utils.MgwAdapterApisResource, basicAuthToken,
+		queryParams)
+	return err
+}
+
+// GetMgwStatus returns the status of the Microgateway adapter of an environment
+// @param environment : Environment of the Microgateway adapter
+// @param basicAuthToken : Base64 encoded username:password of the adapter user
+// @return status of the adapter
+func GetMgwStatus(environment, basicAuthToken string) *MgwStatus {
+	endpoint, _ := utils.GetMgwAdapterEndpointOfEnv(environment, utils.MainConfigFilePath)
+	status := &MgwStatus{environment: environment, endpoint: endpoint, status: MgwStatusDown}
+	resp, err := invokeMgwRequest(http.MethodGet, environment, utils.MgwAdapterHealthResource, basicAuthToken, nil)
+	if err != nil {
+		status.message = err.Error()
+		return status
+	}
+
+	// the adapter is considered up if it responds, unless it reports a different status in the response
+	status.status = MgwStatusUp
+	health := make(map[string]interface{})
+	if json.Unmarshal(resp.Body(), &health) == nil {
+		if reported, ok := health["status"].(string); ok && reported != "" {
+			status.status = reported
+		}
+	}
+	return status
+}
+
+// PrintMgwAPIs prints the APIs in the Microgateway using the given format
+func PrintMgwAPIs(w io.Writer, apis []MgwAPI, format string) {
+	if format == "" {
+		format = defaultMgwApiTableFormat
+	}
+	apiContext := formatter.NewContext(w, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, a := range apis {
+			if err := t.Execute(w, newMgwApiDefinitionFromMgwAPI(a)); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	apiTableHeaders := map[string]string{
+		"Name":     mgwApiNameHeader,
+		"Version":  mgwApiVersionHeader,
+		"Type":     mgwApiTypeHeader,
+		"BasePath": mgwApiBasePathHeader,
+	}
+	if err := apiContext.Write(renderer, apiTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// PrintMgwStatus prints the status of the Microgateway adapter using the given format
+func PrintMgwStatus(w io.Writer, status *MgwStatus, format string) {
+	if format == "" {
+		format = defaultMgwStatusTableFormat
+	}
+	statusContext := formatter.NewContext(w, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		if err := t.Execute(w, status); err != nil {
+			return err
+		}
+		_, _ = w.Write([]byte{'\n'})
+		return nil
+	}
+	statusTableHeaders := map[string]string{
+		"Environment": mgwStatusEnvironmentHeader,
+		"Endpoint":    mgwStatusEndpointHeader,
+		"Status":      mgwStatusStatusHeader,
+		"Message":     mgwStatusMessageHeader,
+	}
+	if err := statusContext.Write(renderer, statusTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// invokeMgwRequest invokes a resource of the Microgateway adapter REST API and returns an error if the adapter
+// does not respond with a 2xx status
+func invokeMgwRequest(method, environment, resource, basicAuthToken string, queryParams map[string]string) (
+	*resty.Response, error) {
+	url := utils.GetMgwAdapterEndpointOfResource(resource, environment, utils.MainConfigFilePath)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " + basicAuthToken
+	headers[utils.HeaderAccept] = utils.HeaderValueApplicationJSON
+
+	utils.Logln(utils.LogPrefixInfo + "connecting to " + url)
+	resp, err := utils.InvokeRequest(method, url, headers, queryParams, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%s: %s", resp.Status(), string(resp.Body()))
+	}
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// setupMgwEnv writes a main config with a Microgateway environment named "mgw" pointing to the server
+func setupMgwEnv(t *testing.T, server *httptest.Server) func() {
+	dir, err := ioutil.TempDir("", "mgw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainConfig := &utils.MainConfig{Environments: map[string]utils.EnvEndpoints{
+		"mgw": {MgwAdapterEndpoint: server.URL},
+	}}
+	configPath := utils.MainConfigFilePath
+	utils.MainConfigFilePath = filepath.Join(dir, utils.MainConfigFileName)
+	utils.WriteConfigFile(mainConfig, utils.MainConfigFilePath)
+	return func() {
+		utils.MainConfigFilePath = configPath
+		server.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestGetMgwAPIList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/mgw/adapter/0.1/apis", r.URL.Path)
+		assert.Equal(t, "Basic YWRtaW46YWRtaW4=", r.Header.Get(utils.HeaderAuthorization))
+		assert.Equal(t, "http", r.URL.Query().Get("apiType"))
+		_, _ = w.Write([]byte(`{"count": 1, "list": [{"apiName": "PetstoreAPI", "version": "1.0.0",
+			"apiType": "HTTP", "basePath": "/petstore"}]}`))
+	}))
+	defer setupMgwEnv(t, server)()
+
+	apiList, err := GetMgwAPIList("mgw", "YWRtaW46YWRtaW4=", "http", "25")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, apiList.Count)
+	assert.Equal(t, "PetstoreAPI", apiList.List[0].APIName)
+
+	var out bytes.Buffer
+	PrintMgwAPIs(&out, apiList.List, "{{ jsonPretty . }}")
+	assert.Contains(t, out.String(), `"BasePath": "/petstore"`)
+}
+
+func TestUndeployMgwAPI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		if r.URL.Query().Get("apiName") != "PetstoreAPI" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "1.0.0", r.URL.Query().Get("version"))
+	}))
+	defer setupMgwEnv(t, server)()
+
+	assert.Nil(t, UndeployMgwAPI("mgw", "YWRtaW46YWRtaW4=", "PetstoreAPI", "1.0.0", ""))
+	assert.NotNil(t, UndeployMgwAPI("mgw", "YWRtaW46YWRtaW4=", "SwaggerPetstore", "1.0.0", ""),
+		"undeploying an API not in the Microgateway should fail")
+}
+
+func TestGetMgwStatus(t *testing.T) {
+	healthy := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer setupMgwEnv(t, server)()
+
+	status := GetMgwStatus("mgw", "YWRtaW46YWRtaW4=")
+	assert.True(t, status.IsUp())
+	assert.Equal(t, server.URL, status.Endpoint())
+
+	healthy = false
+	status = GetMgwStatus("mgw", "YWRtaW46YWRtaW4=")
+	assert.False(t, status.IsUp())
+	assert.Contains(t, status.Message(), "503")
+}
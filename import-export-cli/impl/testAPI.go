@@ -0,0 +1,352 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ApiTestOptions holds the optional parameters used when testing an API
+type ApiTestOptions struct {
+	// Gateway environment to invoke the API. Defaults to the first gateway environment of the API
+	GatewayEnvironment string
+	// Whether to invoke the API using the HTTP gateway URL
+	UseHTTP bool
+	// Name of the application to subscribe to the API. Defaults to utils.DefaultCliApp
+	AppName string
+}
+
+// ApiTestCase is the result of invoking an operation of an API
+type ApiTestCase struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Duration   time.Duration
+	Failure    string
+}
+
+// Name of the test case
+func (t *ApiTestCase) Name() string {
+	return t.Method + " " + t.Path
+}
+
+// ApiTestSuite is the result of testing all the operations of an API
+type ApiTestSuite struct {
+	Name      string
+	TestCases []*ApiTestCase
+	Duration  time.Duration
+}
+
+// Failures returns the number of failed test cases of the suite
+func (s *ApiTestSuite) Failures() int {
+	failures := 0
+	for _, testCase := range s.TestCases {
+		if testCase.Failure != "" {
+			failures++
+		}
+	}
+	return failures
+}
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Time       string           `xml:"time,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite is a test suite of a JUnit XML report
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a test case of a JUnit XML report
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+// junitFailure is the failure of a test case of a JUnit XML report
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+// RunApiTests generates a request for each operation of the API in a project using its OpenAPI definition, invokes
+// them through the gateway and validates the responses against the response schemas in the definition
+// @param credential : Credentials of the environment
+// @param environment : Environment the API is deployed in
+// @param projectPath : Path to the API project directory or archive
+// @param opts : Optional parameters of the tests
+// @return test suite, error
+func RunApiTests(credential credentials.Credential, environment, projectPath string,
+	opts ApiTestOptions) (*ApiTestSuite, error) {
+	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedApisDirName)
+	resolvedProjectPath, err := resolveImportFilePath(projectPath, exportDirectory)
+	if err != nil {
+		return nil, err
+	}
+	tmpPath, err := utils.GetTempCloneFromDirOrZip(resolvedProjectPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		utils.Logln(utils.LogPrefixInfo+"Deleting", tmpPath)
+		if err := os.RemoveAll(tmpPath); err != nil {
+			utils.Logln(utils.LogPrefixError + err.Error())
+		}
+	}()
+	if err = replaceEnvVariables(tmpPath); err != nil {
+		return nil, err
+	}
+
+	apiDefinition, _, err := GetAPIDefinition(tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	_, definition, err := resolveYamlOrJSON(filepath.Join(tmpPath, "Meta-information", "swagger"))
+	if err != nil {
+		return nil, err
+	}
+	swagger, err := LoadOpenAPIDefinition(definition)
+	if err != nil {
+		return nil, err
+	}
+	name := apiDefinition.ID.APIName
+	version := apiDefinition.ID.Version
+	provider := apiDefinition.ID.ProviderName
+
+	// Calling the DCR endpoint to get the credentials of the env
+	credential.ClientId, credential.ClientSecret, err = CallDCREndpoint(credential, environment)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(credential, environment)
+	if err != nil {
+		return nil, err
+	}
+	apiId, err := GetApiOrProductId(accessToken, environment, name, version, provider)
+	if err != nil {
+		return nil, err
+	}
+	api, err := GetDevPortalAPI(accessToken, environment, apiId)
+	if err != nil {
+		return nil, err
+	}
+	gatewayURL, err := ResolveGatewayURL(api, opts.GatewayEnvironment, opts.UseHTTP)
+	if err != nil {
+		return nil, err
+	}
+	token := GenerateAccessToken(credential, environment, name, version, provider, "",
+		KeyGenOptions{AppName: opts.AppName})
+
+	suite := &ApiTestSuite{Name: name + "-" + version}
+	startTime := time.Now()
+	for _, path := range getSortedPaths(swagger) {
+		for _, method := range getSortedMethods(swagger.Paths[path]) {
+			testCase := runApiTestCase(swagger, method, path, gatewayURL, token.AccessToken)
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+	}
+	suite.Duration = time.Since(startTime)
+	return suite, nil
+}
+
+// runApiTestCase invokes an operation of an API using a generated request and validates the response
+func runApiTestCase(swagger *openapi3.Swagger, method, path, gatewayURL, accessToken string) *ApiTestCase {
+	testCase := &ApiTestCase{Method: method, Path: path}
+	request, err := BuildSampleApiRequest(swagger, method, path)
+	if err != nil {
+		testCase.Failure = "Error while building the request: " + err.Error()
+		return testCase
+	}
+	resp, err := InvokeApiResource(gatewayURL, request, accessToken)
+	if err != nil {
+		testCase.Failure = "Error while invoking the API: " + err.Error()
+		return testCase
+	}
+	testCase.StatusCode = resp.StatusCode()
+	testCase.Duration = resp.Time()
+	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
+
+	_, operation, _ := GetOperation(swagger, method, path)
+	if err = ValidateApiResponse(operation, resp.StatusCode(), resp.Header().Get(utils.HeaderContentType),
+		resp.Body()); err != nil {
+		testCase.Failure = err.Error()
+	}
+	return testCase
+}
+
+// ValidateApiResponse validates a response of an operation against the responses declared in the definition.
+// Server errors and status codes which are not declared are considered as failures
+// @param operation : Operation of the API definition
+// @param statusCode : Status code of the response
+// @param contentType : Content type of the response
+// @param body : Body of the response
+// @return error if the response is not valid
+func ValidateApiResponse(operation *openapi3.Operation, statusCode int, contentType string, body []byte) error {
+	if statusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("server error: %d %s", statusCode, http.StatusText(statusCode))
+	}
+	responseRef := operation.Responses.Get(statusCode)
+	if responseRef == nil {
+		responseRef = operation.Responses[strconv.Itoa(statusCode/100)+"XX"]
+	}
+	if responseRef == nil {
+		responseRef = operation.Responses.Default()
+	}
+	if responseRef == nil || responseRef.Value == nil {
+		return fmt.Errorf("status code %d is not declared in the API definition", statusCode)
+	}
+	if len(body) == 0 || len(responseRef.Value.Content) == 0 {
+		return nil
+	}
+
+	mediaTypeName, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.Contains(mediaTypeName, "json") {
+		// Only the JSON responses are validated against the schemas
+		return nil
+	}
+	mediaType := responseRef.Value.Content.Get(mediaTypeName)
+	if mediaType == nil {
+		mediaType = responseRef.Value.Content.Get(utils.HeaderValueApplicationJSON)
+	}
+	if mediaType == nil || mediaType.Schema == nil || mediaType.Schema.Value == nil {
+		return nil
+	}
+	var value interface{}
+	if err = json.Unmarshal(body, &value); err != nil {
+		return errors.New("response body is not a valid JSON: " + err.Error())
+	}
+	if err = mediaType.Schema.Value.VisitJSON(value); err != nil {
+		return errors.New("response body does not match the schema: " + err.Error())
+	}
+	return nil
+}
+
+// PrintApiTestSuite prints the results of the test cases of an API
+func PrintApiTestSuite(suite *ApiTestSuite) {
+	fmt.Println("Testing " + suite.Name + "...")
+	for _, testCase := range suite.TestCases {
+		result := "PASS"
+		if testCase.Failure != "" {
+			result = "FAIL"
+		}
+		fmt.Printf("%s: %s (%d, %s)\n", result, testCase.Name(), testCase.StatusCode, testCase.Duration)
+		if testCase.Failure != "" {
+			fmt.Println("\t" + testCase.Failure)
+		}
+	}
+	fmt.Printf("%d tests, %d failures (%s)\n", len(suite.TestCases), suite.Failures(), suite.Duration)
+}
+
+// WriteJUnitReport writes the results of the test suites to a file in JUnit XML format
+// @param suites : Test suites to be written
+// @param reportFile : Path of the report file
+// @return error
+func WriteJUnitReport(suites []*ApiTestSuite, reportFile string) error {
+	report := junitTestSuites{}
+	var totalDuration time.Duration
+	for _, suite := range suites {
+		junitSuite := junitTestSuite{
+			Name:      suite.Name,
+			Tests:     len(suite.TestCases),
+			Failures:  suite.Failures(),
+			Time:      formatJUnitDuration(suite.Duration),
+			Timestamp: time.Now().Format("2006-01-02T15:04:05"),
+		}
+		for _, testCase := range suite.TestCases {
+			junitCase := junitTestCase{
+				Name:      testCase.Name(),
+				ClassName: suite.Name,
+				Time:      formatJUnitDuration(testCase.Duration),
+			}
+			if testCase.Failure != "" {
+				junitCase.Failure = &junitFailure{Message: testCase.Failure, Content: testCase.Failure}
+			}
+			junitSuite.TestCases = append(junitSuite.TestCases, junitCase)
+		}
+		report.Tests += junitSuite.Tests
+		report.Failures += junitSuite.Failures
+		totalDuration += suite.Duration
+		report.TestSuites = append(report.TestSuites, junitSuite)
+	}
+	report.Time = formatJUnitDuration(totalDuration)
+
+	content, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	content = append([]byte(xml.Header), content...)
+	if err = ioutil.WriteFile(reportFile, content, 0644); err != nil {
+		return err
+	}
+	fmt.Println("Test report written to " + reportFile)
+	return nil
+}
+
+// formatJUnitDuration formats a duration in seconds as expected by JUnit reports
+func formatJUnitDuration(duration time.Duration) string {
+	return strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)
+}
+
+// getSortedPaths returns the paths of a definition in alphabetical order
+func getSortedPaths(swagger *openapi3.Swagger) []string {
+	var paths []string
+	for path := range swagger.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// getSortedMethods returns the methods of the operations of a path in alphabetical order
+func getSortedMethods(pathItem *openapi3.PathItem) []string {
+	var methods []string
+	for method := range pathItem.Operations() {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
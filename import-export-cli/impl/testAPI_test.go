@@ -0,0 +1,74 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateApiResponse(t *testing.T) {
+	content, err := ioutil.ReadFile("testdata/swaggers/petstore_openapi3.yaml")
+	assert.Nil(t, err)
+	swagger, err := LoadOpenAPIDefinition(content)
+	assert.Nil(t, err)
+	_, operation, err := GetOperation(swagger, "GET", "/pets/{petId}")
+	assert.Nil(t, err)
+
+	err = ValidateApiResponse(operation, 200, "application/json; charset=UTF-8", []byte(`{"id": 1, "name": "doggie"}`))
+	assert.Nil(t, err)
+	err = ValidateApiResponse(operation, 200, "application/json", []byte(`{"id": "1"}`))
+	assert.NotNil(t, err, "Response which does not match the schema should fail")
+	err = ValidateApiResponse(operation, 404, "", nil)
+	assert.Nil(t, err, "Declared status codes should pass")
+	err = ValidateApiResponse(operation, 401, "", nil)
+	assert.NotNil(t, err, "Status codes which are not declared should fail")
+	err = ValidateApiResponse(operation, 500, "", nil)
+	assert.NotNil(t, err, "Server errors should fail")
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	suite := &ApiTestSuite{
+		Name: "PetstoreAPI-1.0.0",
+		TestCases: []*ApiTestCase{
+			{Method: "GET", Path: "/pets", StatusCode: 200, Duration: time.Second},
+			{Method: "POST", Path: "/pets", StatusCode: 500, Failure: "server error: 500 Internal Server Error"},
+		},
+	}
+	assert.Equal(t, 1, suite.Failures())
+
+	reportDir, err := ioutil.TempDir("", "report")
+	assert.Nil(t, err)
+	defer os.RemoveAll(reportDir)
+	reportFile := filepath.Join(reportDir, "report.xml")
+	err = WriteJUnitReport([]*ApiTestSuite{suite}, reportFile)
+	assert.Nil(t, err)
+	content, err := ioutil.ReadFile(reportFile)
+	assert.Nil(t, err)
+	report := string(content)
+	assert.True(t, strings.Contains(report, `<testsuites tests="2" failures="1"`))
+	assert.True(t, strings.Contains(report, `<testcase name="GET /pets" classname="PetstoreAPI-1.0.0" time="1.000">`))
+	assert.True(t, strings.Contains(report, `<failure message="server error: 500 Internal Server Error">`))
+}
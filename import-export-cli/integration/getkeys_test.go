@@ -145,6 +145,31 @@ func TestGetKeysAdminTenantUser(t *testing.T) {
 	testutils.ValidateGetKeys(t, args)
 }
 
+func TestGetKeysAsJSONWithCustomAppAdminSuperTenantUser(t *testing.T) {
+	adminUser := superAdminUser
+	adminPassword := superAdminPassword
+
+	apiPublisher := publisher.UserName
+	apiPublisherPassword := publisher.Password
+
+	apiCreator := creator.UserName
+	apiCreatorPassword := creator.Password
+
+	dev := apimClients[0]
+
+	api := testutils.AddAPI(t, dev, apiCreator, apiCreatorPassword)
+
+	testutils.PublishAPI(dev, apiPublisher, apiPublisherPassword, api.ID)
+
+	args := &testutils.ApiGetKeyTestArgs{
+		CtlUser: testutils.Credentials{Username: adminUser, Password: adminPassword},
+		Api:     api,
+		Apim:    dev,
+	}
+
+	testutils.ValidateGetKeysAsJSON(t, args, "apictl-integration-app", 300)
+}
+
 /*
 TODO: Uncomment these when secondary user store automation is supported
 func TestGetKeysSecondaryUserStoreAdminSuperTenantUser(t *testing.T) {
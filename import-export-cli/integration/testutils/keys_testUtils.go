@@ -20,11 +20,14 @@ package testutils
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -33,6 +36,11 @@ func GetKeys(t *testing.T, provider string, name string, version string, env str
 	return base.Execute(t, "get", "keys", "-n", name, "-v", version, "-r", provider, "-e", env, "-k", "--verbose")
 }
 
+func GetKeysAsJSON(t *testing.T, provider, name, version, env, appName string, validityPeriod int) (string, error) {
+	return base.Execute(t, "get", "keys", "-n", name, "-v", version, "-r", provider, "-e", env, "--app", appName,
+		"--validity-period", strconv.Itoa(validityPeriod), "-o", "json", "-k", "--verbose")
+}
+
 func InvokeAPI(t *testing.T, url string, key string, expectedCode int) {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
@@ -158,3 +166,24 @@ func ValidateGetKeysWithoutCleanup(t *testing.T, args *ApiGetKeyTestArgs) {
 		invokeAPIProduct(t, getResourceURLForAPIProduct(args.Apim, args.ApiProduct), base.GetValueOfUniformResponse(result), 200)
 	}
 }
+
+func ValidateGetKeysAsJSON(t *testing.T, args *ApiGetKeyTestArgs, appName string, validityPeriod int) {
+	t.Helper()
+
+	base.SetupEnv(t, args.Apim.GetEnvName(), args.Apim.GetApimURL(), args.Apim.GetTokenURL())
+	base.Login(t, args.Apim.GetEnvName(), args.CtlUser.Username, args.CtlUser.Password)
+
+	result, err := GetKeysAsJSON(t, args.Api.Provider, args.Api.Name, args.Api.Version, args.Apim.GetEnvName(),
+		appName, validityPeriod)
+	assert.Nil(t, err, "Error while getting key")
+
+	token := &impl.KeyGenOutput{}
+	err = json.Unmarshal([]byte(base.GetValueOfUniformResponse(result)), token)
+	assert.Nil(t, err, "Error while parsing the JSON output of get keys")
+	assert.NotEmpty(t, token.AccessToken, "Access token was not returned")
+	assert.NotEmpty(t, token.ExpiresAt, "Expiry time was not returned")
+	assert.LessOrEqual(t, token.ExpiresIn, validityPeriod, "Validity period of the token was not honored")
+
+	InvokeAPI(t, GetResourceURL(args.Apim, args.Api), token.AccessToken, 200)
+	UnsubscribeAPI(args.Apim, args.CtlUser.Username, args.CtlUser.Password, args.Api.ID)
+}
@@ -0,0 +1,138 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Settings of the artifacts that can be enabled or disabled
+const (
+	ArtifactSettingTracing    = "trace"
+	ArtifactSettingStatistics = "statistics"
+)
+
+// artifactResources maps the artifact types of which tracing and statistics can be toggled to the management
+// API resources
+var artifactResources = map[string]string{
+	"api":              utils.MiManagementAPIResource,
+	"proxy-service":    utils.MiManagementProxyServiceResource,
+	"sequence":         utils.MiManagementSequenceResource,
+	"endpoint":         utils.MiManagementEndpointResource,
+	"inbound-endpoint": utils.MiManagementInboundEndpointResource,
+}
+
+// GetToggleableArtifactTypes returns the artifact types of which tracing and statistics can be toggled
+func GetToggleableArtifactTypes() []string {
+	var artifactTypes []string
+	for artifactType := range artifactResources {
+		artifactTypes = append(artifactTypes, artifactType)
+	}
+	sort.Strings(artifactTypes)
+	return artifactTypes
+}
+
+// UpdateArtifactSetting enables or disables tracing or statistics of an artifact deployed in the micro integrator
+// in a given environment
+// @param env : Environment of the micro integrator
+// @param artifactType : Type of the artifact. One of GetToggleableArtifactTypes()
+// @param artifactName : Name of the artifact
+// @param setting : ArtifactSettingTracing or ArtifactSettingStatistics
+// @param enable : Enable the setting if true, disable otherwise
+// @return response message, error
+func UpdateArtifactSetting(env, artifactType, artifactName, setting string, enable bool) (string, error) {
+	resource, found := artifactResources[artifactType]
+	if !found {
+		return "", fmt.Errorf("tracing and statistics can not be updated for %s", artifactType)
+	}
+	state := "disable"
+	if enable {
+		state = "enable"
+	}
+	body := map[string]string{
+		"name":  artifactName,
+		setting: state,
+	}
+	url := utils.GetMIManagementEndpointOfResource(resource, env, utils.MainConfigFilePath)
+	resp, err := invokePOSTRequestWithRetry(env, url, body)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
+
+// GetArtifactNamesMatchingPattern returns the names of the artifacts of a type deployed in the micro integrator in a
+// given environment matching a pattern
+func GetArtifactNamesMatchingPattern(env, artifactType string, pattern *regexp.Regexp) ([]string, error) {
+	var names []string
+	switch artifactType {
+	case "api":
+		list, err := GetIntegrationAPIList(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifact := range list.Apis {
+			names = append(names, artifact.Name)
+		}
+	case "proxy-service":
+		list, err := GetProxyServiceList(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifact := range list.Proxies {
+			names = append(names, artifact.Name)
+		}
+	case "sequence":
+		list, err := GetSequenceList(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifact := range list.Sequences {
+			names = append(names, artifact.Name)
+		}
+	case "endpoint":
+		list, err := GetEndpointList(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifact := range list.Endpoints {
+			names = append(names, artifact.Name)
+		}
+	case "inbound-endpoint":
+		list, err := GetInboundEndpointList(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifact := range list.InboundEndpoints {
+			names = append(names, artifact.Name)
+		}
+	default:
+		return nil, fmt.Errorf("tracing and statistics can not be updated for %s", artifactType)
+	}
+
+	var matched []string
+	for _, name := range names {
+		if pattern.MatchString(name) {
+			matched = append(matched, name)
+		}
+	}
+	sort.Strings(matched)
+	return matched, nil
+}
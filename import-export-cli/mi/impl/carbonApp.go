@@ -0,0 +1,244 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// CarbonAppFileExtension is the extension of carbon application archives
+const CarbonAppFileExtension = ".car"
+
+// DeployCarbonApp uploads a carbon application archive to the micro integrator in a given environment
+func DeployCarbonApp(env, filePath string) (string, error) {
+	if !strings.HasSuffix(filePath, CarbonAppFileExtension) {
+		return "", errors.New(filePath + " is not a carbon application archive")
+	}
+	if info, err := os.Stat(filePath); err != nil {
+		return "", err
+	} else if info.IsDir() {
+		return "", errors.New(filePath + " is a directory")
+	}
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementCarbonAppResource, env, utils.MainConfigFilePath)
+	resp, err := invokeFileUploadRequestWithRetry(env, url, "file", filePath)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
+
+// UndeployCarbonApp removes a carbon application from the micro integrator in a given environment
+func UndeployCarbonApp(env, appName string) (string, error) {
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementCarbonAppResource, env, utils.MainConfigFilePath) +
+		"/" + neturl.PathEscape(appName)
+	resp, err := invokeDELETERequestWithRetry(url, env)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
+
+// GetCarbonAppFiles returns the carbon application archive at the given path, or the archives in it sorted by name
+// if the path is a directory
+func GetCarbonAppFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var carbonApps []string
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), CarbonAppFileExtension) {
+			carbonApps = append(carbonApps, filepath.Join(path, file.Name()))
+		}
+	}
+	if len(carbonApps) == 0 {
+		return nil, errors.New("no carbon application archives found in " + path)
+	}
+	sort.Strings(carbonApps)
+	return carbonApps, nil
+}
+
+// GetCarbonAppNameAndVersion returns the name and the version of a carbon application from the name of its archive.
+// Archives are named as [name]_[version].car. The version is empty if it is not in the file name
+func GetCarbonAppNameAndVersion(filePath string) (string, string) {
+	name := strings.TrimSuffix(filepath.Base(filePath), CarbonAppFileExtension)
+	if i := strings.LastIndex(name, "_"); i > 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// carbonAppDeployedLog is logged by the micro integrator once a carbon application is deployed.
+// Eg: Successfully Deployed Carbon Application : HelloWorld_1.0.0{super-tenant}
+const carbonAppDeployedLog = "Successfully Deployed Carbon Application : "
+
+// CarbonAppState is the state of a carbon application in a micro integrator, recorded before deploying it to detect
+// when the deployed archive replaces an already deployed carbon application
+type CarbonAppState struct {
+	// Deployed is true if the carbon application is listed in the composite apps
+	Deployed bool
+	// Artifacts of the carbon application in the format [type]/[name], sorted
+	Artifacts []string
+	// deploymentLog finds the redeployment of the carbon application in the log file, if it is already deployed
+	deploymentLog *carbonAppDeploymentLog
+}
+
+// carbonAppDeploymentLog finds the entries logged by the micro integrator for the deployment of a carbon application
+type carbonAppDeploymentLog struct {
+	tailer *LogTailer
+	logged bytes.Buffer
+}
+
+// GetCarbonAppState returns the state of a carbon application with the given name and version in the micro
+// integrator in a given environment. Any version is matched if the version is empty. A redeployment of the same
+// carbon application may not change what is listed, hence it is detected from the log file of the micro integrator
+// and an error is returned if the carbon application is deployed and the log file can not be read
+func GetCarbonAppState(env, appName, version string) (*CarbonAppState, error) {
+	state, err := getCarbonAppState(env, appName, version)
+	if err != nil || !state.Deployed {
+		return state, err
+	}
+	state.deploymentLog, err = newCarbonAppDeploymentLog(env, appName, version)
+	if err != nil {
+		return nil, fmt.Errorf("%s is already deployed in %s and its redeployment can not be detected without "+
+			"reading the log file %s: %v", appName, env, DefaultLogFileName, err)
+	}
+	return state, nil
+}
+
+// getCarbonAppState returns the state of a carbon application as listed in the composite apps
+func getCarbonAppState(env, appName, version string) (*CarbonAppState, error) {
+	appList, err := GetCompositeAppList(env)
+	if err != nil {
+		return nil, err
+	}
+	state := &CarbonAppState{}
+	for _, app := range appList.CompositeApps {
+		if app.Name != appName || (version != "" && app.Version != version) {
+			continue
+		}
+		compositeApp, err := getCompositeAppVersion(env, appName, app.Version)
+		if err != nil {
+			return nil, err
+		}
+		state.Deployed = true
+		for _, artifact := range compositeApp.Artifacts {
+			state.Artifacts = append(state.Artifacts, artifact.Type+"/"+artifact.Name)
+		}
+		sort.Strings(state.Artifacts)
+		break
+	}
+	return state, nil
+}
+
+// getCompositeAppVersion returns a version of a composite app. The composite app is looked up by the name with the
+// version first, since only the first version of a composite app deployed with several versions is found by its name
+func getCompositeAppVersion(env, appName, version string) (*artifactutils.CompositeApp, error) {
+	if version != "" {
+		compositeApp, err := GetCompositeApp(env, appName+"_"+version)
+		if err == nil && compositeApp.Version == version {
+			return compositeApp, nil
+		}
+	}
+	compositeApp, err := GetCompositeApp(env, appName)
+	if err != nil {
+		return nil, err
+	}
+	if version != "" && compositeApp.Version != version {
+		return nil, fmt.Errorf("version %s of %s is not found. Found version %s", version, appName,
+			compositeApp.Version)
+	}
+	return compositeApp, nil
+}
+
+// newCarbonAppDeploymentLog tails the log file of the micro integrator for the deployment of a carbon application,
+// skipping the entries already logged
+func newCarbonAppDeploymentLog(env, appName, version string) (*carbonAppDeploymentLog, error) {
+	filter := &LogFilter{Pattern: carbonAppDeployedLogPattern(appName, version)}
+	deploymentLog := &carbonAppDeploymentLog{}
+	deploymentLog.tailer = NewLogTailer(env, DefaultLogFileName, filter, ioutil.Discard)
+	if err := deploymentLog.tailer.Poll(); err != nil {
+		return nil, err
+	}
+	deploymentLog.tailer.out = &deploymentLog.logged
+	return deploymentLog, nil
+}
+
+// carbonAppDeployedLogPattern matches the entry logged once a carbon application is deployed. Any version is matched
+// if the version is empty
+func carbonAppDeployedLogPattern(appName, version string) *regexp.Regexp {
+	appNameWithVersion := regexp.QuoteMeta(appName) + `(_[^{\s]+)?`
+	if version != "" {
+		appNameWithVersion = regexp.QuoteMeta(appName + "_" + version)
+	}
+	return regexp.MustCompile(regexp.QuoteMeta(carbonAppDeployedLog) + appNameWithVersion + `(\{|\s*$)`)
+}
+
+// isDeployed returns true if the deployment of the carbon application is logged since the log file was last read
+func (l *carbonAppDeploymentLog) isDeployed() (bool, error) {
+	if err := l.tailer.Poll(); err != nil {
+		return false, err
+	}
+	return l.logged.Len() > 0, nil
+}
+
+// WaitForCarbonAppDeployment polls the micro integrator in a given environment until the carbon application is
+// deployed, or returns an error after the timeout. A carbon application not deployed before is deployed once it is
+// listed with its artifacts in the composite apps. A carbon application already deployed is redeployed once its
+// deployment is logged, since the previous deployment may be listed until the new archive is deployed
+// @param previous : State of the carbon application before it was deployed, see GetCarbonAppState
+func WaitForCarbonAppDeployment(env, appName, version string, previous *CarbonAppState,
+	timeout, interval time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if previous.deploymentLog != nil {
+			deployed, err := previous.deploymentLog.isDeployed()
+			if err != nil {
+				utils.Logln(utils.LogPrefixWarning+"Reading the log file of "+env, err)
+			} else if deployed {
+				return nil
+			}
+		} else {
+			state, err := getCarbonAppState(env, appName, version)
+			if err != nil {
+				utils.Logln(utils.LogPrefixWarning+"Getting the composite apps of "+env, err)
+			} else if state.Deployed && len(state.Artifacts) > 0 {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for " + appName + " to be deployed in " + env)
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCarbonAppNameAndVersion(t *testing.T) {
+	name, version := GetCarbonAppNameAndVersion("capps/HelloWorld_1.0.0.car")
+	assert.Equal(t, "HelloWorld", name)
+	assert.Equal(t, "1.0.0", version)
+
+	name, version = GetCarbonAppNameAndVersion("HelloWorld.car")
+	assert.Equal(t, "HelloWorld", name)
+	assert.Equal(t, "", version)
+}
+
+func TestCarbonAppDeployedLogPattern(t *testing.T) {
+	entry := "[2020-10-13 10:41:28,321]  INFO {CAppDeploymentManager} - Successfully Deployed Carbon Application : "
+
+	pattern := carbonAppDeployedLogPattern("HelloWorld", "1.0.0")
+	assert.True(t, pattern.MatchString(entry+"HelloWorld_1.0.0{super-tenant}"))
+	assert.True(t, pattern.MatchString(entry+"HelloWorld_1.0.0"))
+	assert.False(t, pattern.MatchString(entry+"HelloWorld_1.0.1{super-tenant}"))
+	assert.False(t, pattern.MatchString(entry+"HelloWorld_1.0.0.1{super-tenant}"))
+	assert.False(t, pattern.MatchString(entry+"HelloWorldProxy_1.0.0{super-tenant}"))
+
+	pattern = carbonAppDeployedLogPattern("HelloWorld", "")
+	assert.True(t, pattern.MatchString(entry+"HelloWorld_2.0.0{super-tenant}"))
+	assert.True(t, pattern.MatchString(entry+"HelloWorld{super-tenant}"))
+	assert.False(t, pattern.MatchString(entry+"HelloWorldProxy_1.0.0{super-tenant}"))
+}
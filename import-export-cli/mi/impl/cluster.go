@@ -0,0 +1,172 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	// MIClusterAnnotation is the annotation of a command which decides how it is run on a Micro Integrator cluster
+	MIClusterAnnotation = "mi-cluster"
+	// MIClusterSkip the command is not run on each node of a cluster
+	MIClusterSkip = "skip"
+	// MIClusterManual the command runs itself on each node of a cluster using RunOnMINodes
+	MIClusterManual = "manual"
+)
+
+// MINodeResult is the result of running a command on a node of a Micro Integrator cluster
+type MINodeResult struct {
+	Node   string
+	Output string
+	Err    error
+}
+
+// IsMIClusterEnv returns true if the environment has Micro Integrator nodes and is not a node itself
+func IsMIClusterEnv(env string) bool {
+	if _, node := utils.SplitMINodeEnvName(env); node != "" {
+		return false
+	}
+	return len(utils.GetMINodesOfEnv(env, utils.MainConfigFilePath)) > 0
+}
+
+// RunOnMINodes runs the command with the given arguments on each node of a Micro Integrator cluster in parallel.
+// The command is executed again for each node with the environment flag set to the node and the given input as stdin.
+// The credential store is locked while a node process saves its access token, hence the processes do not overwrite
+// the tokens saved by each other
+func RunOnMINodes(env string, args []string, input []byte) []MINodeResult {
+	nodes := utils.GetMINodesOfEnv(env, utils.MainConfigFilePath)
+	results := make([]MINodeResult, len(nodes))
+
+	failedNodes, err := credentials.EnsureMINodeCredentials(env)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials of "+env, err)
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		utils.HandleErrorAndExit("Unable to find the executable to run on the nodes of "+env, err)
+	}
+
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		results[i].Node = node
+		if failedNodes[node] != nil {
+			results[i].Err = errors.New("login failed: " + failedNodes[node].Error())
+			continue
+		}
+		wg.Add(1)
+		go func(result *MINodeResult) {
+			defer wg.Done()
+			nodeArgs := setMINodeEnvironment(args, utils.GetMINodeEnvName(env, result.Node))
+			utils.Logln(utils.LogPrefixInfo+"Running on node "+result.Node+":", strings.Join(nodeArgs, " "))
+			nodeCmd := exec.Command(executable, nodeArgs...)
+			if input != nil {
+				nodeCmd.Stdin = bytes.NewReader(input)
+			}
+			output, err := nodeCmd.CombinedOutput()
+			result.Output = string(output)
+			result.Err = err
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+// setMINodeEnvironment returns the arguments of a command with the environment flag set to a node. The environment
+// flag in the arguments, given as -e env, -eenv, -e=env, --environment env or --environment=env, is removed
+func setMINodeEnvironment(args []string, nodeEnv string) []string {
+	var nodeArgs []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			// the arguments after the terminator are not flags
+			nodeArgs = append(nodeArgs, "--environment", nodeEnv)
+			return append(nodeArgs, args[i:]...)
+		case arg == "-e" || arg == "--environment":
+			i++
+		case strings.HasPrefix(arg, "--environment="):
+		case strings.HasPrefix(arg, "-e") && !strings.HasPrefix(arg, "--"):
+		default:
+			nodeArgs = append(nodeArgs, arg)
+		}
+	}
+	return append(nodeArgs, "--environment", nodeEnv)
+}
+
+// PrintMINodeResults prints the outputs of the nodes grouping the nodes with the same output together,
+// followed by a summary of the failed nodes. The number of failed nodes is returned
+func PrintMINodeResults(w io.Writer, env string, results []MINodeResult) int {
+	var groups [][]MINodeResult
+	for _, result := range results {
+		found := false
+		for i, group := range groups {
+			if group[0].Output == result.Output && errorString(group[0].Err) == errorString(result.Err) {
+				groups[i] = append(group, result)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, []MINodeResult{result})
+		}
+	}
+
+	var failed []string
+	for _, group := range groups {
+		var nodes []string
+		for _, result := range group {
+			nodes = append(nodes, result.Node)
+		}
+		if group[0].Err != nil {
+			for _, node := range nodes {
+				failed = append(failed, node+" ("+group[0].Err.Error()+")")
+			}
+		}
+		if group[0].Output == "" {
+			continue
+		}
+		fmt.Fprintf(w, "=== %s ===\n", strings.Join(nodes, ", "))
+		fmt.Fprint(w, group[0].Output)
+		if !strings.HasSuffix(group[0].Output, "\n") {
+			fmt.Fprintln(w)
+		}
+	}
+	if len(failed) > 0 {
+		fmt.Fprintf(w, "\n%d of %d nodes in %s failed: %s\n", len(failed), len(results), env, strings.Join(failed, ", "))
+	}
+	return len(failed)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
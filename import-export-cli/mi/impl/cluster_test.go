@@ -0,0 +1,42 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMINodeEnvironment(t *testing.T) {
+	expected := []string{"mi", "get", "apis", "-k", "--environment", "dev-node-1"}
+	for _, args := range [][]string{
+		{"mi", "get", "apis", "-e", "dev", "-k"},
+		{"mi", "get", "apis", "-edev", "-k"},
+		{"mi", "get", "apis", "-e=dev", "-k"},
+		{"mi", "get", "apis", "--environment", "dev", "-k"},
+		{"mi", "get", "apis", "--environment=dev", "-k"},
+	} {
+		assert.Equal(t, expected, setMINodeEnvironment(args, "dev-node-1"), args)
+	}
+
+	assert.Equal(t, []string{"mi", "add", "user", "--environment", "dev-node-1", "--", "-e"},
+		setMINodeEnvironment([]string{"mi", "add", "user", "-e", "dev", "--", "-e"}, "dev-node-1"),
+		"Arguments after the terminator should be kept")
+}
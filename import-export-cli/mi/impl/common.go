@@ -19,12 +19,15 @@
 package impl
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"os"
+	"path/filepath"
 	"text/template"
 
 	"github.com/go-resty/resty"
@@ -33,9 +36,6 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
-// miHTTPRetryCount default retry count for HTTP calls
-const miHTTPRetryCount = 2
-
 type updateArtifactRequestBody struct {
 	Name   string `json:"name"`
 	Status string `json:"status"`
@@ -66,10 +66,6 @@ func unmarshalData(url string, params map[string]string, env string, model inter
 		}
 		return response, nil
 	}
-	if resp.StatusCode() == http.StatusUnauthorized {
-		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
-		utils.HandleErrorAndExit("Execute 'apictl mi login --help' for more information", nil)
-	}
 	if len(resp.Body()) == 0 {
 		return nil, errors.New(resp.Status())
 	}
@@ -89,10 +85,6 @@ func downloadLogFileData(url string, params map[string]string, env string) ([]by
 	if resp.StatusCode() == http.StatusOK {
 		return resp.Body(), nil
 	}
-	if resp.StatusCode() == http.StatusUnauthorized {
-		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
-		utils.HandleErrorAndExit("Execute 'apictl mi login --help' for more information", nil)
-	}
 	return nil, errors.New(resp.Status())
 }
 
@@ -102,10 +94,6 @@ func handleResponse(resp *resty.Response, err error, url, messageTag, errorTag s
 	}
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
 
-	if resp.StatusCode() == http.StatusUnauthorized {
-		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
-		utils.HandleErrorAndExit("Execute 'apictl mi login --help' for more information", nil)
-	}
 	if len(resp.Body()) == 0 {
 		return "", errors.New(resp.Status())
 	}
@@ -116,52 +104,101 @@ func handleResponse(resp *resty.Response, err error, url, messageTag, errorTag s
 	return "", errors.New(data[errorTag])
 }
 
-func retryHTTPCall(attempts int, env string, f func(string) (*resty.Response, error)) (*resty.Response, error) {
-	cred, err := credentials.GetMICredentials(env)
-	resp, err := f(cred.AccessToken)
-	if resp.StatusCode() == http.StatusUnauthorized {
-		if attempts--; attempts > 0 {
-			token, err := credentials.GetOAuthAccessTokenForMI(cred.Username, cred.Password, env)
-			if err != nil {
-				return nil, err
-			}
-			credentials.UpdateMIAccessToken(env, token)
-			return retryHTTPCall(attempts, env, f)
+// retryHTTPCall invokes a management API call with the access token of the environment, which is renewed before the
+// call if it has expired. The call is retried once with a new access token if the access token is rejected, since
+// it may have been revoked. The command exits if the call is still unauthorized, so that the callers need not handle it
+func retryHTTPCall(env string, f func(string) (*resty.Response, error)) (*resty.Response, error) {
+	accessToken, err := credentials.GetMIAccessToken(env)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting an access token for MI in "+env, err)
+	}
+	resp, err := f(accessToken)
+	if err == nil && resp.StatusCode() == http.StatusUnauthorized {
+		utils.Logln(utils.LogPrefixInfo + "MI access token of " + env + " was rejected. Renewing the access token")
+		if accessToken, err = credentials.RenewMIAccessToken(env); err != nil {
+			utils.HandleErrorAndExit("Error renewing the access token for MI in "+env, err)
 		}
+		resp, err = f(accessToken)
+	}
+	if err == nil && resp.StatusCode() == http.StatusUnauthorized {
+		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
+		utils.HandleErrorAndExit("Execute '"+utils.ProjectName+" "+utils.MiCmdLiteral+" login --help' for more information", nil)
 	}
 	return resp, err
 }
 
 func invokeGETRequestWithRetry(url string, params map[string]string, env string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokeGETRequestWithMultipleQueryParams(params, url, headers)
+		return utils.InvokeMIRequest(env, http.MethodGet, url, headers, params, nil)
 	})
 }
 
 func invokePATCHRequestWithRetry(url string, body map[string]string, env string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokePATCHRequest(url, headers, body)
+		return utils.InvokeMIRequest(env, http.MethodPatch, url, headers, nil, body)
 	})
 }
 
 func invokePOSTRequestWithRetry(env, url string, body interface{}) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(env, func(accessToken string) (*resty.Response, error) {
+		headers := make(map[string]string)
+		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+		headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+		return utils.InvokeMIRequest(env, http.MethodPost, url, headers, nil, body)
+	})
+}
+
+func invokePUTRequestWithRetry(env, url string, body interface{}) (*resty.Response, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return retryHTTPCall(env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 		headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
-		return utils.InvokePOSTRequest(url, headers, body)
+		return utils.InvokeMIRequest(env, http.MethodPut, url, headers, nil, string(data))
+	})
+}
+
+func invokeFileUploadRequestWithRetry(env, url, paramName, path string) (*resty.Response, error) {
+	return retryHTTPCall(env, func(accessToken string) (*resty.Response, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile(paramName, filepath.Base(path))
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.Copy(part, file); err != nil {
+			return nil, err
+		}
+		if err = writer.Close(); err != nil {
+			return nil, err
+		}
+
+		headers := make(map[string]string)
+		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+		headers[utils.HeaderContentType] = writer.FormDataContentType()
+		headers[utils.HeaderAccept] = utils.HeaderValueApplicationJSON
+		return utils.InvokeMIRequest(env, http.MethodPost, url, headers, nil, body.Bytes())
 	})
 }
 
 func invokeDELETERequestWithRetry(url string, env string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokeDELETERequest(url, headers)
+		return utils.InvokeMIRequest(env, http.MethodDelete, url, headers, nil, nil)
 	})
 }
 
@@ -41,3 +41,5 @@ const monthHeader = "MONTH"
 const yearHeader = "YEAR"
 const transactionCountHeader = "TRANSACTION COUNT"
 const userIDHeader = "USER ID"
+const timeHeader = "TIME"
+const changeHeader = "CHANGE"
@@ -19,11 +19,13 @@
 package impl
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
@@ -32,6 +34,7 @@ import (
 
 const (
 	defaultMessageStoreListTableFormat = "table {{.Name}}\t{{.Type}}\t{{.Size}}"
+	defaultMessageStoreSizeTableFormat = "table {{.Time}}\t{{.Name}}\t{{.Type}}\t{{.Size}}\t{{.Change}}"
 	defaultMessageStoreDetailedFormat  = "detail Name - {{.Name}}\n" +
 		"File Name - {{.FileName}}\n" +
 		"Container - {{.Container}}\n" +
@@ -107,3 +110,90 @@ func PrintMessageStoreDetails(messageStore *artifactutils.MessageStoreData, form
 		fmt.Println("Error executing template:", err.Error())
 	}
 }
+
+// MessageStoreSizeSample is the size of a message store at a point of time
+type MessageStoreSizeSample struct {
+	Time string `json:"time"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+	// Change of the size since the previous sample
+	Change int `json:"change"`
+}
+
+// WatchMessageStoreSizes polls the sizes of the message stores deployed in the micro integrator in a given
+// environment and prints them according to the given format, with the header of the table printed only once.
+// All the message stores are watched if no names are given. Polling stops after count polls if count is positive
+func WatchMessageStoreSizes(w io.Writer, env string, names []string, format string, interval time.Duration,
+	count int) error {
+	if format == "" {
+		format = defaultMessageStoreSizeTableFormat
+	}
+	previousSizes := make(map[string]int)
+	for poll := 1; ; poll++ {
+		messageStoreList, err := GetMessageStoreList(env)
+		if err != nil {
+			return err
+		}
+		samples := getMessageStoreSizeSamples(messageStoreList, names, previousSizes, time.Now())
+		if err = printMessageStoreSizeSamples(w, samples, format, poll == 1); err != nil {
+			return err
+		}
+		if count > 0 && poll >= count {
+			return nil
+		}
+		time.Sleep(interval)
+	}
+}
+
+func getMessageStoreSizeSamples(messageStoreList *artifactutils.MessageStoreList, names []string,
+	previousSizes map[string]int, now time.Time) []MessageStoreSizeSample {
+	var samples []MessageStoreSizeSample
+	for _, messageStore := range messageStoreList.MessageStores {
+		if len(names) > 0 && !containsString(names, messageStore.Name) {
+			continue
+		}
+		change := 0
+		if previousSize, found := previousSizes[messageStore.Name]; found {
+			change = messageStore.Size - previousSize
+		}
+		previousSizes[messageStore.Name] = messageStore.Size
+		samples = append(samples, MessageStoreSizeSample{
+			Time:   now.Format("15:04:05"),
+			Name:   messageStore.Name,
+			Type:   messageStore.Type,
+			Size:   messageStore.Size,
+			Change: change,
+		})
+	}
+	return samples
+}
+
+func printMessageStoreSizeSamples(w io.Writer, samples []MessageStoreSizeSample, format string, withHeader bool) error {
+	var buf bytes.Buffer
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, sample := range samples {
+			if err := t.Execute(w, sample); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	messageStoreSizeTableHeaders := map[string]string{
+		"Time":   timeHeader,
+		"Name":   nameHeader,
+		"Type":   typeHeader,
+		"Size":   sizeHeader,
+		"Change": changeHeader,
+	}
+	if err := formatter.NewContext(&buf, format).Write(renderer, messageStoreSizeTableHeaders); err != nil {
+		return err
+	}
+	output := buf.String()
+	if !withHeader && formatter.Format(format).IsTable() {
+		output = output[strings.Index(output, "\n")+1:]
+	}
+	_, err := io.WriteString(w, output)
+	return err
+}
@@ -19,17 +19,109 @@
 package impl
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
 )
 
 const transactionReportFilePrefix = "transaction-count-summary-"
 
+const (
+	// TransactionReportAggregateDaily sums the transaction counts of each day
+	TransactionReportAggregateDaily = "daily"
+	// TransactionReportAggregateMonthly sums the transaction counts of each month
+	TransactionReportAggregateMonthly = "monthly"
+
+	// TransactionReportFormatCSV writes the report as comma separated values
+	TransactionReportFormatCSV = "csv"
+	// TransactionReportFormatJSON writes the report as JSON
+	TransactionReportFormatJSON = "json"
+	// TransactionReportFormatYAML writes the report as YAML
+	TransactionReportFormatYAML = "yaml"
+
+	environmentHeader     = "ENVIRONMENT"
+	periodHeader          = "PERIOD"
+	nodeHeader            = "NODE"
+	deltaHeader           = "DELTA"
+	deltaPercentageHeader = "DELTA %"
+
+	dailyPeriodLayout   = "2006-01-02"
+	monthlyPeriodLayout = "2006-01"
+)
+
+// columns of the transaction report returned by the management API, which are the columns of the CURRENT_STATS table
+// of the transaction counter. The encrypted transaction count after them is not read
+const (
+	timestampColumn = iota
+	nodeIDColumn
+	transactionCountColumn
+)
+
+// transactionReportColumns are the names of the columns read from the header of the transaction report
+var transactionReportColumns = []string{"TIME_STAMP", "NODE_ID", "TRANSACTION_COUNT"}
+
+// timestamp layouts in which the micro integrator may return the time of a transaction count
+var transactionTimestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05.0",
+	"2006-01-02 15:04:05",
+	dailyPeriodLayout,
+	monthlyPeriodLayout,
+}
+
+// TransactionReport is the transaction counts of one or more environments for a given period
+type TransactionReport struct {
+	Environments []string               `json:"environments" yaml:"environments"`
+	Start        string                 `json:"start" yaml:"start"`
+	End          string                 `json:"end,omitempty" yaml:"end,omitempty"`
+	Aggregation  string                 `json:"aggregation,omitempty" yaml:"aggregation,omitempty"`
+	Total        int64                  `json:"total" yaml:"total"`
+	Rows         []TransactionReportRow `json:"rows" yaml:"rows"`
+}
+
+// TransactionReportRow is the transaction count of a period. Delta and DeltaPercentage are the change from the
+// previous month and are only set for the months after the first month of a monthly report
+type TransactionReportRow struct {
+	Environment      string   `json:"environment,omitempty" yaml:"environment,omitempty"`
+	Period           string   `json:"period" yaml:"period"`
+	Node             string   `json:"node,omitempty" yaml:"node,omitempty"`
+	TransactionCount int64    `json:"transactionCount" yaml:"transactionCount"`
+	Delta            *int64   `json:"delta,omitempty" yaml:"delta,omitempty"`
+	DeltaPercentage  *float64 `json:"deltaPercentage,omitempty" yaml:"deltaPercentage,omitempty"`
+}
+
+// transactionReportTableRow is a row of the report as shown in a table, a csv file or a Go template
+type transactionReportTableRow struct {
+	Environment      string
+	Period           string
+	Node             string
+	TransactionCount string
+	Delta            string
+	DeltaPercentage  string
+}
+
+// transactionCountEntry is a transaction count read from the report returned by the micro integrator
+type transactionCountEntry struct {
+	environment string
+	timestamp   string
+	time        time.Time
+	node        string
+	count       int64
+}
+
 // GetTransactionReport returns inbound transactions received by the micro integrator in a given environment as a report
 func GetTransactionReport(env string, period []string) (*artifactutils.TransactionCountInfo, error) {
 	params := make(map[string]string)
@@ -46,7 +138,7 @@ func GetTransactionReport(env string, period []string) (*artifactutils.Transacti
 
 // WriteTransactionReportAsCSV writes the transaction report to a csv file in the specified target directory
 func WriteTransactionReportAsCSV(transactions *artifactutils.TransactionCountInfo, targetDirectory string) {
-	fileName := transactionReportFilePrefix + strconv.FormatInt(time.Now().UnixNano(), 10) + ".csv"
+	fileName := GetTransactionReportFileName(TransactionReportFormatCSV)
 	destinationFilePath := filepath.Join(targetDirectory, fileName)
 	transactionCountLines := transactions.TransactionCounts
 	err := utils.WriteLinesToCSVFile(transactionCountLines, destinationFilePath)
@@ -56,3 +148,294 @@ func WriteTransactionReportAsCSV(transactions *artifactutils.TransactionCountInf
 		fmt.Println("Transaction Count Report created in", destinationFilePath)
 	}
 }
+
+// GetTransactionReportFileName returns a timestamped name for a transaction report file of the given format
+func GetTransactionReportFileName(format string) string {
+	return transactionReportFilePrefix + strconv.FormatInt(time.Now().UnixNano(), 10) + "." + format
+}
+
+// GetMergedTransactionReport returns the transaction counts of the given environments for the given period as a
+// single report. The counts are summed for each day or month when aggregation is daily or monthly, in which case the
+// counts of all the environments are merged. Otherwise the counts are listed as returned by each environment
+func GetMergedTransactionReport(envs []string, period []string, aggregation string) (*TransactionReport, error) {
+	if aggregation != "" && aggregation != TransactionReportAggregateDaily &&
+		aggregation != TransactionReportAggregateMonthly {
+		return nil, fmt.Errorf("invalid aggregation %s. Use %s or %s", aggregation,
+			TransactionReportAggregateDaily, TransactionReportAggregateMonthly)
+	}
+	var entries []transactionCountEntry
+	for _, env := range envs {
+		transactions, err := GetTransactionReport(env, period)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", env, err)
+		}
+		envEntries, err := readTransactionCountEntries(env, transactions.TransactionCounts, aggregation != "")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", env, err)
+		}
+		entries = append(entries, envEntries...)
+	}
+	report := &TransactionReport{
+		Environments: envs,
+		Start:        period[0],
+		End:          period[1],
+		Aggregation:  aggregation,
+	}
+	switch aggregation {
+	case TransactionReportAggregateDaily:
+		report.Rows = aggregateTransactionCounts(entries, dailyPeriodLayout)
+	case TransactionReportAggregateMonthly:
+		report.Rows = fillMissingMonths(aggregateTransactionCounts(entries, monthlyPeriodLayout))
+		setMonthOverMonthDeltas(report.Rows)
+	default:
+		for _, entry := range entries {
+			report.Rows = append(report.Rows, TransactionReportRow{
+				Environment:      entry.environment,
+				Period:           entry.timestamp,
+				Node:             entry.node,
+				TransactionCount: entry.count,
+			})
+		}
+	}
+	if len(envs) == 1 || aggregation != "" {
+		for i := range report.Rows {
+			report.Rows[i].Environment = ""
+		}
+	}
+	for _, row := range report.Rows {
+		report.Total += row.TransactionCount
+	}
+	return report, nil
+}
+
+// readTransactionCountEntries reads the transaction counts from the lines of a report. The first line is the header
+// and the rest are the rows of the CURRENT_STATS table of the transaction counter, in its column order
+func readTransactionCountEntries(env string, lines [][]string, parseTime bool) ([]transactionCountEntry, error) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	if !isTransactionReportHeader(lines[0]) {
+		return nil, errors.New("unrecognized transaction report columns " + strings.Join(lines[0], ", ") +
+			". Expected " + strings.Join(transactionReportColumns, ", "))
+	}
+	var entries []transactionCountEntry
+	for _, line := range lines[1:] {
+		if len(line) < len(transactionReportColumns) {
+			return nil, errors.New("incomplete transaction report row " + strings.Join(line, ", "))
+		}
+		count, err := strconv.ParseInt(strings.TrimSpace(line[transactionCountColumn]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction count %s", line[transactionCountColumn])
+		}
+		entry := transactionCountEntry{
+			environment: env,
+			timestamp:   strings.TrimSpace(line[timestampColumn]),
+			node:        strings.TrimSpace(line[nodeIDColumn]),
+			count:       count,
+		}
+		if parseTime {
+			if entry.time, err = parseTransactionTimestamp(entry.timestamp); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// isTransactionReportHeader returns true if the line names the columns of the report in the expected order
+func isTransactionReportHeader(line []string) bool {
+	if len(line) < len(transactionReportColumns) {
+		return false
+	}
+	for i, column := range transactionReportColumns {
+		if !strings.EqualFold(strings.TrimSpace(line[i]), column) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTransactionTimestamp parses a timestamp given in one of the known layouts or in milliseconds since the epoch
+func parseTransactionTimestamp(timestamp string) (time.Time, error) {
+	if millis, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+		return time.Unix(0, millis*int64(time.Millisecond)).UTC(), nil
+	}
+	for _, layout := range transactionTimestampLayouts {
+		if t, err := time.Parse(layout, timestamp); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("invalid transaction timestamp " + timestamp)
+}
+
+// aggregateTransactionCounts sums the counts of the entries for each period formatted with the given layout
+func aggregateTransactionCounts(entries []transactionCountEntry, layout string) []TransactionReportRow {
+	counts := make(map[string]int64)
+	for _, entry := range entries {
+		counts[entry.time.Format(layout)] += entry.count
+	}
+	var rows []TransactionReportRow
+	for period, count := range counts {
+		rows = append(rows, TransactionReportRow{Period: period, TransactionCount: count})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Period < rows[j].Period
+	})
+	return rows
+}
+
+// fillMissingMonths adds the months without transactions between the first and the last month with a zero count, so
+// that the deltas are always calculated from the previous month
+func fillMissingMonths(rows []TransactionReportRow) []TransactionReportRow {
+	if len(rows) < 2 {
+		return rows
+	}
+	var filled []TransactionReportRow
+	month, _ := time.Parse(monthlyPeriodLayout, rows[0].Period)
+	for _, row := range rows {
+		for ; month.Format(monthlyPeriodLayout) < row.Period; month = month.AddDate(0, 1, 0) {
+			filled = append(filled, TransactionReportRow{Period: month.Format(monthlyPeriodLayout)})
+		}
+		filled = append(filled, row)
+		month = month.AddDate(0, 1, 0)
+	}
+	return filled
+}
+
+func setMonthOverMonthDeltas(rows []TransactionReportRow) {
+	for i := 1; i < len(rows); i++ {
+		previous := rows[i-1].TransactionCount
+		delta := rows[i].TransactionCount - previous
+		rows[i].Delta = &delta
+		if previous != 0 {
+			percentage := float64(delta) * 100 / float64(previous)
+			rows[i].DeltaPercentage = &percentage
+		}
+	}
+}
+
+// WriteTransactionReport writes the report in the given format, which is csv, json, yaml, or a table or a Go template
+// applied to each row. The csv, table and template formats contain only the columns which have values
+func WriteTransactionReport(w io.Writer, report *TransactionReport, format string) error {
+	switch format {
+	case TransactionReportFormatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case TransactionReportFormatYAML:
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	case TransactionReportFormatCSV, "":
+		return writeTransactionReportAsCSV(w, report)
+	}
+	return writeTransactionReportAsTable(w, report, format)
+}
+
+func writeTransactionReportAsCSV(w io.Writer, report *TransactionReport) error {
+	columns := getTransactionReportColumns(report)
+	headers := getTransactionReportHeaders()
+	var lines [][]string
+	var line []string
+	for _, column := range columns {
+		line = append(line, headers[column])
+	}
+	lines = append(lines, line)
+	for _, row := range getTransactionReportTableRows(report) {
+		values := map[string]string{
+			"Environment":      row.Environment,
+			"Period":           row.Period,
+			"Node":             row.Node,
+			"TransactionCount": row.TransactionCount,
+			"Delta":            row.Delta,
+			"DeltaPercentage":  row.DeltaPercentage,
+		}
+		line = nil
+		for _, column := range columns {
+			line = append(line, values[column])
+		}
+		lines = append(lines, line)
+	}
+	return csv.NewWriter(w).WriteAll(lines)
+}
+
+func writeTransactionReportAsTable(w io.Writer, report *TransactionReport, format string) error {
+	if format == formatter.TableFormatKey {
+		var fields []string
+		for _, column := range getTransactionReportColumns(report) {
+			fields = append(fields, "{{."+column+"}}")
+		}
+		format = formatter.TableFormatKey + " " + strings.Join(fields, "\t")
+	}
+	reportContext := formatter.NewContext(w, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, row := range getTransactionReportTableRows(report) {
+			if err := t.Execute(w, row); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	return reportContext.Write(renderer, getTransactionReportHeaders())
+}
+
+func getTransactionReportHeaders() map[string]string {
+	return map[string]string{
+		"Environment":      environmentHeader,
+		"Period":           periodHeader,
+		"Node":             nodeHeader,
+		"TransactionCount": transactionCountHeader,
+		"Delta":            deltaHeader,
+		"DeltaPercentage":  deltaPercentageHeader,
+	}
+}
+
+// getTransactionReportColumns returns the columns of the report which have values for at least one row
+func getTransactionReportColumns(report *TransactionReport) []string {
+	hasEnvironment, hasNode := false, false
+	for _, row := range report.Rows {
+		hasEnvironment = hasEnvironment || row.Environment != ""
+		hasNode = hasNode || row.Node != ""
+	}
+	var columns []string
+	if hasEnvironment {
+		columns = append(columns, "Environment")
+	}
+	columns = append(columns, "Period")
+	if hasNode {
+		columns = append(columns, "Node")
+	}
+	columns = append(columns, "TransactionCount")
+	if report.Aggregation == TransactionReportAggregateMonthly {
+		columns = append(columns, "Delta", "DeltaPercentage")
+	}
+	return columns
+}
+
+func getTransactionReportTableRows(report *TransactionReport) []transactionReportTableRow {
+	var tableRows []transactionReportTableRow
+	for _, row := range report.Rows {
+		tableRow := transactionReportTableRow{
+			Environment:      row.Environment,
+			Period:           row.Period,
+			Node:             row.Node,
+			TransactionCount: strconv.FormatInt(row.TransactionCount, 10),
+		}
+		if row.Delta != nil {
+			tableRow.Delta = fmt.Sprintf("%+d", *row.Delta)
+		}
+		if row.DeltaPercentage != nil {
+			tableRow.DeltaPercentage = fmt.Sprintf("%+.2f", *row.DeltaPercentage)
+		}
+		tableRows = append(tableRows, tableRow)
+	}
+	return tableRows
+}
@@ -0,0 +1,149 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var transactionReportHeader = []string{"TIME_STAMP", "NODE_ID", "TRANSACTION_COUNT", "TRANSACTION_COUNT_ENCRYPTED"}
+
+func TestReadTransactionCountEntries(t *testing.T) {
+	lines := [][]string{
+		transactionReportHeader,
+		{"2020-10-13", "node-1", "120", "aGVsbG8="},
+		{"2020-10-14", " node-2 ", " 30 ", "d29ybGQ="},
+	}
+	entries, err := readTransactionCountEntries("dev", lines, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []transactionCountEntry{
+		{environment: "dev", timestamp: "2020-10-13", time: time.Date(2020, 10, 13, 0, 0, 0, 0, time.UTC),
+			node: "node-1", count: 120},
+		{environment: "dev", timestamp: "2020-10-14", time: time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC),
+			node: "node-2", count: 30},
+	}, entries)
+
+	// timestamps are parsed only when the counts are aggregated
+	entries, err = readTransactionCountEntries("dev", [][]string{transactionReportHeader,
+		{"not a date", "node-1", "5", ""}}, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "not a date", entries[0].timestamp)
+	assert.True(t, entries[0].time.IsZero())
+
+	entries, err = readTransactionCountEntries("dev", [][]string{{"time_stamp", "node_id", "transaction_count"}}, true)
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+
+	entries, err = readTransactionCountEntries("dev", nil, true)
+	assert.Nil(t, err)
+	assert.Nil(t, entries)
+}
+
+func TestReadTransactionCountEntriesInvalidReports(t *testing.T) {
+	_, err := readTransactionCountEntries("dev", [][]string{{"2020-10-13", "node-1", "120", "aGVsbG8="}}, true)
+	assert.EqualError(t, err, "unrecognized transaction report columns 2020-10-13, node-1, 120, aGVsbG8=. "+
+		"Expected TIME_STAMP, NODE_ID, TRANSACTION_COUNT")
+
+	_, err = readTransactionCountEntries("dev", [][]string{{"NODE_ID", "TIME_STAMP", "TRANSACTION_COUNT"}}, true)
+	assert.NotNil(t, err)
+
+	_, err = readTransactionCountEntries("dev", [][]string{transactionReportHeader, {"2020-10-13", "node-1"}}, true)
+	assert.EqualError(t, err, "incomplete transaction report row 2020-10-13, node-1")
+
+	_, err = readTransactionCountEntries("dev", [][]string{transactionReportHeader,
+		{"2020-10-13", "node-1", "many", ""}}, true)
+	assert.EqualError(t, err, "invalid transaction count many")
+
+	_, err = readTransactionCountEntries("dev", [][]string{transactionReportHeader,
+		{"13/10/2020", "node-1", "1", ""}}, true)
+	assert.EqualError(t, err, "invalid transaction timestamp 13/10/2020")
+}
+
+func TestParseTransactionTimestamp(t *testing.T) {
+	expected := time.Date(2020, 10, 13, 10, 41, 28, 0, time.UTC)
+	for _, timestamp := range []string{"2020-10-13T10:41:28Z", "2020-10-13 10:41:28.0", "2020-10-13 10:41:28",
+		"1602585688000"} {
+		parsed, err := parseTransactionTimestamp(timestamp)
+		assert.Nil(t, err, timestamp)
+		assert.True(t, expected.Equal(parsed), timestamp)
+	}
+	parsed, err := parseTransactionTimestamp("2020-10")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), parsed)
+}
+
+func TestAggregateTransactionCounts(t *testing.T) {
+	entries := []transactionCountEntry{
+		{environment: "dev", time: time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC), node: "node-1", count: 10},
+		{environment: "dev", time: time.Date(2020, 10, 13, 0, 0, 0, 0, time.UTC), node: "node-1", count: 120},
+		{environment: "prod", time: time.Date(2020, 10, 13, 0, 0, 0, 0, time.UTC), node: "node-2", count: 30},
+		{environment: "prod", time: time.Date(2020, 10, 31, 0, 0, 0, 0, time.UTC), node: "node-2", count: 5},
+	}
+	assert.Equal(t, []TransactionReportRow{
+		{Period: "2020-10-13", TransactionCount: 150},
+		{Period: "2020-10-31", TransactionCount: 5},
+		{Period: "2020-11-02", TransactionCount: 10},
+	}, aggregateTransactionCounts(entries, dailyPeriodLayout))
+	assert.Equal(t, []TransactionReportRow{
+		{Period: "2020-10", TransactionCount: 155},
+		{Period: "2020-11", TransactionCount: 10},
+	}, aggregateTransactionCounts(entries, monthlyPeriodLayout))
+	assert.Nil(t, aggregateTransactionCounts(nil, monthlyPeriodLayout))
+}
+
+func TestFillMissingMonths(t *testing.T) {
+	rows := []TransactionReportRow{
+		{Period: "2020-11", TransactionCount: 100},
+		{Period: "2021-02", TransactionCount: 50},
+		{Period: "2021-03", TransactionCount: 75},
+	}
+	assert.Equal(t, []TransactionReportRow{
+		{Period: "2020-11", TransactionCount: 100},
+		{Period: "2020-12"},
+		{Period: "2021-01"},
+		{Period: "2021-02", TransactionCount: 50},
+		{Period: "2021-03", TransactionCount: 75},
+	}, fillMissingMonths(rows))
+
+	single := []TransactionReportRow{{Period: "2020-11", TransactionCount: 100}}
+	assert.Equal(t, single, fillMissingMonths(single))
+}
+
+func TestSetMonthOverMonthDeltas(t *testing.T) {
+	rows := []TransactionReportRow{
+		{Period: "2020-11", TransactionCount: 100},
+		{Period: "2020-12", TransactionCount: 150},
+		{Period: "2021-01"},
+		{Period: "2021-02", TransactionCount: 40},
+	}
+	setMonthOverMonthDeltas(rows)
+
+	assert.Nil(t, rows[0].Delta)
+	assert.Nil(t, rows[0].DeltaPercentage)
+	assert.Equal(t, int64(50), *rows[1].Delta)
+	assert.Equal(t, 50.0, *rows[1].DeltaPercentage)
+	assert.Equal(t, int64(-150), *rows[2].Delta)
+	assert.Equal(t, -100.0, *rows[2].DeltaPercentage)
+	// no percentage of a change from a month without transactions
+	assert.Equal(t, int64(40), *rows[3].Delta)
+	assert.Nil(t, rows[3].DeltaPercentage)
+}
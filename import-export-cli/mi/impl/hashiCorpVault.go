@@ -19,18 +19,135 @@
 package impl
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
+const (
+	defaultExternalVaultListTableFormat = "table {{.Name}}\t{{.Status}}"
+	defaultExternalVaultDetailedFormat  = "detail Name - {{.Name}}\n" +
+		"Updatable Fields - {{join .UpdatableFields \", \"}}\n" +
+		"Server Version - {{.ServerVersion}}\n" +
+		"Status - {{.Status}}\n" +
+		"Message - {{.Message}}"
+
+	// ExternalVaultStatusUpdatable is the status of an external vault which can be updated through the management API
+	ExternalVaultStatusUpdatable = "Updatable"
+	// ExternalVaultStatusUnreachable is the status of an external vault of a micro integrator which cannot be reached
+	ExternalVaultStatusUnreachable = "Unreachable"
+
+	hashiCorpSecretIDKey  = "secretId"
+	hashiCorpRoleIDKey    = "roleId"
+	hashiCorpRootTokenKey = "rootToken"
+)
+
 // UpdateHashiCorpSecretID updates the secretID of the HashiCorp vault configuration in the micro integrator in a given environment
 func UpdateHashiCorpSecretID(env, secretID string) (interface{}, error) {
-	body := `{"secretId":"` + secretID + `"}`
+	return updateHashiCorpVault(env, hashiCorpSecretIDKey, secretID)
+}
+
+// UpdateHashiCorpRoleID updates the AppRole role ID of the HashiCorp vault configuration in the micro integrator in a given environment
+func UpdateHashiCorpRoleID(env, roleID string) (string, error) {
+	return updateHashiCorpVault(env, hashiCorpRoleIDKey, roleID)
+}
+
+// UpdateHashiCorpRootToken updates the token of the HashiCorp vault configuration in the micro integrator in a given environment
+func UpdateHashiCorpRootToken(env, token string) (string, error) {
+	return updateHashiCorpVault(env, hashiCorpRootTokenKey, token)
+}
+
+func updateHashiCorpVault(env, key, value string) (string, error) {
+	body := map[string]string{key: value}
 	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementExternalVaultsResource, env, utils.MainConfigFilePath) + "/" +
 		utils.MiManagementExternalVaultHashiCorpResource
 	return updateHarshiCorpSecret(env, url, body)
 }
 
-func updateHarshiCorpSecret(env, url, body string) (string, error) {
+func updateHarshiCorpSecret(env, url string, body interface{}) (string, error) {
 	resp, err := invokePOSTRequestWithRetry(env, url, body)
 	return handleResponse(resp, err, url, "Message", "Error")
 }
+
+// hashiCorpVaultFields are the fields of the HashiCorp vault configuration accepted by the management API
+var hashiCorpVaultFields = []string{hashiCorpRoleIDKey, hashiCorpSecretIDKey, hashiCorpRootTokenKey}
+
+// GetExternalVaultList returns the external vaults of which the configuration can be updated through the management
+// API of the micro integrator in a given environment, with their status
+func GetExternalVaultList(env string) *artifactutils.ExternalVaultList {
+	vault := getHashiCorpVault(env)
+	return &artifactutils.ExternalVaultList{Count: 1, Vaults: []artifactutils.ExternalVault{*vault}}
+}
+
+// GetExternalVault returns the status of an external vault of the micro integrator in a given environment
+func GetExternalVault(env, vaultName string) (*artifactutils.ExternalVault, error) {
+	if vaultName != utils.MiManagementExternalVaultHashiCorpResource {
+		return nil, errors.New("external vault " + vaultName + " is not supported. Supported vaults: " +
+			utils.MiManagementExternalVaultHashiCorpResource)
+	}
+	return getHashiCorpVault(env), nil
+}
+
+// getHashiCorpVault checks whether the configuration of the HashiCorp vault can be updated, which is the case when the
+// management API of the micro integrator can be reached with the current credentials
+func getHashiCorpVault(env string) *artifactutils.ExternalVault {
+	vault := &artifactutils.ExternalVault{
+		Name:            utils.MiManagementExternalVaultHashiCorpResource,
+		UpdatableFields: hashiCorpVaultFields,
+	}
+	resp, err := callMIManagementEndpointOfResource(utils.MiManagementServerResource, nil, env,
+		&artifactutils.ServerSummary{})
+	if err != nil {
+		vault.Status = ExternalVaultStatusUnreachable
+		vault.Message = err.Error()
+		return vault
+	}
+	server := resp.(*artifactutils.ServerSummary)
+	vault.ServerVersion = strings.TrimSpace(server.ProductName + " " + server.ProductVersion)
+	vault.Status = ExternalVaultStatusUpdatable
+	vault.Message = "The connection state of the vault is not exposed by the management API"
+	return vault
+}
+
+// PrintExternalVaultList prints a list of external vaults according to the given format
+func PrintExternalVaultList(vaultList *artifactutils.ExternalVaultList, format string) {
+	vaultListContext := getContextWithFormat(format, defaultExternalVaultListTableFormat)
+
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, vault := range vaultList.Vaults {
+			if err := t.Execute(w, vault); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	vaultListTableHeaders := map[string]string{
+		"Name":   nameHeader,
+		"Status": statusHeader,
+	}
+	if err := vaultListContext.Write(renderer, vaultListTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// PrintExternalVaultDetails prints the status of an external vault according to the given format
+func PrintExternalVaultDetails(vault *artifactutils.ExternalVault, format string) {
+	if format == "" || strings.HasPrefix(format, formatter.TableFormatKey) {
+		format = defaultExternalVaultDetailedFormat
+	}
+
+	vaultContext := formatter.NewContext(os.Stdout, format)
+	renderer := getItemRendererEndsWithNewLine(vault)
+
+	if err := vaultContext.Write(renderer, nil); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
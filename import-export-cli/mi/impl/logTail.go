@@ -0,0 +1,323 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// DefaultLogFileName is the log file of the micro integrator tailed if a file is not given
+const DefaultLogFileName = "wso2carbon.log"
+
+// logTimestampLayout is the layout of the timestamps in the micro integrator logs
+const logTimestampLayout = "2006-01-02 15:04:05,000"
+
+// logEntryHeader matches the timestamp and the level at the beginning of a log entry.
+// Eg: [2020-10-13 10:41:28,321]  INFO {org.apache.synapse.ServerManager} - Server ready
+var logEntryHeader = regexp.MustCompile(`^\[(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2},\d{3})\]\s+([A-Z]+)`)
+
+// rotatedLogFileSuffix matches the date and the optional index the log files are rotated to.
+// Eg: wso2carbon-10-18-2026.log, wso2carbon-10-18-2026-2.log
+var rotatedLogFileSuffix = regexp.MustCompile(`-(\d{2}-\d{2}-\d{4})(?:-(\d+))?\.log$`)
+
+// rotatedLogFileDateLayout is the layout of the dates in the names of the rotated log files
+const rotatedLogFileDateLayout = "01-02-2006"
+
+// logHeadSize is the number of bytes at the beginning of the log file compared to detect a rotation
+const logHeadSize = 256
+
+// logLevels in the increasing order of severity
+var logLevels = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// LogFilter represents the filters applied on the log entries
+type LogFilter struct {
+	Since   time.Time      // Entries logged before this are skipped, if not zero
+	Pattern *regexp.Regexp // Entries not matching this are skipped, if not nil
+	Level   int            // Entries with a lower severity are skipped
+}
+
+// NewLogFilter creates a log filter from the values of the flags
+// @param since : A duration (eg: 10m) relative to now or a timestamp (eg: 2020-10-13 10:41:28). Ignored if empty
+// @param pattern : A regular expression to match the entries. Ignored if empty
+// @param level : Minimum level of the entries. Ignored if empty
+// @param now : Current time
+// @return filter, error
+func NewLogFilter(since, pattern, level string, now time.Time) (*LogFilter, error) {
+	filter := &LogFilter{}
+	if since != "" {
+		if duration, err := time.ParseDuration(since); err == nil {
+			filter.Since = now.Add(-duration)
+		} else if timestamp, err := time.ParseInLocation("2006-01-02 15:04:05", since, time.Local); err == nil {
+			filter.Since = timestamp
+		} else if timestamp, err := time.Parse(time.RFC3339, since); err == nil {
+			filter.Since = timestamp
+		} else {
+			return nil, fmt.Errorf("invalid value %q for since. Use a duration like 10m or a timestamp like "+
+				"\"2020-10-13 10:41:28\"", since)
+		}
+	}
+	if pattern != "" {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		filter.Pattern = regex
+	}
+	if level != "" {
+		filter.Level = logLevelSeverity(strings.ToUpper(level))
+		if filter.Level < 0 {
+			return nil, fmt.Errorf("invalid log level %q. Use one of %s", level, strings.Join(logLevels, ", "))
+		}
+	}
+	return filter, nil
+}
+
+// matchEntry returns true if the first line of a log entry matches the filter. Lines without a timestamp and a
+// level are matched only against the pattern
+func (f *LogFilter) matchEntry(line string) bool {
+	if f.Pattern != nil && !f.Pattern.MatchString(line) {
+		return false
+	}
+	header := logEntryHeader.FindStringSubmatch(line)
+	if header == nil {
+		return true
+	}
+	if !f.Since.IsZero() {
+		timestamp, err := time.ParseInLocation(logTimestampLayout, header[1], time.Local)
+		if err == nil && timestamp.Before(f.Since) {
+			return false
+		}
+	}
+	severity := logLevelSeverity(header[2])
+	return severity < 0 || severity >= f.Level
+}
+
+// LogTailer writes the new lines of a log file of a micro integrator matching a filter
+type LogTailer struct {
+	environment  string
+	fileName     string
+	filter       *LogFilter
+	out          io.Writer
+	offset       int             // number of bytes of the log file already processed
+	head         []byte          // first bytes of the log file already processed
+	partialLine  []byte          // last line of the log file without a line break yet
+	started      bool            // whether a line is written to the tailer
+	matched      bool            // whether the current entry matches the filter
+	rotatedFiles map[string]bool // rotated log files already in the server
+}
+
+// NewLogTailer creates a log tailer for a log file of the micro integrator in the environment
+func NewLogTailer(env, fileName string, filter *LogFilter, out io.Writer) *LogTailer {
+	return &LogTailer{environment: env, fileName: fileName, filter: filter, out: out}
+}
+
+// Poll downloads the log file and writes the lines added since the last poll. If the log file is rotated, the
+// remaining lines are read from the rotated file
+func (t *LogTailer) Poll() error {
+	data, err := GetLogFile(t.environment, t.fileName)
+	if err != nil {
+		return err
+	}
+	if t.isRotated(data) {
+		utils.Logln(utils.LogPrefixInfo + t.fileName + " is rotated")
+		if err = t.readRotatedFile(); err != nil {
+			return err
+		}
+		t.offset = 0
+	}
+	t.write(data[t.offset:])
+	t.offset = len(data)
+	if len(data) > logHeadSize {
+		data = data[:logHeadSize]
+	}
+	t.head = append([]byte(nil), data...)
+	return nil
+}
+
+// isRotated returns true if the downloaded log file is not the file processed so far. A rotated log file is either
+// shorter than the processed bytes or starts differently, as the new file may already be longer than the old one
+func (t *LogTailer) isRotated(data []byte) bool {
+	return len(data) < t.offset || !bytes.HasPrefix(data, t.head)
+}
+
+// WatchRotations records the rotated log files in the server, so the file a log is rotated to can be found
+func (t *LogTailer) WatchRotations() error {
+	rotatedFiles, err := t.listRotatedFiles()
+	if err != nil {
+		return err
+	}
+	t.rotatedFiles = make(map[string]bool)
+	for _, name := range rotatedFiles {
+		t.rotatedFiles[name] = true
+	}
+	return nil
+}
+
+// readRotatedFile writes the remaining lines of the log file from the file it was rotated to
+func (t *LogTailer) readRotatedFile() error {
+	if t.rotatedFiles != nil {
+		rotatedFiles, err := t.listRotatedFiles()
+		if err != nil {
+			return err
+		}
+		var newFiles []string
+		for _, name := range rotatedFiles {
+			if !t.rotatedFiles[name] {
+				newFiles = append(newFiles, name)
+				t.rotatedFiles[name] = true
+			}
+		}
+		if len(newFiles) > 0 {
+			// the latest rotated file has the lines of the log file before it was rotated
+			sortRotatedFiles(newFiles)
+			data, err := GetLogFile(t.environment, newFiles[len(newFiles)-1])
+			if err != nil {
+				return err
+			}
+			if len(data) > t.offset {
+				t.write(data[t.offset:])
+			}
+		}
+	}
+	if len(t.partialLine) > 0 {
+		t.writeLine(string(t.partialLine))
+		t.partialLine = nil
+	}
+	return nil
+}
+
+// listRotatedFiles returns the log files in the server created by rotating the tailed log file
+func (t *LogTailer) listRotatedFiles() ([]string, error) {
+	fileList, err := GetLogFileList(t.environment)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSuffix(t.fileName, ".log")
+	var rotatedFiles []string
+	for _, logFile := range fileList.LogFiles {
+		if logFile.FileName != t.fileName && strings.HasPrefix(logFile.FileName, prefix) {
+			rotatedFiles = append(rotatedFiles, logFile.FileName)
+		}
+	}
+	return rotatedFiles, nil
+}
+
+// sortRotatedFiles sorts the rotated log files by the date and the index in their names, from the oldest to the
+// latest. Files without a date in the name are placed first
+func sortRotatedFiles(names []string) {
+	sort.SliceStable(names, func(i, j int) bool {
+		dateI, indexI := rotatedFileDate(names[i])
+		dateJ, indexJ := rotatedFileDate(names[j])
+		if !dateI.Equal(dateJ) {
+			return dateI.Before(dateJ)
+		}
+		if indexI != indexJ {
+			return indexI < indexJ
+		}
+		return names[i] < names[j]
+	})
+}
+
+// rotatedFileDate returns the date and the index in the name of a rotated log file. The date is zero if the name
+// does not have one
+func rotatedFileDate(name string) (time.Time, int) {
+	match := rotatedLogFileSuffix.FindStringSubmatch(name)
+	if match == nil {
+		return time.Time{}, 0
+	}
+	date, err := time.Parse(rotatedLogFileDateLayout, match[1])
+	if err != nil {
+		return time.Time{}, 0
+	}
+	index, _ := strconv.Atoi(match[2])
+	return date, index
+}
+
+// write writes the complete lines in the data and keeps the last line until its line break is received
+func (t *LogTailer) write(data []byte) {
+	data = append(t.partialLine, data...)
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		t.partialLine = data
+		return
+	}
+	t.partialLine = append([]byte(nil), data[end+1:]...)
+	for _, line := range strings.Split(string(data[:end]), "\n") {
+		t.writeLine(strings.TrimRight(line, "\r"))
+	}
+}
+
+// writeLine writes a line if its log entry matches the filter. Lines of stack traces belong to the last entry
+func (t *LogTailer) writeLine(line string) {
+	if logEntryHeader.MatchString(line) || !t.started {
+		t.matched = t.filter.matchEntry(line)
+		t.started = true
+	}
+	if t.matched {
+		_, _ = fmt.Fprintln(t.out, line)
+	}
+}
+
+// TailLogFile writes the lines of a log file of the micro integrator in a given environment matching the filter
+// @param env : Environment of the micro integrator
+// @param fileName : Name of the log file
+// @param filter : Filter of the log entries
+// @param follow : Keep polling the log file for new lines until interrupted
+// @param interval : Interval between two polls
+// @param out : Writer of the log lines
+// @return error
+func TailLogFile(env, fileName string, filter *LogFilter, follow bool, interval time.Duration, out io.Writer) error {
+	tailer := NewLogTailer(env, fileName, filter, out)
+	if follow {
+		if err := tailer.WatchRotations(); err != nil {
+			return err
+		}
+	}
+	for {
+		if err := tailer.Poll(); err != nil {
+			return err
+		}
+		if !follow {
+			if len(tailer.partialLine) > 0 {
+				tailer.writeLine(string(tailer.partialLine))
+			}
+			return nil
+		}
+		time.Sleep(interval)
+	}
+}
+
+// logLevelSeverity returns the severity of a log level or -1 if the level is unknown
+func logLevelSeverity(level string) int {
+	for i, logLevel := range logLevels {
+		if logLevel == level {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,143 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLogFilter(t *testing.T) {
+	now := time.Date(2020, 10, 13, 10, 41, 28, 0, time.Local)
+
+	filter, err := NewLogFilter("10m", "Server", "warn", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.Add(-10*time.Minute), filter.Since)
+	assert.Equal(t, "Server", filter.Pattern.String())
+	assert.Equal(t, logLevelSeverity("WARN"), filter.Level)
+
+	filter, err = NewLogFilter("2020-10-13 09:00:00", "", "", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2020, 10, 13, 9, 0, 0, 0, time.Local), filter.Since)
+	assert.Nil(t, filter.Pattern)
+	assert.Equal(t, 0, filter.Level)
+
+	filter, err = NewLogFilter("2020-10-13T09:00:00Z", "", "", now)
+	assert.Nil(t, err)
+	assert.True(t, time.Date(2020, 10, 13, 9, 0, 0, 0, time.UTC).Equal(filter.Since))
+
+	filter, err = NewLogFilter("", "", "", now)
+	assert.Nil(t, err)
+	assert.True(t, filter.Since.IsZero())
+}
+
+func TestNewLogFilterInvalidValues(t *testing.T) {
+	now := time.Now()
+
+	_, err := NewLogFilter("yesterday", "", "", now)
+	assert.EqualError(t, err, `invalid value "yesterday" for since. Use a duration like 10m or a timestamp like `+
+		`"2020-10-13 10:41:28"`)
+
+	_, err = NewLogFilter("", "([", "", now)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid pattern "(["`)
+
+	_, err = NewLogFilter("", "", "verbose", now)
+	assert.EqualError(t, err, `invalid log level "verbose". Use one of TRACE, DEBUG, INFO, WARN, ERROR, FATAL`)
+}
+
+func TestMatchEntry(t *testing.T) {
+	since := time.Date(2020, 10, 13, 10, 0, 0, 0, time.Local)
+	filter, err := NewLogFilter("2020-10-13 10:00:00", "Server", "INFO", time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, since, filter.Since)
+
+	assert.True(t, filter.matchEntry("[2020-10-13 10:41:28,321]  INFO {org.apache.synapse.ServerManager} - Server ready"))
+	assert.True(t, filter.matchEntry("[2020-10-13 10:00:00,000] ERROR {ServerManager} - Server failed"))
+	// logged before since
+	assert.False(t, filter.matchEntry("[2020-10-13 09:59:59,999]  INFO {ServerManager} - Server ready"))
+	// lower level
+	assert.False(t, filter.matchEntry("[2020-10-13 10:41:28,321] DEBUG {ServerManager} - Server ready"))
+	// not matching the pattern
+	assert.False(t, filter.matchEntry("[2020-10-13 10:41:28,321]  INFO {CarbonAppDeployer} - Deployed"))
+	// lines without a header are matched only against the pattern
+	assert.True(t, filter.matchEntry("\tat org.apache.synapse.ServerManager.start(ServerManager.java:42)"))
+	assert.False(t, filter.matchEntry("\tat org.apache.synapse.Axis2SynapseController.init(Unknown Source)"))
+}
+
+func TestWrite(t *testing.T) {
+	filter, err := NewLogFilter("", "", "WARN", time.Now())
+	assert.Nil(t, err)
+	out := &bytes.Buffer{}
+	tailer := NewLogTailer("dev", DefaultLogFileName, filter, out)
+
+	tailer.write([]byte("[2020-10-13 10:41:28,321]  INFO {ServerManager} - Starting\n" +
+		"[2020-10-13 10:41:29,000] ERROR {ServerManager} - Failed\r\n\tat Server.start(Server.java:42)\n" +
+		"[2020-10-13 10:41:30,000]  WARN {Server"))
+	assert.Equal(t, "[2020-10-13 10:41:29,000] ERROR {ServerManager} - Failed\n"+
+		"\tat Server.start(Server.java:42)\n", out.String())
+	assert.Equal(t, "[2020-10-13 10:41:30,000]  WARN {Server", string(tailer.partialLine))
+
+	// the partial line is written once its line break is received
+	out.Reset()
+	tailer.write([]byte("Manager} - Slow start\n\tat Server.start(Server.java:50)\n[2020-10-13 10:41:31,000]  INFO"))
+	assert.Equal(t, "[2020-10-13 10:41:30,000]  WARN {ServerManager} - Slow start\n"+
+		"\tat Server.start(Server.java:50)\n", out.String())
+
+	out.Reset()
+	tailer.write([]byte(" {ServerManager} - Ready\n\tat Server.ready(Server.java:60)\n"))
+	assert.Equal(t, "", out.String())
+	assert.Empty(t, tailer.partialLine)
+}
+
+func TestWriteLinesBeforeFirstEntry(t *testing.T) {
+	filter, err := NewLogFilter("", "Caused by", "", time.Now())
+	assert.Nil(t, err)
+	out := &bytes.Buffer{}
+	tailer := NewLogTailer("dev", DefaultLogFileName, filter, out)
+
+	// the first line downloaded may be in the middle of an entry
+	tailer.write([]byte("Caused by: java.io.IOException\n\tat Server.read(Server.java:10)\n" +
+		"[2020-10-13 10:41:28,321]  INFO {ServerManager} - Ready\n"))
+	assert.Equal(t, "Caused by: java.io.IOException\n\tat Server.read(Server.java:10)\n", out.String())
+}
+
+func TestIsRotated(t *testing.T) {
+	tailer := NewLogTailer("dev", DefaultLogFileName, &LogFilter{}, &bytes.Buffer{})
+	assert.False(t, tailer.isRotated([]byte("[2020-10-13 10:41:28,321]  INFO - Starting\n")))
+
+	tailer.offset = 43
+	tailer.head = []byte("[2020-10-13 10:41:28,321]  INFO - Starting\n")
+	assert.False(t, tailer.isRotated([]byte("[2020-10-13 10:41:28,321]  INFO - Starting\n[2020-10-13 10:41:29,000]")))
+	// the new log file is shorter than the processed bytes
+	assert.True(t, tailer.isRotated([]byte("[2020-10-14 00:00:00,001]")))
+	// the new log file is already longer than the processed bytes
+	assert.True(t, tailer.isRotated([]byte("[2020-10-14 00:00:00,001]  INFO - Rotated\n[2020-10-14 00:00:00,002]")))
+}
+
+func TestSortRotatedFiles(t *testing.T) {
+	files := []string{"wso2carbon-10-18-2026.log", "wso2carbon-09-30-2026.log", "wso2carbon-01-02-2027.log",
+		"wso2carbon-10-18-2026-10.log", "wso2carbon-10-18-2026-2.log", "wso2carbon-old.log"}
+	sortRotatedFiles(files)
+	assert.Equal(t, []string{"wso2carbon-old.log", "wso2carbon-09-30-2026.log", "wso2carbon-10-18-2026.log",
+		"wso2carbon-10-18-2026-2.log", "wso2carbon-10-18-2026-10.log", "wso2carbon-01-02-2027.log"}, files)
+}
@@ -19,6 +19,9 @@
 package impl
 
 import (
+	"os"
+	"time"
+
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -32,6 +35,18 @@ func DeactivateMessageProcessor(env, messageProcessorName string) (interface{},
 	return updateMessageProcessorSerivceState(env, messageProcessorName, "inactive")
 }
 
+// ActivateMessageProcessorAfter waits for the duration, or until an interrupt is received, and activates a message
+// processor deployed in the micro integrator in a given environment
+func ActivateMessageProcessorAfter(env, messageProcessorName string, duration time.Duration,
+	interrupt <-chan os.Signal) (interface{}, error) {
+	select {
+	case <-time.After(duration):
+	case sig := <-interrupt:
+		utils.Logln(utils.LogPrefixInfo+"Received", sig, "activating "+messageProcessorName+" before the end of the pause")
+	}
+	return ActivateMessageProcessor(env, messageProcessorName)
+}
+
 func updateMessageProcessorSerivceState(env, messageProcessorName, state string) (interface{}, error) {
 	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementMessageProcessorResource, env, utils.MainConfigFilePath)
 	return updateArtifactState(url, messageProcessorName, state, env)
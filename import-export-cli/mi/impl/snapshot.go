@@ -0,0 +1,448 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+)
+
+const (
+	defaultSnapshotDiffTableFormat = "table {{.ArtifactType}}\t{{.Name}}\t{{.Difference}}\t{{.Details}}"
+
+	differenceHeader = "DIFFERENCE"
+	detailsHeader    = "DETAILS"
+
+	// SnapshotDifferenceMissing artifact is in the first snapshot but not in the second
+	SnapshotDifferenceMissing = "missing"
+	// SnapshotDifferenceExtra artifact is in the second snapshot but not in the first
+	SnapshotDifferenceExtra = "extra"
+	// SnapshotDifferenceChanged artifact is in both snapshots with a different version or state
+	SnapshotDifferenceChanged = "changed"
+)
+
+// MISnapshot is the inventory of the artifacts deployed in a micro integrator
+type MISnapshot struct {
+	Environment string `json:"environment"`
+	CreatedTime string `json:"createdTime"`
+	// Artifacts of each artifact type sorted by name
+	Artifacts map[string][]SnapshotArtifact `json:"artifacts"`
+}
+
+// SnapshotArtifact is an artifact in a snapshot with its version and state
+type SnapshotArtifact struct {
+	Name    string            `json:"name"`
+	Version string            `json:"version,omitempty"`
+	State   map[string]string `json:"state,omitempty"`
+}
+
+// SnapshotDifference is a difference of an artifact between two snapshots
+type SnapshotDifference struct {
+	ArtifactType string `json:"artifactType"`
+	Name         string `json:"name"`
+	Difference   string `json:"difference"`
+	Details      string `json:"details,omitempty"`
+}
+
+// snapshotCollectors collect the artifacts of each artifact type, named as in the get commands
+var snapshotCollectors = map[string]func(env string) ([]SnapshotArtifact, error){
+	"apis":               collectIntegrationAPIs,
+	"composite-apps":     collectCompositeApps,
+	"connectors":         collectConnectors,
+	"data-services":      collectDataServices,
+	"endpoints":          collectEndpoints,
+	"inbound-endpoints":  collectInboundEndpoints,
+	"local-entries":      collectLocalEntries,
+	"message-processors": collectMessageProcessors,
+	"message-stores":     collectMessageStores,
+	"proxy-services":     collectProxyServices,
+	"sequences":          collectSequences,
+	"tasks":              collectTasks,
+	"sequence-templates": collectSequenceTemplates,
+	"endpoint-templates": collectEndpointTemplates,
+}
+
+// GetMISnapshot returns the inventory of all the artifacts deployed in the micro integrator in a given environment
+func GetMISnapshot(env string) (*MISnapshot, error) {
+	snapshot := &MISnapshot{
+		Environment: env,
+		CreatedTime: time.Now().UTC().Format(time.RFC3339),
+		Artifacts:   make(map[string][]SnapshotArtifact),
+	}
+	for artifactType, collect := range snapshotCollectors {
+		artifacts, err := collect(env)
+		if err != nil {
+			return nil, fmt.Errorf("getting %s: %v", artifactType, err)
+		}
+		if artifacts == nil {
+			artifacts = []SnapshotArtifact{}
+		}
+		sort.Slice(artifacts, func(i, j int) bool { return artifacts[i].Name < artifacts[j].Name })
+		snapshot.Artifacts[artifactType] = artifacts
+	}
+	return snapshot, nil
+}
+
+// WriteMISnapshot writes the snapshot as JSON to the writer
+func WriteMISnapshot(w io.Writer, snapshot *MISnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// ReadMISnapshot reads a snapshot written by WriteMISnapshot from a file
+func ReadMISnapshot(filePath string) (*MISnapshot, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &MISnapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, errors.New("invalid snapshot " + filePath + ": " + err.Error())
+	}
+	if snapshot.Artifacts == nil {
+		return nil, errors.New("invalid snapshot " + filePath + ": no artifacts found")
+	}
+	return snapshot, nil
+}
+
+// DiffMISnapshots returns the artifacts which are missing, extra or changed in the second snapshot compared to the
+// first, sorted by the artifact type and the name
+func DiffMISnapshots(first, second *MISnapshot) []SnapshotDifference {
+	var differences []SnapshotDifference
+	for _, artifactType := range getSnapshotArtifactTypes(first, second) {
+		secondArtifacts := make(map[string]SnapshotArtifact)
+		for _, artifact := range second.Artifacts[artifactType] {
+			secondArtifacts[artifact.Name] = artifact
+		}
+		for _, artifact := range first.Artifacts[artifactType] {
+			secondArtifact, found := secondArtifacts[artifact.Name]
+			if !found {
+				differences = append(differences, SnapshotDifference{artifactType, artifact.Name,
+					SnapshotDifferenceMissing, ""})
+				continue
+			}
+			delete(secondArtifacts, artifact.Name)
+			if details := diffSnapshotArtifacts(artifact, secondArtifact); details != "" {
+				differences = append(differences, SnapshotDifference{artifactType, artifact.Name,
+					SnapshotDifferenceChanged, details})
+			}
+		}
+		for _, artifact := range second.Artifacts[artifactType] {
+			if _, extra := secondArtifacts[artifact.Name]; extra {
+				differences = append(differences, SnapshotDifference{artifactType, artifact.Name,
+					SnapshotDifferenceExtra, ""})
+			}
+		}
+	}
+	sort.SliceStable(differences, func(i, j int) bool {
+		if differences[i].ArtifactType != differences[j].ArtifactType {
+			return differences[i].ArtifactType < differences[j].ArtifactType
+		}
+		return differences[i].Name < differences[j].Name
+	})
+	return differences
+}
+
+// PrintMISnapshotDiff prints the differences between two snapshots according to the given format
+func PrintMISnapshotDiff(w io.Writer, differences []SnapshotDifference, format string) {
+	if len(differences) == 0 {
+		fmt.Fprintln(w, "No differences found")
+		return
+	}
+	if format == "" {
+		format = defaultSnapshotDiffTableFormat
+	}
+	diffContext := formatter.NewContext(w, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, difference := range differences {
+			if err := t.Execute(w, difference); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	diffTableHeaders := map[string]string{
+		"ArtifactType": typeHeader,
+		"Name":         nameHeader,
+		"Difference":   differenceHeader,
+		"Details":      detailsHeader,
+	}
+	if err := diffContext.Write(renderer, diffTableHeaders); err != nil {
+		fmt.Fprintln(w, "Error executing template:", err.Error())
+	}
+}
+
+func getSnapshotArtifactTypes(snapshots ...*MISnapshot) []string {
+	var artifactTypes []string
+	found := make(map[string]bool)
+	for _, snapshot := range snapshots {
+		for artifactType := range snapshot.Artifacts {
+			if !found[artifactType] {
+				found[artifactType] = true
+				artifactTypes = append(artifactTypes, artifactType)
+			}
+		}
+	}
+	sort.Strings(artifactTypes)
+	return artifactTypes
+}
+
+func diffSnapshotArtifacts(first, second SnapshotArtifact) string {
+	var details []string
+	if first.Version != second.Version {
+		details = append(details, "version: "+first.Version+" -> "+second.Version)
+	}
+	var keys []string
+	for key := range first.State {
+		keys = append(keys, key)
+	}
+	for key := range second.State {
+		if _, found := first.State[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if first.State[key] != second.State[key] {
+			details = append(details, key+": "+first.State[key]+" -> "+second.State[key])
+		}
+	}
+	return strings.Join(details, ", ")
+}
+
+func collectIntegrationAPIs(env string) ([]SnapshotArtifact, error) {
+	list, err := GetIntegrationAPIList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, summary := range list.Apis {
+		api, err := GetIntegrationAPI(env, summary.Name)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, SnapshotArtifact{Name: api.Name, Version: api.Version,
+			State: map[string]string{"context": getIntegrationAPIContext(api.Url), "stats": api.Stats,
+				"tracing": api.Tracing}})
+	}
+	return artifacts, nil
+}
+
+// getIntegrationAPIContext returns the path of the invocation URL of an API. The host and port of the URL differ
+// between the nodes and the environments, hence they are not compared
+func getIntegrationAPIContext(apiUrl string) string {
+	parsedUrl, err := url.Parse(apiUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return apiUrl
+	}
+	return parsedUrl.Path
+}
+
+func collectCompositeApps(env string) ([]SnapshotArtifact, error) {
+	list, err := GetCompositeAppList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, app := range list.CompositeApps {
+		artifacts = append(artifacts, SnapshotArtifact{Name: app.Name, Version: app.Version})
+	}
+	return artifacts, nil
+}
+
+func collectConnectors(env string) ([]SnapshotArtifact, error) {
+	list, err := GetConnectorList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, connector := range list.Connectors {
+		artifacts = append(artifacts, SnapshotArtifact{Name: connector.Name,
+			State: map[string]string{"package": connector.Package, "status": connector.Status}})
+	}
+	return artifacts, nil
+}
+
+func collectDataServices(env string) ([]SnapshotArtifact, error) {
+	list, err := GetDataServiceList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, dataService := range list.List {
+		artifacts = append(artifacts, SnapshotArtifact{Name: dataService.ServiceName})
+	}
+	return artifacts, nil
+}
+
+func collectEndpoints(env string) ([]SnapshotArtifact, error) {
+	list, err := GetEndpointList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, endpoint := range list.Endpoints {
+		artifacts = append(artifacts, SnapshotArtifact{Name: endpoint.Name,
+			State: map[string]string{"type": endpoint.Type, "active": strconv.FormatBool(endpoint.Active)}})
+	}
+	return artifacts, nil
+}
+
+func collectInboundEndpoints(env string) ([]SnapshotArtifact, error) {
+	list, err := GetInboundEndpointList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, summary := range list.InboundEndpoints {
+		inboundEndpoint, err := GetInboundEndpoint(env, summary.Name)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, SnapshotArtifact{Name: inboundEndpoint.Name,
+			State: map[string]string{"protocol": inboundEndpoint.Type, "stats": inboundEndpoint.Stats,
+				"tracing": inboundEndpoint.Tracing}})
+	}
+	return artifacts, nil
+}
+
+func collectLocalEntries(env string) ([]SnapshotArtifact, error) {
+	list, err := GetLocalEntryList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, localEntry := range list.LocalEntries {
+		artifacts = append(artifacts, SnapshotArtifact{Name: localEntry.Name,
+			State: map[string]string{"type": localEntry.Type}})
+	}
+	return artifacts, nil
+}
+
+func collectMessageProcessors(env string) ([]SnapshotArtifact, error) {
+	list, err := GetMessageProcessorList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, messageProcessor := range list.MessageProcessors {
+		artifacts = append(artifacts, SnapshotArtifact{Name: messageProcessor.Name,
+			State: map[string]string{"type": messageProcessor.Type, "status": messageProcessor.Status}})
+	}
+	return artifacts, nil
+}
+
+func collectMessageStores(env string) ([]SnapshotArtifact, error) {
+	list, err := GetMessageStoreList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, messageStore := range list.MessageStores {
+		// the size of a message store changes at runtime and is not considered as a part of its state
+		artifacts = append(artifacts, SnapshotArtifact{Name: messageStore.Name,
+			State: map[string]string{"type": messageStore.Type}})
+	}
+	return artifacts, nil
+}
+
+func collectProxyServices(env string) ([]SnapshotArtifact, error) {
+	list, err := GetProxyServiceList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, summary := range list.Proxies {
+		proxy, err := GetProxyService(env, summary.Name)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, SnapshotArtifact{Name: proxy.Name,
+			State: map[string]string{"stats": proxy.Stats, "tracing": proxy.Tracing}})
+	}
+	return artifacts, nil
+}
+
+func collectSequences(env string) ([]SnapshotArtifact, error) {
+	list, err := GetSequenceList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, sequence := range list.Sequences {
+		artifacts = append(artifacts, SnapshotArtifact{Name: sequence.Name,
+			State: map[string]string{"container": sequence.Container, "stats": sequence.Stats,
+				"tracing": sequence.Tracing}})
+	}
+	return artifacts, nil
+}
+
+func collectTasks(env string) ([]SnapshotArtifact, error) {
+	list, err := GetTaskList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, task := range list.Tasks {
+		artifacts = append(artifacts, SnapshotArtifact{Name: task.Name,
+			State: map[string]string{"triggerType": task.Type, "triggerCount": task.TriggerCount,
+				"triggerInterval": task.TriggerInterval, "cronExpression": task.TriggerCron}})
+	}
+	return artifacts, nil
+}
+
+func collectSequenceTemplates(env string) ([]SnapshotArtifact, error) {
+	list, err := GetTemplateList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, sequenceTemplate := range list.SequenceTemplates {
+		artifacts = append(artifacts, SnapshotArtifact{Name: sequenceTemplate.Name})
+	}
+	return artifacts, nil
+}
+
+func collectEndpointTemplates(env string) ([]SnapshotArtifact, error) {
+	list, err := GetTemplateList(env)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []SnapshotArtifact
+	for _, endpointTemplate := range list.EndpointTemplates {
+		artifacts = append(artifacts, SnapshotArtifact{Name: endpointTemplate.Name})
+	}
+	return artifacts, nil
+}
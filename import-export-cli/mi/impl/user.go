@@ -19,8 +19,11 @@
 package impl
 
 import (
+	"net/http"
+	neturl "net/url"
 	"strings"
 
+	"github.com/go-resty/resty"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -28,15 +31,30 @@ type newUserRequestBody struct {
 	UserID   string `json:"userId"`
 	Password string `json:"password"`
 	IsAdmin  string `json:"isAdmin"`
+	Domain   string `json:"domain,omitempty"`
+}
+
+type updateUserRolesRequestBody struct {
+	UserID       string   `json:"userId"`
+	Domain       string   `json:"domain,omitempty"`
+	AddedRoles   []string `json:"addedRoles"`
+	RemovedRoles []string `json:"removedRoles"`
 }
 
 // AddMIUser adds a new user to the micro integrator in a given environment
 func AddMIUser(env, userName, password, isAdmin string) (interface{}, error) {
+	return AddMIUserToDomain(env, userName, password, isAdmin, "")
+}
+
+// AddMIUserToDomain adds a new user to a user store domain of the micro integrator in a given environment.
+// The primary user store is used if the domain is empty
+func AddMIUserToDomain(env, userName, password, isAdmin, domain string) (string, error) {
 	isAdmin = resolveIsAdmin(isAdmin)
 	body := newUserRequestBody{
 		UserID:   userName,
 		Password: password,
 		IsAdmin:  isAdmin,
+		Domain:   domain,
 	}
 	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementUserResource, env, utils.MainConfigFilePath)
 	return addNewMIUser(env, url, body)
@@ -44,8 +62,69 @@ func AddMIUser(env, userName, password, isAdmin string) (interface{}, error) {
 
 // DeleteMIUser deletes a user from a micro integrator in a given environment
 func DeleteMIUser(env, userName string) (interface{}, error) {
-	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementUserResource, env, utils.MainConfigFilePath) + "/" + userName
-	return deleteMIUser(url, env)
+	return DeleteMIUserFromDomain(env, userName, "")
+}
+
+// DeleteMIUserFromDomain deletes a user from a user store domain of the micro integrator in a given environment
+func DeleteMIUserFromDomain(env, userName, domain string) (string, error) {
+	url := getMIUserURL(env, userName)
+	return deleteMIUser(withDomainQueryParam(url, domain), env)
+}
+
+// UpdateMIUserRoles assigns roles to and removes roles from a user of the micro integrator in a given environment
+func UpdateMIUserRoles(env, userName, domain string, addedRoles, removedRoles []string) (string, error) {
+	body := updateUserRolesRequestBody{
+		UserID:       userName,
+		Domain:       domain,
+		AddedRoles:   addedRoles,
+		RemovedRoles: removedRoles,
+	}
+	if body.AddedRoles == nil {
+		body.AddedRoles = []string{}
+	}
+	if body.RemovedRoles == nil {
+		body.RemovedRoles = []string{}
+	}
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementRoleResource, env, utils.MainConfigFilePath)
+	resp, err := invokePUTRequestWithRetry(env, url, body)
+	return handleUserUpdateResponse(resp, err, url)
+}
+
+// UpdateMIUserPassword changes the password of a user of the micro integrator in a given environment
+func UpdateMIUserPassword(env, userName, domain, newPassword string) (string, error) {
+	body := map[string]string{
+		"newPassword":     newPassword,
+		"confirmPassword": newPassword,
+	}
+	putNonEmptyValueToMap(body, "domain", domain)
+	url := getMIUserURL(env, userName)
+	resp, err := invokePATCHRequestWithRetry(url, body, env)
+	return handleUserUpdateResponse(resp, err, url)
+}
+
+// handleUserUpdateResponse reads the status of a user update, which is returned as "status" or as "Message"
+// depending on the version of the micro integrator
+func handleUserUpdateResponse(resp *resty.Response, err error, url string) (string, error) {
+	status, err := handleResponse(resp, err, url, "status", "Error")
+	if err != nil && resp.StatusCode() == http.StatusOK {
+		if message := unmarshalJSONToStringMap(resp.Body())["Message"]; message != "" {
+			return message, nil
+		}
+	}
+	return status, err
+}
+
+// getMIUserURL returns the URL of a user of the micro integrator in a given environment
+func getMIUserURL(env, userName string) string {
+	return utils.GetMIManagementEndpointOfResource(utils.MiManagementUserResource, env, utils.MainConfigFilePath) +
+		"/" + neturl.PathEscape(userName)
+}
+
+func withDomainQueryParam(url, domain string) string {
+	if domain == "" {
+		return url
+	}
+	return url + "?domain=" + neturl.QueryEscape(domain)
 }
 
 func addNewMIUser(env, url string, body interface{}) (string, error) {
@@ -0,0 +1,196 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultUserProvisioningTableFormat = "table {{.UserId}}\t{{.Domain}}\t{{.Status}}\t{{.Message}}"
+
+	domainHeader  = "DOMAIN"
+	messageHeader = "MESSAGE"
+
+	// UserProvisioningAdded user was added
+	UserProvisioningAdded = "added"
+	// UserProvisioningFailed user could not be added, or its roles could not be assigned
+	UserProvisioningFailed = "failed"
+	// UserProvisioningExists user already exists and was not added
+	UserProvisioningExists = "exists"
+	// UserProvisioningWouldAdd user would be added in a dry run
+	UserProvisioningWouldAdd = "would be added"
+)
+
+// MIUsersFile is the file given to provision users in bulk
+type MIUsersFile struct {
+	Users []MIUserDefinition `yaml:"users"`
+}
+
+// MIUserDefinition is a user to be provisioned
+type MIUserDefinition struct {
+	UserID   string   `yaml:"userId"`
+	Password string   `yaml:"password"`
+	IsAdmin  bool     `yaml:"isAdmin"`
+	Domain   string   `yaml:"domain"`
+	Roles    []string `yaml:"roles"`
+}
+
+// UserProvisioningResult is the result of provisioning a user
+type UserProvisioningResult struct {
+	UserId  string `json:"userId"`
+	Domain  string `json:"domain,omitempty"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ReadMIUsersFile reads the users to be provisioned from a YAML file. Environment variables in the file
+// (eg: $CAPP_TESTER_PASSWORD) are substituted, so that passwords need not be stored in the file
+func ReadMIUsersFile(filePath string) ([]MIUserDefinition, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	content, err := utils.EnvSubstitute(string(data))
+	if err != nil {
+		return nil, err
+	}
+	usersFile := &MIUsersFile{}
+	if err = yaml.UnmarshalStrict([]byte(content), usersFile); err != nil {
+		return nil, errors.New("invalid users file " + filePath + ": " + err.Error())
+	}
+	if len(usersFile.Users) == 0 {
+		return nil, errors.New("no users found in " + filePath)
+	}
+	found := make(map[string]bool)
+	for i, user := range usersFile.Users {
+		if user.UserID == "" || user.Password == "" {
+			return nil, fmt.Errorf("userId and password are required for user %d in %s", i+1, filePath)
+		}
+		key := user.Domain + "/" + user.UserID
+		if found[key] {
+			return nil, errors.New("user " + user.UserID + " is defined more than once in " + filePath)
+		}
+		found[key] = true
+	}
+	return usersFile.Users, nil
+}
+
+// ProvisionMIUsers adds the users which do not exist in the micro integrator in a given environment and assigns
+// their roles. Nothing is changed in a dry run. The result of each user is returned
+func ProvisionMIUsers(env string, users []MIUserDefinition, dryRun bool) []UserProvisioningResult {
+	var results []UserProvisioningResult
+	for _, user := range users {
+		result := UserProvisioningResult{UserId: user.UserID, Domain: user.Domain}
+		exists, err := miUserExists(env, user.UserID, user.Domain)
+		if err != nil {
+			result.Status = UserProvisioningFailed
+			result.Message = "checking whether the user exists failed: " + err.Error()
+		} else if exists {
+			result.Status = UserProvisioningExists
+		} else if dryRun {
+			result.Status = UserProvisioningWouldAdd
+			if len(user.Roles) > 0 {
+				result.Message = "roles: " + strings.Join(user.Roles, ", ")
+			}
+		} else {
+			result.Status, result.Message = provisionMIUser(env, user)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// PrintUserProvisioningResults prints the results of provisioning users according to the given format
+func PrintUserProvisioningResults(w io.Writer, results []UserProvisioningResult, format string) {
+	if format == "" {
+		format = defaultUserProvisioningTableFormat
+	}
+	resultContext := formatter.NewContext(w, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, result := range results {
+			if err := t.Execute(w, result); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	resultTableHeaders := map[string]string{
+		"UserId":  userIDHeader,
+		"Domain":  domainHeader,
+		"Status":  statusHeader,
+		"Message": messageHeader,
+	}
+	if err := resultContext.Write(renderer, resultTableHeaders); err != nil {
+		fmt.Fprintln(w, "Error executing template:", err.Error())
+	}
+}
+
+func provisionMIUser(env string, user MIUserDefinition) (string, string) {
+	isAdmin := "no"
+	if user.IsAdmin {
+		isAdmin = "yes"
+	}
+	if _, err := AddMIUserToDomain(env, user.UserID, user.Password, isAdmin, user.Domain); err != nil {
+		return UserProvisioningFailed, err.Error()
+	}
+	if len(user.Roles) == 0 {
+		return UserProvisioningAdded, ""
+	}
+	if _, err := UpdateMIUserRoles(env, user.UserID, user.Domain, user.Roles, nil); err != nil {
+		return UserProvisioningFailed, "user was added, but assigning roles failed: " + err.Error()
+	}
+	return UserProvisioningAdded, "roles: " + strings.Join(user.Roles, ", ")
+}
+
+// miUserExists checks whether a user exists in the micro integrator. Only a 404 response means that the user does not
+// exist. Other responses, such as server errors or an unknown domain, are returned as errors
+func miUserExists(env, userID, domain string) (bool, error) {
+	params := make(map[string]string)
+	putNonEmptyValueToMap(params, "domain", domain)
+	resp, err := invokeGETRequestWithRetry(getMIUserURL(env, userID), params, env)
+	if err != nil {
+		return false, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	var data map[string]interface{}
+	if json.Unmarshal(resp.Body(), &data) == nil {
+		if message, ok := data["Error"].(string); ok && message != "" {
+			return false, errors.New(message)
+		}
+	}
+	return false, errors.New(resp.Status())
+}
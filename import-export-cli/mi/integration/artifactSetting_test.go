@@ -0,0 +1,62 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/integration/testutils"
+)
+
+const tracingCmd = "tracing"
+
+func TestEnableAndDisableTracingOfAPI(t *testing.T) {
+	testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, "api", validAPIName, "--enable")
+	testutils.ValidateAPITracing(t, config, validAPIName, "enabled")
+
+	testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, "api", validAPIName, "--disable")
+	testutils.ValidateAPITracing(t, config, validAPIName, "disabled")
+}
+
+func TestEnableTracingOfProxyServicesByPattern(t *testing.T) {
+	testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, proxyServiceCmd, "--pattern",
+		"^"+validProxyServiceName+"$", "--enable")
+	testutils.ValidateProxyServiceTracing(t, config, validProxyServiceName, "enabled")
+
+	testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, proxyServiceCmd, "--pattern",
+		"^"+validProxyServiceName+"$", "--disable")
+	testutils.ValidateProxyServiceTracing(t, config, validProxyServiceName, "disabled")
+}
+
+func TestEnableTracingOfProxyServicesByNonMatchingPattern(t *testing.T) {
+	response := testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, proxyServiceCmd, "--pattern",
+		"^"+invalidProxyServiceName+"$", "--enable")
+	assert.Contains(t, response, "No proxy-services found matching ^"+invalidProxyServiceName+"$")
+}
+
+func TestEnableTracingWithoutEnableOrDisable(t *testing.T) {
+	response := testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, "api", validAPIName)
+	assert.Contains(t, response, "exactly one of --enable or --disable is required")
+}
+
+func TestEnableTracingOfUnsupportedArtifactType(t *testing.T) {
+	response := testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, "task", "SampleTask", "--enable")
+	assert.Contains(t, response, "unsupported artifact type task")
+}
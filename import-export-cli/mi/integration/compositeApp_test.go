@@ -59,3 +59,25 @@ func TestGetCAppsWithoutEnvFlag(t *testing.T) {
 func TestGetCAppsWithInvalidArgs(t *testing.T) {
 	testutils.ExecGetCommandWithInvalidArgCount(t, config, 1, 2, false, cAppCmd, validCAppName, invalidCAppName)
 }
+
+const deployableCAppName = "RESTDataServiceCompositeExporter"
+const deployableCAppFile = "testdata/capps/" + deployableCAppName + "_1.0.0.car"
+
+func TestUndeployAndDeployCApp(t *testing.T) {
+	response := testutils.ExecCAppCommand(t, config, "undeploy", deployableCAppName)
+	assert.Contains(t, response, "Undeploying carbon application [ "+deployableCAppName+" ] status:")
+
+	response = testutils.ExecCAppCommand(t, config, "deploy", deployableCAppFile, "--wait", "--timeout", "120")
+	assert.Contains(t, response, "Carbon application [ "+deployableCAppName+" ] deployed")
+	assert.True(t, testutils.IsCAppDeployed(config, deployableCAppName))
+}
+
+func TestDeployNonExistingCAppFile(t *testing.T) {
+	response := testutils.ExecCAppCommand(t, config, "deploy", "testdata/capps/invalid.car")
+	assert.Contains(t, response, "Error reading carbon applications")
+}
+
+func TestUndeployNonExistingCApp(t *testing.T) {
+	response := testutils.ExecCAppCommand(t, config, "undeploy", invalidCAppName)
+	assert.Contains(t, response, "Error undeploying carbon application [ "+invalidCAppName+" ]")
+}
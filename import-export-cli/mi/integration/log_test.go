@@ -59,3 +59,28 @@ func TestGetLogsWithoutEnvFlag(t *testing.T) {
 func TestGetLogsWithInvalidArgs(t *testing.T) {
 	testutils.ExecGetCommandWithInvalidArgCount(t, config, 1, 2, false, logsCmd, validLogFileName, invalidLogFileName)
 }
+
+func TestTailLogs(t *testing.T) {
+	response, _ := testutils.TailLogFile(t, config)
+	base.Log(response)
+	assert.Contains(t, response, "INFO")
+}
+
+func TestTailLogsWithLevel(t *testing.T) {
+	response, _ := testutils.TailLogFile(t, config, "--level", "ERROR")
+	base.Log(response)
+	assert.NotContains(t, response, "] INFO ")
+	assert.NotContains(t, response, "]  INFO ")
+}
+
+func TestTailLogsWithPattern(t *testing.T) {
+	response, _ := testutils.TailLogFile(t, config, "--grep", "WSO2 Micro Integrator started")
+	base.Log(response)
+	assert.Contains(t, response, "WSO2 Micro Integrator started")
+}
+
+func TestTailNonExistingLogFile(t *testing.T) {
+	response, _ := testutils.TailLogFile(t, config, invalidLogFileName)
+	base.Log(response)
+	assert.Contains(t, response, "Error getting the log file "+invalidLogFileName)
+}
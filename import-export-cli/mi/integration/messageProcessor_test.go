@@ -112,3 +112,10 @@ func TestDeactivateMessageProcessorWithoutSettingUpEnv(t *testing.T) {
 func TestDeactivateMessageProcessorWithoutLogin(t *testing.T) {
 	testutils.ExecDeactivateCommandWithoutLogin(t, config, messageProcessorCmd, validMessageProcessor)
 }
+
+func TestPauseMessageProcessor(t *testing.T) {
+	response := testutils.ExecPauseMessageProcessorCommand(t, config, validMessageProcessor, "2s")
+	assert.Contains(t, response, validMessageProcessor+" : is deactivated")
+	assert.Contains(t, response, validMessageProcessor+" : is activated")
+	assert.Equal(t, "active", testutils.GetMessageProcessorStatus(config, validMessageProcessor))
+}
@@ -19,6 +19,7 @@
 package integration
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,3 +60,9 @@ func TestGetMessageStoresWithoutEnvFlag(t *testing.T) {
 func TestGetMessageStoresWithInvalidArgs(t *testing.T) {
 	testutils.ExecGetCommandWithInvalidArgCount(t, config, 1, 2, false, messageStoreCmd, validMessageStore, invalidMessageStore)
 }
+
+func TestWatchMessageStore(t *testing.T) {
+	response := testutils.ExecWatchMessageStoreCommand(t, config, "2", validMessageStore)
+	assert.Contains(t, response, "CHANGE")
+	assert.Equal(t, 2, strings.Count(response, validMessageStore))
+}
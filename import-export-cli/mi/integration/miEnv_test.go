@@ -81,3 +81,31 @@ func TestLoginToMIWithInvalidArgs(t *testing.T) {
 	base.Log(response)
 	assert.Contains(t, response, "accepts 1 arg(s), received 2")
 }
+
+func TestLoginToMIWithoutStoringPassword(t *testing.T) {
+	base.SetupMIEnv(t, miClient.GetEnvName(), miClient.GetMiURL())
+	response, _ := base.Execute(t, "mi", "login", miClient.GetEnvName(), "-u", testutils.AdminUserName, "-p", testutils.AdminPassword,
+		"--store-password=false", "-k")
+	base.Log(response)
+	t.Cleanup(func() {
+		base.Execute(t, "mi", "logout", miClient.GetEnvName(), "-k")
+	})
+	assert.Contains(t, response, fmt.Sprintf("Logged into MI in %v environment", miClient.GetEnvName()))
+	response, _ = base.Execute(t, "mi", "get", userCmd, "-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, testutils.AdminUserName)
+}
+
+func TestAddEnvironmentWithMIClientCertificateWithoutKey(t *testing.T) {
+	response, _ := base.Execute(t, "add", "env", miClient.GetEnvName(), "--mi", miClient.GetMiURL(),
+		"--mi-client-cert", "testdata/client.crt", "-k")
+	base.Log(response)
+	assert.Contains(t, response, "Both the Micro Integrator client certificate and its key are required")
+}
+
+func TestAddEnvironmentWithInvalidMIClientCertificate(t *testing.T) {
+	response, _ := base.Execute(t, "add", "env", miClient.GetEnvName(), "--mi", miClient.GetMiURL(),
+		"--mi-client-cert", "testdata/missing.crt", "--mi-client-key", "testdata/missing.key", "-k")
+	base.Log(response)
+	assert.Contains(t, response, "Invalid Micro Integrator client certificate")
+}
@@ -0,0 +1,55 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/integration/testutils"
+)
+
+func TestSnapshot(t *testing.T) {
+	snapshot, err := impl.ReadMISnapshot(testutils.ExecSnapshotCommand(t, config))
+	assert.Nil(t, err)
+	assert.NotEmpty(t, snapshot.Artifacts["composite-apps"])
+	assert.NotEmpty(t, snapshot.Artifacts["apis"])
+}
+
+func TestDiffSnapshotWithEnvironment(t *testing.T) {
+	snapshotFile := testutils.ExecSnapshotCommand(t, config)
+	response := testutils.ExecDiffCommand(t, config, snapshotFile, "--env", miClient.GetEnvName())
+	assert.Contains(t, response, "No differences found")
+}
+
+func TestDiffSnapshotAfterUpdatingTracing(t *testing.T) {
+	snapshotFile := testutils.ExecSnapshotCommand(t, config)
+	testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, "api", validAPIName, "--enable")
+	defer testutils.ExecUpdateArtifactSettingCommand(t, config, tracingCmd, "api", validAPIName, "--disable")
+
+	response := testutils.ExecDiffCommand(t, config, snapshotFile, "--env", miClient.GetEnvName())
+	assert.Contains(t, response, validAPIName)
+	assert.Contains(t, response, "tracing: disabled -> enabled")
+}
+
+func TestDiffWithoutTwoSources(t *testing.T) {
+	response := testutils.ExecDiffCommand(t, config, "--env", miClient.GetEnvName())
+	assert.Contains(t, response, "Two snapshots or environments should be given to compare")
+}
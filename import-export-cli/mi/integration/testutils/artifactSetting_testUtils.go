@@ -0,0 +1,55 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package testutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ExecUpdateArtifactSettingCommand run update setting artifactType args and return ctl output
+func ExecUpdateArtifactSettingCommand(t *testing.T, config *MiConfig, setting, artifactType string, args ...string) string {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	updateCmdArgs := []string{"mi", "update", setting, artifactType, "-e", config.MIClient.GetEnvName(), "-k"}
+	updateCmdArgs = append(updateCmdArgs, args...)
+	response, _ := base.Execute(t, updateCmdArgs...)
+	base.Log(response)
+	return response
+}
+
+// ValidateAPITracing validate the tracing of an API from the Management API
+func ValidateAPITracing(t *testing.T, config *MiConfig, apiName, expected string) {
+	t.Helper()
+	artifact := config.MIClient.GetArtifactFromAPI(utils.MiManagementAPIResource, getParamMap("apiName", apiName),
+		&artifactutils.IntegrationAPI{})
+	assert.Equal(t, expected, artifact.(*artifactutils.IntegrationAPI).Tracing)
+}
+
+// ValidateProxyServiceTracing validate the tracing of a proxy service from the Management API
+func ValidateProxyServiceTracing(t *testing.T, config *MiConfig, proxyName, expected string) {
+	t.Helper()
+	artifact := config.MIClient.GetArtifactFromAPI(utils.MiManagementProxyServiceResource,
+		getParamMap("proxyServiceName", proxyName), &artifactutils.Proxy{})
+	assert.Equal(t, expected, artifact.(*artifactutils.Proxy).Tracing)
+}
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -61,3 +62,25 @@ func calidateCAppEqual(t *testing.T, CAppsListFromCtl string, cApp *artifactutil
 		assert.Contains(t, CAppsListFromCtl, artifact.Type)
 	}
 }
+
+// ExecCAppCommand run deploy/undeploy capp with the given args and return ctl output
+func ExecCAppCommand(t *testing.T, config *MiConfig, operation string, args ...string) string {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	cAppCmdArgs := []string{"mi", operation, "capp", "-e", config.MIClient.GetEnvName(), "-k"}
+	cAppCmdArgs = append(cAppCmdArgs, args...)
+	response, _ := base.Execute(t, cAppCmdArgs...)
+	base.Log(response)
+	return response
+}
+
+// IsCAppDeployed check whether the capp is listed by the Management API
+func IsCAppDeployed(config *MiConfig, cAppName string) bool {
+	artifactList := config.MIClient.GetArtifactListFromAPI(utils.MiManagementCarbonAppResource, &artifactutils.CompositeAppList{})
+	for _, cApp := range artifactList.(*artifactutils.CompositeAppList).CompositeApps {
+		if cApp.Name == cAppName {
+			return true
+		}
+	}
+	return false
+}
@@ -67,3 +67,12 @@ func filterOnlyLogFiles(logFileList *artifactutils.LogFileList) *artifactutils.L
 	filteredList.Count = int32(len(filteredList.LogFiles))
 	return filteredList
 }
+
+// TailLogFile return ctl output from the command mi logs
+func TailLogFile(t *testing.T, config *MiConfig, args ...string) (string, error) {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	logsCmdArgs := []string{"mi", "logs", "-e", config.MIClient.GetEnvName(), "-k"}
+	logsCmdArgs = append(logsCmdArgs, args...)
+	return base.Execute(t, logsCmdArgs...)
+}
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -63,3 +64,20 @@ func validateMessageProcessorEqual(t *testing.T, messageProcessorFromCtl string,
 		assert.Contains(t, messageProcessorFromCtl, value)
 	}
 }
+
+// ExecPauseMessageProcessorCommand run deactivate message-processor with --for and return ctl output
+func ExecPauseMessageProcessorCommand(t *testing.T, config *MiConfig, messageProcessorName, duration string) string {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "deactivate", "message-processor", messageProcessorName, "-e",
+		config.MIClient.GetEnvName(), "--for", duration, "-k")
+	base.Log(response)
+	return response
+}
+
+// GetMessageProcessorStatus get the status of the message processor from the Management API
+func GetMessageProcessorStatus(config *MiConfig, messageProcessorName string) string {
+	artifact := config.MIClient.GetArtifactFromAPI(utils.MiManagementMessageProcessorResource,
+		getParamMap("messageProcessorName", messageProcessorName), &artifactutils.MessageProcessorData{})
+	return artifact.(*artifactutils.MessageProcessorData).Status
+}
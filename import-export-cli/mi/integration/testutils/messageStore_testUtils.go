@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -66,3 +67,14 @@ func validateMessageStoreEqual(t *testing.T, messageStoreFromCtl string, message
 		assert.Contains(t, messageStoreFromCtl, value)
 	}
 }
+
+// ExecWatchMessageStoreCommand run get message-stores with --watch for count polls and return ctl output
+func ExecWatchMessageStoreCommand(t *testing.T, config *MiConfig, count string, args ...string) string {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	watchCmdArgs := []string{"mi", "get", "message-stores", "-e", config.MIClient.GetEnvName(), "--watch",
+		"--interval", "1s", "--count", count, "-k"}
+	response, _ := base.Execute(t, append(watchCmdArgs, args...)...)
+	base.Log(response)
+	return response
+}
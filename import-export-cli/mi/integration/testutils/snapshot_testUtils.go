@@ -0,0 +1,47 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package testutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+)
+
+// ExecSnapshotCommand run mi snapshot and return the path of the written snapshot
+func ExecSnapshotCommand(t *testing.T, config *MiConfig) string {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	snapshotFile := filepath.Join(os.TempDir(), t.Name()+"_snapshot.json")
+	response, _ := base.Execute(t, "mi", "snapshot", "-e", config.MIClient.GetEnvName(), "-o", snapshotFile, "-k")
+	base.Log(response)
+	return snapshotFile
+}
+
+// ExecDiffCommand run mi diff with the given args and return ctl output
+func ExecDiffCommand(t *testing.T, config *MiConfig, args ...string) string {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	diffCmdArgs := append([]string{"mi", "diff", "-k"}, args...)
+	response, _ := base.Execute(t, diffCmdArgs...)
+	base.Log(response)
+	return response
+}
@@ -56,3 +56,14 @@ func ExecGetTransactionCountWithInvalidArgCount(t *testing.T, config *MiConfig,
 	expected := fmt.Sprintf("accepts exactly 0 or 2 arg(s), received %v", passed)
 	assert.Contains(t, response, expected)
 }
+
+// ExecGetTransactionReport run get transaction-reports with the given args
+func ExecGetTransactionReport(t *testing.T, config *MiConfig, args ...string) (string, error) {
+	t.Helper()
+	SetupAndLoginToMI(t, config)
+	getCmdArgs := []string{"mi", "get", "transaction-reports", "-e", config.MIClient.GetEnvName(), "-k"}
+	getCmdArgs = append(getCmdArgs, args...)
+	response, err := base.Execute(t, getCmdArgs...)
+	base.Log(response)
+	return response, err
+}
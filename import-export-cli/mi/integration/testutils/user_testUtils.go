@@ -112,3 +112,20 @@ func removeUserFromAPI(config *MiConfig, userName string) {
 	defer response.Body.Close()
 	base.ValidateAndLogResponse("mi.removeUserFromAPI()", response, 200)
 }
+
+// ExecAddUsersFromFile runs the mi add users command with the given users file
+func ExecAddUsersFromFile(t *testing.T, config *MiConfig, usersFile string, args ...string) (string, error) {
+	SetupAndLoginToMI(t, config)
+	cmdArgs := []string{"mi", "add", "users", "--from-file", usersFile, "-e", config.MIClient.GetEnvName(), "-k"}
+	cmdArgs = append(cmdArgs, args...)
+	output, err := base.Execute(t, cmdArgs...)
+	base.Log(output)
+	return output, err
+}
+
+// RemoveUserFromAPIOnCleanup removes a user using the MI Management API at the end of the test
+func RemoveUserFromAPIOnCleanup(t *testing.T, config *MiConfig, userName string) {
+	t.Cleanup(func() {
+		removeUserFromAPI(config, userName)
+	})
+}
@@ -19,8 +19,12 @@
 package integration
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/integration/testutils"
 )
 
@@ -45,3 +49,26 @@ func TestGetTransactionsWithoutEnvFlag(t *testing.T) {
 func TestGetTransactionsWithInvalidArgs(t *testing.T) {
 	testutils.ExecGetTransactionCountWithInvalidArgCount(t, config, 1, transactionCountCmd, "2020")
 }
+
+func TestGetMonthlyTransactionReportAsJSON(t *testing.T) {
+	response, err := testutils.ExecGetTransactionReport(t, config, "2020-01", "--aggregate", "monthly", "--format", "json")
+	assert.Nil(t, err)
+	assert.Contains(t, response, `"aggregation": "monthly"`)
+	assert.Contains(t, response, `"total":`)
+}
+
+func TestGetDailyTransactionReportToFile(t *testing.T) {
+	reportPath := filepath.Join(os.TempDir(), t.Name()+".yaml")
+	defer os.Remove(reportPath)
+	response, err := testutils.ExecGetTransactionReport(t, config, "2020-01", "--aggregate", "daily", "-o", reportPath)
+	assert.Nil(t, err)
+	assert.Contains(t, response, "Transaction Count Report created in "+reportPath)
+	report, err := ioutil.ReadFile(reportPath)
+	assert.Nil(t, err)
+	assert.Contains(t, string(report), "aggregation: daily")
+}
+
+func TestGetTransactionReportWithInvalidAggregation(t *testing.T) {
+	response, _ := testutils.ExecGetTransactionReport(t, config, "2020-01", "--aggregate", "weekly")
+	assert.Contains(t, response, "invalid aggregation weekly")
+}
@@ -19,6 +19,7 @@
 package integration
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -137,3 +138,57 @@ func TestDeleteUser(t *testing.T) {
 	expected := "Deleting user [ " + newUserName + " ] status: Deleted"
 	assert.Contains(t, response, expected)
 }
+
+const usersFile = "testdata/users/users.yaml"
+
+func TestAddUsersFromFileDryRun(t *testing.T) {
+	os.Setenv("BULK_TESTER_PASSWORD", "tester-password")
+	os.Setenv("BULK_ADMIN_PASSWORD", "admin-password")
+	response, _ := testutils.ExecAddUsersFromFile(t, config, usersFile, "--dry-run")
+	assert.Contains(t, response, "bulk-tester")
+	assert.Contains(t, response, "would be added")
+	userList, _ := testutils.ListArtifacts(t, userCmd, config)
+	assert.NotContains(t, userList, "bulk-tester")
+}
+
+func TestAddUsersFromFile(t *testing.T) {
+	os.Setenv("BULK_TESTER_PASSWORD", "tester-password")
+	os.Setenv("BULK_ADMIN_PASSWORD", "admin-password")
+	testutils.RemoveUserFromAPIOnCleanup(t, config, "bulk-tester")
+	testutils.RemoveUserFromAPIOnCleanup(t, config, "bulk-admin")
+	response, err := testutils.ExecAddUsersFromFile(t, config, usersFile)
+	assert.Nil(t, err)
+	assert.Contains(t, response, "added")
+	testutils.ValidateUser(t, userCmd, config, "bulk-admin")
+
+	response, _ = testutils.ExecAddUsersFromFile(t, config, usersFile)
+	assert.Contains(t, response, "exists")
+}
+
+func TestAddUsersFromMissingFile(t *testing.T) {
+	response, _ := testutils.ExecAddUsersFromFile(t, config, "testdata/users/missing.yaml")
+	assert.Contains(t, response, "missing.yaml")
+}
+
+func TestUpdateUserRolesWithoutRoles(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "update", "user-roles", validUserName, "-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, "At least one of --add or --remove is required")
+}
+
+func TestUpdateUserRolesOfNonExistingUser(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "update", "user-roles", invalidUserName, "--add", "admin",
+		"-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, "Error updating the roles of user [ "+invalidUserName+" ]")
+}
+
+func TestDeleteUserWithInvalidDomain(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "delete", "user", newUserName, "--domain", "INVALID-DOMAIN",
+		"-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, "[ERROR]: deleting user [ "+newUserName+" ]")
+}
@@ -0,0 +1,71 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/integration/testutils"
+)
+
+const vaultCmd = "vaults"
+const hashiCorpVaultName = "hashicorp"
+
+func TestGetVaultsWithoutSettingUpEnv(t *testing.T) {
+	testutils.ExecGetCommandWithoutSettingEnv(t, vaultCmd)
+}
+
+func TestGetVaultsWithoutLogin(t *testing.T) {
+	testutils.ExecGetCommandWithoutLogin(t, vaultCmd, config)
+}
+
+func TestGetVaultsWithoutEnvFlag(t *testing.T) {
+	testutils.ExecGetCommandWithoutEnvFlag(t, vaultCmd, config)
+}
+
+func TestUpdateVaultWithoutEnvFlag(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "update", "vault", hashiCorpVaultName, "--role-id", "role", "-k")
+	base.Log(response)
+	assert.Contains(t, response, `required flag(s) "environment" not set`)
+}
+
+func TestUpdateVaultWithoutValues(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "update", "vault", hashiCorpVaultName, "-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, "At least one of --role-id, --secret-id-file or --token-file is required")
+}
+
+func TestUpdateUnsupportedVault(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "update", "vault", "abc-vault", "--role-id", "role", "-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, "Updating the external vault abc-vault is not supported")
+}
+
+func TestUpdateVaultWithMissingSecretIDFile(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	response, _ := base.Execute(t, "mi", "update", "vault", hashiCorpVaultName, "--secret-id-file", "testdata/missing-secret-id",
+		"-e", miClient.GetEnvName(), "-k")
+	base.Log(response)
+	assert.Contains(t, response, "Error reading testdata/missing-secret-id")
+}
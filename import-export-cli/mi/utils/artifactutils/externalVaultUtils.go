@@ -0,0 +1,43 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package artifactutils
+
+// ExternalVaultList is the list of external vaults of which the configuration can be updated through the management
+// API of a micro integrator
+type ExternalVaultList struct {
+	Count  int32           `json:"count"`
+	Vaults []ExternalVault `json:"list"`
+}
+
+// ExternalVault holds the status of an external vault as seen through the management API of a micro integrator. The
+// management API does not expose the connection state of the vault, hence the status shows whether the
+// configuration of the vault can be updated through the management API
+type ExternalVault struct {
+	Name            string   `json:"name"`
+	UpdatableFields []string `json:"updatableFields"`
+	ServerVersion   string   `json:"serverVersion"`
+	Status          string   `json:"status"`
+	Message         string   `json:"message"`
+}
+
+// ServerSummary is the information about a micro integrator returned by the server resource of the management API
+type ServerSummary struct {
+	ProductName    string `json:"productName"`
+	ProductVersion string `json:"productVersion"`
+}
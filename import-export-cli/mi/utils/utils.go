@@ -19,7 +19,12 @@
 package utils
 
 import (
+	"bufio"
+	"fmt"
 	"strings"
+	"syscall"
+
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 // GetTrimmedCmdLiteral returns the command without the arguments
@@ -27,3 +32,21 @@ func GetTrimmedCmdLiteral(cmd string) string {
 	cmdParts := strings.Fields(cmd)
 	return cmdParts[0]
 }
+
+// IsInteractive returns true if the standard input is a terminal
+func IsInteractive() bool {
+	return terminal.IsTerminal(int(syscall.Stdin))
+}
+
+// ReadPassword prompts for a password and reads it without echoing when the standard input is a terminal.
+// Otherwise the password is read as a line from the reader without prompting
+func ReadPassword(reader *bufio.Reader, prompt string) string {
+	if !IsInteractive() {
+		password, _ := reader.ReadString('\n')
+		return strings.TrimRight(password, "\r\n")
+	}
+	fmt.Print(prompt)
+	bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println()
+	return string(bytePassword)
+}
@@ -19,10 +19,15 @@
 package olm
 
 import (
+	"context"
 	"fmt"
+	"time"
+
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
-	"time"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // installOLM installs Operator Lifecycle Manager (OLM) with the given version
@@ -50,20 +55,30 @@ func InstallOLM(version string) {
 	}
 
 	// rolling out
-	if err := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sRollOut, "status", "-w", "deployment/olm-operator", "-n", olmNamespace); err != nil {
+	if err := k8sUtils.K8sWaitForDeployment("olm-operator", olmNamespace, rolloutTimeoutSec); err != nil {
 		utils.HandleErrorAndExit("Error installing OLM: Rolling out deployment OLM Operator", err)
 	}
-	if err := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sRollOut, "status", "-w", "deployment/catalog-operator", "-n", olmNamespace); err != nil {
+	if err := k8sUtils.K8sWaitForDeployment("catalog-operator", olmNamespace, rolloutTimeoutSec); err != nil {
 		utils.HandleErrorAndExit("Error installing OLM: Rolling out deployment Catalog Operator", err)
 	}
 
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error installing OLM", err)
+	}
+	csvResource := client.Dynamic.Resource(OperatorCsvGVR).Namespace(olmNamespace)
+
 	// wait max 50s to csv phase to be succeeded
 	csvPhase := ""
 	for i := 50; i > 0 && csvPhase != csvPhaseSucceeded; i-- {
-		newCsvPhase, err := k8sUtils.GetCommandOutput(k8sUtils.Kubectl, k8sUtils.K8sGet, OperatorCsv, "-n", olmNamespace, "packageserver", "-o", `jsonpath={.status.phase}`)
-		if err != nil {
+		newCsvPhase := ""
+		csv, err := csvResource.Get(context.TODO(), "packageserver", metav1.GetOptions{})
+		if err != nil && !k8sErrors.IsNotFound(err) {
 			utils.HandleErrorAndExit("Error installing OLM: Getting csv phase", err)
 		}
+		if csv != nil {
+			newCsvPhase, _, _ = unstructured.NestedString(csv.Object, "status", "phase")
+		}
 
 		// only print new phase
 		if csvPhase != newCsvPhase {
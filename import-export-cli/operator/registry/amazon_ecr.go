@@ -45,7 +45,7 @@ var AmazonEcrRegistry = &Registry{
 	Run: func(reg *Registry) {
 		createAmazonEcrConfig()
 		k8sUtils.K8sCreateSecretFromFile(
-			k8sUtils.AwsCredentialsSecret, k8sUtils.ApiOpWso2Namespace,
+			k8sUtils.AwsCredentialsSecret, operatorNamespace,
 			reg.Repository.KeyFile, k8sUtils.AwsCredentialsFile,
 		)
 	},
@@ -107,21 +107,12 @@ func createAmazonEcrConfig() {
 	}
 	defer os.Remove(tempFile)
 
-	// render config map
-	configMap, err := k8sUtils.GetCommandOutput(
-		k8sUtils.Kubectl, k8sUtils.K8sCreate, k8sUtils.ConfigMap,
-		k8sUtils.AmazonCredHelperConfMap, "--from-file=config.json="+tempFile,
-		"-n", k8sUtils.ApiOpWso2Namespace,
-		"--dry-run", "-o", "yaml",
-	)
+	// create or update config map
+	err = k8sUtils.K8sCreateConfigMapFromFile(k8sUtils.AmazonCredHelperConfMap, operatorNamespace,
+		tempFile, "config.json")
 	if err != nil {
 		utils.HandleErrorAndExit("Error creating docker config for Amazon ECR", err)
 	}
-
-	// apply config map
-	if err = k8sUtils.K8sApplyFromStdin(configMap); err != nil {
-		utils.HandleErrorAndExit("Error creating docker config for Amazon ECR", err)
-	}
 }
 
 func init() {
@@ -0,0 +1,87 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// AzureAcrRegistry represents Azure Container Registry authenticated with a service principal
+var AzureAcrRegistry = &Registry{
+	Name:       "AZURE_ACR",
+	ConfigType: HttpsRegistry.Name,
+	Caption:    "Azure Container Registry",
+	Repository: Repository{},
+	Option:     7,
+	Read: func(reg *Registry, flagValues *map[string]FlagValue) {
+		var repository, appId, password string
+
+		// check input mode: interactive or batch
+		if flagValues == nil {
+			// get inputs in interactive mode
+			repository, appId, password = readCredentialsInteractive(
+				"Enter repository (eg: myregistry.azurecr.io/wso2)", "Enter service principal application ID",
+				"Enter service principal password", validateAcrRepository)
+		} else {
+			// get inputs in batch mode
+			repository, appId, password = readCredentialsFromFlags(flagValues)
+			if err := validateAcrRepository(repository); err != nil {
+				utils.HandleErrorAndExit("Invalid repository for Azure Container Registry", err)
+			}
+		}
+
+		reg.Repository.Name = repository
+		reg.Repository.ServerUrl = getRegistryUrl(repository)
+		reg.Repository.Username = appId
+		reg.Repository.Password = password
+	},
+	Run: func(reg *Registry) {
+		// secret is used for both pushing the image by kaniko and pulling the image from the cluster
+		k8sUtils.K8sCreateSecretFromInputs(
+			k8sUtils.DockerRegCredSecret, operatorNamespace,
+			reg.Repository.ServerUrl, reg.Repository.Username, reg.Repository.Password,
+		)
+		reg.Repository.Password = "" // clear password
+	},
+	Flags: Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmUsername: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmPassword: true, k8sUtils.FlagBmPasswordStdin: true},
+	},
+}
+
+// validateAcrRepository validates the repository is prefixed with an ACR login server, eg: myregistry.azurecr.io/wso2
+func validateAcrRepository(repository string) error {
+	names := strings.SplitN(repository, "/", 2)
+	if len(names) != 2 || names[1] == "" {
+		return errors.New("repository should be in the format <registry-name>.azurecr.io/<repository>")
+	}
+	if !strings.Contains(names[0], ".azurecr.") {
+		return fmt.Errorf("%q is not an Azure Container Registry login server", names[0])
+	}
+	return nil
+}
+
+func init() {
+	add(AzureAcrRegistry)
+}
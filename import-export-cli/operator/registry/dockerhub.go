@@ -64,7 +64,7 @@ var DockerHubRegistry = &Registry{
 		reg.Repository.Password = password
 	},
 	Run: func(reg *Registry) {
-		k8sUtils.K8sCreateSecretFromInputs(k8sUtils.DockerRegCredSecret, k8sUtils.ApiOpWso2Namespace,
+		k8sUtils.K8sCreateSecretFromInputs(k8sUtils.DockerRegCredSecret, operatorNamespace,
 			reg.Repository.ServerUrl, reg.Repository.Username, reg.Repository.Password)
 		reg.Repository.Password = "" // clear password
 	},
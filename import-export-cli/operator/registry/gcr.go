@@ -59,9 +59,9 @@ var GcrRegistry = &Registry{
 			utils.HandleErrorAndExit("Error reading GCR service account key json file", err)
 		}
 
-		k8sUtils.K8sCreateSecretFromFile(k8sUtils.GcrSvcAccKeySecret, k8sUtils.ApiOpWso2Namespace,
+		k8sUtils.K8sCreateSecretFromFile(k8sUtils.GcrSvcAccKeySecret, operatorNamespace,
 			reg.Repository.KeyFile, k8sUtils.GcrSvcAccKeyFile)
-		k8sUtils.K8sCreateSecretFromInputs(k8sUtils.GcrPullSecret, k8sUtils.ApiOpWso2Namespace,
+		k8sUtils.K8sCreateSecretFromInputs(k8sUtils.GcrPullSecret, operatorNamespace,
 			"gcr.io", "_json_key", string(data))
 	},
 	Flags: Flags{
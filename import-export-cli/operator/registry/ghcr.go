@@ -0,0 +1,80 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"strings"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+)
+
+// ghcrServerUrl is the URL of GitHub Container Registry
+const ghcrServerUrl = "ghcr.io"
+
+// GhcrRegistry represents GitHub Container Registry authenticated with a personal access token
+var GhcrRegistry = &Registry{
+	Name:       "GHCR",
+	ConfigType: HttpsRegistry.Name,
+	Caption:    "GitHub Container Registry",
+	Repository: Repository{ServerUrl: ghcrServerUrl},
+	Option:     9,
+	Read: func(reg *Registry, flagValues *map[string]FlagValue) {
+		var repository, username, token string
+
+		// check input mode: interactive or batch
+		if flagValues == nil {
+			// get inputs in interactive mode
+			repository, username, token = readCredentialsInteractive("Enter repository (eg: ghcr.io/wso2)",
+				"Enter GitHub username", "Enter personal access token", func(string) error { return nil })
+		} else {
+			// get inputs in batch mode
+			repository, username, token = readCredentialsFromFlags(flagValues)
+		}
+
+		reg.Repository.Name = ghcrRepository(repository)
+		reg.Repository.Username = username
+		reg.Repository.Password = token
+	},
+	Run: func(reg *Registry) {
+		// secret is used for both pushing the image by kaniko and pulling the image from the cluster
+		k8sUtils.K8sCreateSecretFromInputs(
+			k8sUtils.DockerRegCredSecret, operatorNamespace,
+			reg.Repository.ServerUrl, reg.Repository.Username, reg.Repository.Password,
+		)
+		reg.Repository.Password = "" // clear password
+	},
+	Flags: Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmUsername: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmPassword: true, k8sUtils.FlagBmPasswordStdin: true},
+	},
+}
+
+// ghcrRepository returns the repository prefixed with "ghcr.io/". Owner names are lower cased since
+// GitHub Container Registry only accepts lower case image names
+func ghcrRepository(repository string) string {
+	repository = strings.ToLower(strings.Trim(repository, "/"))
+	if strings.HasPrefix(repository, ghcrServerUrl+"/") {
+		return repository
+	}
+	return ghcrServerUrl + "/" + repository
+}
+
+func init() {
+	add(GhcrRegistry)
+}
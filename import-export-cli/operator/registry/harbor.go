@@ -0,0 +1,94 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"errors"
+	"strings"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// harborRobotPrefix is the prefix of the names of Harbor robot accounts
+const harborRobotPrefix = "robot$"
+
+// HarborRegistry represents a Harbor registry authenticated with a robot account
+var HarborRegistry = &Registry{
+	Name:       "HARBOR",
+	ConfigType: HttpsRegistry.Name,
+	Caption:    "Harbor",
+	Repository: Repository{},
+	Option:     8,
+	Read: func(reg *Registry, flagValues *map[string]FlagValue) {
+		var repository, robotName, token string
+
+		// check input mode: interactive or batch
+		if flagValues == nil {
+			// get inputs in interactive mode
+			repository, robotName, token = readCredentialsInteractive(
+				"Enter repository with project (eg: harbor.example.com/wso2)", "Enter robot account name",
+				"Enter robot account token", validateHarborRepository)
+		} else {
+			// get inputs in batch mode
+			repository, robotName, token = readCredentialsFromFlags(flagValues)
+			if err := validateHarborRepository(repository); err != nil {
+				utils.HandleErrorAndExit("Invalid repository for Harbor", err)
+			}
+		}
+
+		reg.Repository.Name = strings.TrimSuffix(repository, "/")
+		reg.Repository.ServerUrl = getRegistryUrl(repository)
+		reg.Repository.Username = harborRobotName(robotName)
+		reg.Repository.Password = token
+	},
+	Run: func(reg *Registry) {
+		// secret is used for both pushing the image by kaniko and pulling the image from the cluster
+		k8sUtils.K8sCreateSecretFromInputs(
+			k8sUtils.DockerRegCredSecret, operatorNamespace,
+			reg.Repository.ServerUrl, reg.Repository.Username, reg.Repository.Password,
+		)
+		reg.Repository.Password = "" // clear password
+	},
+	Flags: Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmUsername: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmPassword: true, k8sUtils.FlagBmPasswordStdin: true},
+	},
+}
+
+// validateHarborRepository validates the repository contains the Harbor host and the project, eg: harbor.example.com/wso2
+func validateHarborRepository(repository string) error {
+	names := strings.SplitN(strings.TrimSuffix(repository, "/"), "/", 2)
+	if len(names) != 2 || names[0] == "" || names[1] == "" {
+		return errors.New("repository should be in the format <harbor-host>/<project>")
+	}
+	return nil
+}
+
+// harborRobotName returns the robot account name prefixed with "robot$"
+func harborRobotName(name string) string {
+	if strings.HasPrefix(name, harborRobotPrefix) {
+		return name
+	}
+	return harborRobotPrefix + name
+}
+
+func init() {
+	add(HarborRegistry)
+}
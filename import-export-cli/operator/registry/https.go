@@ -19,9 +19,7 @@
 package registry
 
 import (
-	"fmt"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
-	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"strings"
 )
 
@@ -37,21 +35,11 @@ var HttpsRegistry = &Registry{
 		// check input mode: interactive or batch
 		if flagValues == nil {
 			// get inputs in interactive mode
-			repository, username, password = readHttpsRepInputs()
+			repository, username, password = readCredentialsInteractive("Enter repository", "Enter username",
+				"Enter password", func(string) error { return nil })
 		} else {
 			// get inputs in batch mode
-			repository = (*flagValues)[k8sUtils.FlagBmRepository].Value.(string)
-			username = (*flagValues)[k8sUtils.FlagBmUsername].Value.(string)
-			password = (*flagValues)[k8sUtils.FlagBmPassword].Value.(string)
-
-			// if "--password-stdin" is supplied get password from stdin
-			if (*flagValues)[k8sUtils.FlagBmPasswordStdin].Value.(bool) {
-				pwStdin, err := utils.ReadPassword("Enter password")
-				if err != nil {
-					utils.HandleErrorAndExit("Error reading password from user", err)
-				}
-				password = pwStdin
-			}
+			repository, username, password = readCredentialsFromFlags(flagValues)
 		}
 
 		// support prefixed repository with registry url to be compatible with older version of API-CTL
@@ -70,7 +58,7 @@ var HttpsRegistry = &Registry{
 		}
 
 		k8sUtils.K8sCreateSecretFromInputs(
-			k8sUtils.DockerRegCredSecret, k8sUtils.ApiOpWso2Namespace,
+			k8sUtils.DockerRegCredSecret, operatorNamespace,
 			reg.Repository.ServerUrl, reg.Repository.Username, reg.Repository.Password,
 		)
 		reg.Repository.Password = "" // clear password
@@ -82,47 +70,6 @@ var HttpsRegistry = &Registry{
 	},
 }
 
-// readHttpsRepInputs reads https private registry URL, username and password from the user
-func readHttpsRepInputs() (string, string, string) {
-	isConfirm := false
-	repository := ""
-	username := ""
-	password := ""
-	var err error
-
-	for !isConfirm {
-		repository, err = utils.ReadInputString("Enter repository",
-			utils.Default{Value: "", IsDefault: false}, "", true)
-		if err != nil {
-			utils.HandleErrorAndExit("Error reading registry repository name from user", err)
-		}
-
-		username, err = utils.ReadInputString("Enter username", utils.Default{Value: "", IsDefault: false},
-			"", true)
-		if err != nil {
-			utils.HandleErrorAndExit("Error reading username from user", err)
-		}
-
-		password, err = utils.ReadPassword("Enter password")
-		if err != nil {
-			utils.HandleErrorAndExit("Error reading password from user", err)
-		}
-
-		fmt.Println("\nRepository: " + repository)
-		fmt.Println("Username  : " + username)
-
-		isConfirmStr, err := utils.ReadInputString("Confirm configurations",
-			utils.Default{Value: "Y", IsDefault: true}, "", false)
-		if err != nil {
-			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
-		}
-
-		isConfirm = strings.EqualFold(isConfirmStr, "y") || strings.EqualFold(isConfirmStr, "yes")
-	}
-
-	return repository, username, password
-}
-
 // getRegistryUrl returns the registry URL for given repository
 func getRegistryUrl(repository string) string {
 	names := strings.SplitN(repository, "/", 2)
@@ -26,11 +26,13 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"gopkg.in/yaml.v2"
 	"sort"
+	"strings"
 )
 
 // Registry represents Docker Registry
 type Registry struct {
 	Name       string                                                // Unique Name
+	ConfigType string                                                // Registry type set in the controller config, Name is used if empty
 	Caption    string                                                // Text to display in the CLI about registry details
 	Repository Repository                                            // Repository name
 	Option     int                                                   // Option to be choose the CLI registry list
@@ -66,6 +68,14 @@ var registries = make(map[int]*Registry)
 // optionToExec represents the choice use selected
 var optionToExec int
 
+// operatorNamespace represents the namespace of the API Operator to create registry configs and secrets
+var operatorNamespace = k8sUtils.ApiOpWso2Namespace
+
+// SetNamespace sets the namespace of the API Operator to create registry configs and secrets
+func SetNamespace(namespace string) {
+	operatorNamespace = namespace
+}
+
 // ReadInputsInteractive reads inputs with respect to the selected registry type interactively
 func ReadInputsInteractive() {
 	reg := registries[optionToExec]
@@ -81,9 +91,14 @@ func ReadInputsFromFlags(flagValues *map[string]FlagValue) {
 // UpdateConfigsSecrets updates controller config with registry type and creates secrets with credentials
 func UpdateConfigsSecrets() {
 	// set registry first since this can throw error if api operator not installed. If error occur no need to rollback secret.
-	updateDockerRegistryConfig(registries[optionToExec].Name, registries[optionToExec].Repository.Name)
+	reg := registries[optionToExec]
+	configType := reg.ConfigType
+	if configType == "" {
+		configType = reg.Name
+	}
+	updateDockerRegistryConfig(configType, reg.Repository.Name)
 	// create secret
-	registries[optionToExec].Run(registries[optionToExec])
+	reg.Run(reg)
 }
 
 // ChooseRegistryInteractive lists registries in the CLI and reads a choice from user
@@ -155,6 +170,7 @@ func updateDockerRegistryConfig(registryType string, repository string) {
 	// set configurations
 	registryConfigMap["data"].(map[interface{}]interface{})[k8sUtils.CtrlConfigRegType] = registryType
 	registryConfigMap["data"].(map[interface{}]interface{})[k8sUtils.CtrlConfigReg] = repository
+	registryConfigMap["metadata"].(map[interface{}]interface{})["namespace"] = operatorNamespace
 
 	configuredRegConfigMap, err := yaml.Marshal(registryConfigMap)
 	if err != nil {
@@ -162,11 +178,77 @@ func updateDockerRegistryConfig(registryType string, repository string) {
 	}
 
 	// apply controller config config map back
-	if err := k8sUtils.K8sApplyFromStdin(string(configuredRegConfigMap)); err != nil {
+	if err := k8sUtils.K8sApplyFromBytes([][]byte{configuredRegConfigMap}); err != nil {
 		utils.HandleErrorAndExit("Error creating controller-configs", err)
 	}
 }
 
+// readCredentialsFromFlags reads repository, username and password from flags in batch mode.
+// Password is read from stdin if the flag "--password-stdin" is supplied
+func readCredentialsFromFlags(flagValues *map[string]FlagValue) (string, string, string) {
+	repository := (*flagValues)[k8sUtils.FlagBmRepository].Value.(string)
+	username := (*flagValues)[k8sUtils.FlagBmUsername].Value.(string)
+	password := (*flagValues)[k8sUtils.FlagBmPassword].Value.(string)
+
+	if (*flagValues)[k8sUtils.FlagBmPasswordStdin].Value.(bool) {
+		pwStdin, err := utils.ReadPassword("Enter password")
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading password from user", err)
+		}
+		password = pwStdin
+	}
+	return repository, username, password
+}
+
+// readCredentialsInteractive reads repository, username and password from the user until the user confirms them
+// @param repositoryPrompt : Prompt to read the repository
+// @param usernamePrompt : Prompt to read the username
+// @param passwordPrompt : Prompt to read the password
+// @param validateRepository : Returns an error if the repository is invalid
+// @return repository, username, password
+func readCredentialsInteractive(repositoryPrompt, usernamePrompt, passwordPrompt string,
+	validateRepository func(string) error) (string, string, string) {
+	isConfirm := false
+	repository := ""
+	username := ""
+	password := ""
+	var err error
+
+	for !isConfirm {
+		repository, err = utils.ReadInputString(repositoryPrompt, utils.Default{Value: "", IsDefault: false}, "", true)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading registry repository name from user", err)
+		}
+		if err = validateRepository(repository); err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+
+		username, err = utils.ReadInputString(usernamePrompt, utils.Default{Value: "", IsDefault: false}, "", true)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading username from user", err)
+		}
+
+		password, err = utils.ReadPassword(passwordPrompt)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading password from user", err)
+		}
+
+		fmt.Println("\nRepository: " + repository)
+		fmt.Println("Username  : " + username)
+
+		isConfirmStr, err := utils.ReadInputString("Confirm configurations",
+			utils.Default{Value: "Y", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+
+		isConfirm = strings.EqualFold(isConfirmStr, "y") || strings.EqualFold(isConfirmStr, "yes")
+	}
+
+	return repository, username, password
+}
+
 // add adds a registry to the registries maps
 // using pointers for memory optimization
 func add(registry *Registry) {
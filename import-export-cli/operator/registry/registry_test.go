@@ -0,0 +1,65 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+)
+
+func TestValidateAcrRepository(t *testing.T) {
+	assert.Nil(t, validateAcrRepository("myregistry.azurecr.io/wso2"))
+	assert.Nil(t, validateAcrRepository("myregistry.azurecr.cn/wso2/apis"))
+	assert.NotNil(t, validateAcrRepository("myregistry.azurecr.io"), "Repository path should be required")
+	assert.NotNil(t, validateAcrRepository("docker.io/wso2"), "Non ACR login servers should not be accepted")
+}
+
+func TestValidateHarborRepository(t *testing.T) {
+	assert.Nil(t, validateHarborRepository("harbor.example.com/wso2"))
+	assert.Nil(t, validateHarborRepository("harbor.example.com:8443/wso2/"))
+	assert.NotNil(t, validateHarborRepository("harbor.example.com"), "Harbor project should be required")
+	assert.NotNil(t, validateHarborRepository("harbor.example.com/"), "Harbor project should be required")
+}
+
+func TestHarborRobotName(t *testing.T) {
+	assert.Equal(t, "robot$apictl", harborRobotName("apictl"))
+	assert.Equal(t, "robot$wso2+apictl", harborRobotName("robot$wso2+apictl"))
+}
+
+func TestGhcrRepository(t *testing.T) {
+	assert.Equal(t, "ghcr.io/wso2", ghcrRepository("WSO2"))
+	assert.Equal(t, "ghcr.io/wso2/apis", ghcrRepository("ghcr.io/wso2/apis/"))
+}
+
+func TestReadCredentialsFromFlags(t *testing.T) {
+	reg := *HarborRegistry
+	reg.Read(&reg, &map[string]FlagValue{
+		k8sUtils.FlagBmRepository:    {Value: "harbor.example.com/wso2", IsProvided: true},
+		k8sUtils.FlagBmUsername:      {Value: "apictl", IsProvided: true},
+		k8sUtils.FlagBmPassword:      {Value: "token", IsProvided: true},
+		k8sUtils.FlagBmPasswordStdin: {Value: false},
+	})
+	assert.Equal(t, "harbor.example.com/wso2", reg.Repository.Name)
+	assert.Equal(t, "harbor.example.com", reg.Repository.ServerUrl)
+	assert.Equal(t, "robot$apictl", reg.Repository.Username)
+	assert.Equal(t, "token", reg.Repository.Password)
+	assert.Equal(t, "HTTPS", reg.ConfigType)
+}
@@ -0,0 +1,218 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"gopkg.in/yaml.v2"
+)
+
+// Statuses of the APIs deployed in a batch
+const (
+	ApiBatchStatusCreated = "created"
+	ApiBatchStatusUpdated = "updated"
+	ApiBatchStatusFailed  = "failed"
+)
+
+const (
+	apiBatchNameHeader      = "NAME"
+	apiBatchNamespaceHeader = "NAMESPACE"
+	apiBatchProjectHeader   = "PROJECT"
+	apiBatchStatusHeader    = "STATUS"
+	apiBatchMessageHeader   = "MESSAGE"
+
+	defaultApiBatchTableFormat = "table {{.Name}}\t{{.Namespace}}\t{{.Project}}\t{{.Status}}\t{{.Message}}"
+)
+
+// ApiBatchManifest represents the manifest with the overrides of the APIs deployed from a directory
+type ApiBatchManifest struct {
+	Apis []*ApiBatchEntry `yaml:"apis"`
+}
+
+// ApiBatchEntry represents an API deployed from a project in a directory
+type ApiBatchEntry struct {
+	Project   string `yaml:"project"`   // Name of the project directory
+	Name      string `yaml:"name"`      // Name of the API, name of the project is used if empty
+	Namespace string `yaml:"namespace"` // Namespace of the API
+	Replicas  int    `yaml:"replicas"`  // Replicas of the API
+}
+
+// ApiBatchResult represents the result of deploying an API in a batch
+type ApiBatchResult struct {
+	Name      string
+	Namespace string
+	Project   string
+	Status    string
+	Message   string
+}
+
+// ResolveApiBatch returns the APIs to be deployed from the apictl projects in a directory. Values in the manifest
+// override the default namespace and replicas
+// @param dir : Directory with apictl projects
+// @param manifestPath : Path to the manifest. Defaults are used for all the projects if empty
+// @param namespace : Default namespace of the APIs
+// @param replicas : Default replicas of the APIs
+// @return APIs sorted by the project name, error
+func ResolveApiBatch(dir, manifestPath, namespace string, replicas int) ([]*ApiBatchEntry, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	apis := make(map[string]*ApiBatchEntry)
+	var projects []string
+	for _, info := range infos {
+		if !info.IsDir() || !isApictlProject(filepath.Join(dir, info.Name())) {
+			continue
+		}
+		projects = append(projects, info.Name())
+		apis[info.Name()] = &ApiBatchEntry{Project: info.Name(), Name: ToK8sName(info.Name()), Namespace: namespace,
+			Replicas: replicas}
+	}
+	if len(projects) == 0 {
+		return nil, fmt.Errorf("no apictl projects found in %s", dir)
+	}
+
+	if manifestPath != "" {
+		data, err := ioutil.ReadFile(manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		manifest := &ApiBatchManifest{}
+		if err = yaml.UnmarshalStrict(data, manifest); err != nil {
+			return nil, fmt.Errorf("error reading manifest %s: %v", manifestPath, err)
+		}
+		for _, override := range manifest.Apis {
+			api, found := apis[override.Project]
+			if !found {
+				return nil, fmt.Errorf("project %q in the manifest is not found in %s", override.Project, dir)
+			}
+			if override.Name != "" {
+				api.Name = strings.ToLower(override.Name)
+			}
+			if override.Namespace != "" {
+				api.Namespace = override.Namespace
+			}
+			if override.Replicas > 0 {
+				api.Replicas = override.Replicas
+			}
+		}
+	}
+
+	sort.Strings(projects)
+	result := make([]*ApiBatchEntry, 0, len(projects))
+	names := make(map[string]string)
+	for _, project := range projects {
+		api := apis[project]
+		key := api.Namespace + "/" + api.Name
+		if other, exists := names[key]; exists {
+			return nil, fmt.Errorf("projects %q and %q are deployed as the same API %q", other, project, api.Name)
+		}
+		names[key] = project
+		result = append(result, api)
+	}
+	return result, nil
+}
+
+// RunApiBatch deploys the APIs in a batch with at most the given number of APIs in parallel
+// @param apis : APIs to be deployed
+// @param parallelism : Maximum number of APIs deployed in parallel
+// @param deploy : Deploys an API and returns its status
+// @return results in the order of the APIs
+func RunApiBatch(apis []*ApiBatchEntry, parallelism int, deploy func(*ApiBatchEntry) (string, error)) []*ApiBatchResult {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	results := make([]*ApiBatchResult, len(apis))
+	slots := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, api := range apis {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, api *ApiBatchEntry) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			result := &ApiBatchResult{Name: api.Name, Namespace: api.Namespace, Project: api.Project}
+			status, err := deploy(api)
+			if err != nil {
+				result.Status, result.Message = ApiBatchStatusFailed, err.Error()
+			} else {
+				result.Status = status
+			}
+			results[i] = result
+		}(i, api)
+	}
+	wg.Wait()
+	return results
+}
+
+// ApiBatchError returns an error if any of the APIs in a batch is failed
+func ApiBatchError(results []*ApiBatchResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Status == ApiBatchStatusFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d APIs failed", failed, len(results))
+	}
+	return nil
+}
+
+// PrintApiBatchResults prints the results of deploying APIs in a batch as a table
+func PrintApiBatchResults(w io.Writer, results []*ApiBatchResult) {
+	batchContext := formatter.NewContext(w, defaultApiBatchTableFormat)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, result := range results {
+			if err := t.Execute(w, result); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Name":      apiBatchNameHeader,
+		"Namespace": apiBatchNamespaceHeader,
+		"Project":   apiBatchProjectHeader,
+		"Status":    apiBatchStatusHeader,
+		"Message":   apiBatchMessageHeader,
+	}
+	if err := batchContext.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// isApictlProject returns true if the directory is an apictl project
+func isApictlProject(dir string) bool {
+	stat, err := os.Stat(filepath.Join(dir, "Meta-information"))
+	return err == nil && stat.IsDir()
+}
@@ -0,0 +1,165 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestBatchDir creates a directory with an apictl project for each of the given names
+func newTestBatchDir(t *testing.T, projects ...string) string {
+	dir, err := ioutil.TempDir("", "api-batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, project := range projects {
+		if err = os.MkdirAll(filepath.Join(dir, project, "Meta-information"), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// directories without Meta-information are not projects
+	if err = os.MkdirAll(filepath.Join(dir, "docs"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestResolveApiBatch(t *testing.T) {
+	dir := newTestBatchDir(t, "Petstore_API", "Inventory")
+	defer os.RemoveAll(dir)
+	manifest := filepath.Join(dir, "manifest.yaml")
+	_ = ioutil.WriteFile(manifest, []byte(`apis:
+- project: Inventory
+  name: stock
+  namespace: store
+  replicas: 3
+`), os.ModePerm)
+
+	apis, err := ResolveApiBatch(dir, manifest, "wso2", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(apis))
+	assert.Equal(t, &ApiBatchEntry{Project: "Inventory", Name: "stock", Namespace: "store", Replicas: 3}, apis[0])
+	assert.Equal(t, "Petstore_API", apis[1].Project)
+	assert.Equal(t, ToK8sName("Petstore_API"), apis[1].Name)
+	assert.Equal(t, "wso2", apis[1].Namespace)
+	assert.Equal(t, 1, apis[1].Replicas)
+}
+
+func TestResolveApiBatchInvalidManifest(t *testing.T) {
+	dir := newTestBatchDir(t, "petstore", "inventory")
+	defer os.RemoveAll(dir)
+	manifest := filepath.Join(dir, "manifest.yaml")
+
+	_ = ioutil.WriteFile(manifest, []byte("apis:\n- project: orders\n"), os.ModePerm)
+	_, err := ResolveApiBatch(dir, manifest, "wso2", 1)
+	assert.NotNil(t, err)
+
+	_ = ioutil.WriteFile(manifest, []byte("apis:\n- project: inventory\n  name: petstore\n"), os.ModePerm)
+	_, err = ResolveApiBatch(dir, manifest, "wso2", 1)
+	assert.NotNil(t, err, "APIs with the same name in the same namespace should fail")
+
+	_, err = ResolveApiBatch(filepath.Join(dir, "docs"), "", "wso2", 1)
+	assert.NotNil(t, err, "directory without projects should fail")
+}
+
+func TestRunApiBatch(t *testing.T) {
+	apis := []*ApiBatchEntry{{Project: "a", Name: "a"}, {Project: "b", Name: "b"}, {Project: "c", Name: "c"}}
+	var running, maxRunning int32
+	results := RunApiBatch(apis, 2, func(api *ApiBatchEntry) (string, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		if api.Name == "b" {
+			return "", errors.New("image build failed")
+		}
+		return ApiBatchStatusCreated, nil
+	})
+
+	assert.True(t, maxRunning <= 2)
+	assert.Equal(t, ApiBatchStatusCreated, results[0].Status)
+	assert.Equal(t, ApiBatchStatusFailed, results[1].Status)
+	assert.Equal(t, "image build failed", results[1].Message)
+	assert.Equal(t, ApiBatchStatusCreated, results[2].Status)
+	assert.EqualError(t, ApiBatchError(results), "1 of 3 APIs failed")
+
+	var out bytes.Buffer
+	PrintApiBatchResults(&out, results)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 4, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "NAME"))
+	assert.Contains(t, lines[2], "image build failed")
+}
+
+func TestRunApiBatchSharesK8sClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubeconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	kubeconfig := filepath.Join(dir, "config")
+	err = ioutil.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: test
+  context:
+    cluster: test
+    namespace: apis
+current-context: test
+`), 0600)
+	assert.Nil(t, err)
+	defer os.Setenv("KUBECONFIG", os.Getenv("KUBECONFIG"))
+	_ = os.Setenv("KUBECONFIG", kubeconfig)
+	SetK8sClient(nil)
+	defer SetK8sClient(nil)
+
+	apis := []*ApiBatchEntry{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+	clients := make([]*K8sClient, len(apis))
+	results := RunApiBatch(apis, len(apis), func(api *ApiBatchEntry) (string, error) {
+		client, err := GetK8sClient()
+		if err != nil {
+			return "", err
+		}
+		clients[api.Name[0]-'a'] = client
+		return ApiBatchStatusCreated, nil
+	})
+
+	assert.Nil(t, ApiBatchError(results))
+	assert.Equal(t, "apis", clients[0].Namespace)
+	for _, client := range clients {
+		assert.True(t, client == clients[0], "APIs deployed in parallel should share a single client")
+	}
+}
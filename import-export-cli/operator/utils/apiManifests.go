@@ -0,0 +1,495 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	wso2v1alpha2 "github.com/wso2/k8s-api-operator/api-operator/pkg/apis/wso2/v1alpha2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Output formats of rendered kubernetes manifests
+const (
+	ManifestFormatYaml = "yaml"
+	ManifestFormatJson = "json"
+)
+
+// directories of an apictl project which are rendered as kubernetes resources
+const (
+	projectBalInterceptorsDir    = "Interceptors"
+	projectJavaInterceptorsDir   = "libs"
+	projectEndpointCertsDir      = "Endpoint-certificates"
+	projectClientCertsDir        = "Client-certificates"
+	projectSwaggerFileWithoutExt = "Meta-information/swagger"
+)
+
+// ProjectDocsDirs are the directories of an apictl project added to the docs config map instead of the archive of
+// the project
+var ProjectDocsDirs = []string{"Docs", "Image"}
+
+// maxConfigMapDataSize is the maximum size of the data in a config map accepted by kubernetes
+const maxConfigMapDataSize = 1024 * 1024
+
+var invalidK8sNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// ApiManifests contains the kubernetes resources required to deploy an API with the API Operator
+type ApiManifests struct {
+	// ConfigMaps with the swagger definition, the interceptors and the docs
+	ConfigMaps []*corev1.ConfigMap
+	// Secrets with the certificates
+	Secrets []*corev1.Secret
+	// Api is the API custom resource
+	Api *wso2v1alpha2.API
+}
+
+// NewApiManifests renders the kubernetes resources of an API from a swagger file or an apictl project
+// @param apiName : Name of the API custom resource
+// @param namespace : Namespace of the resources
+// @param path : Path to the swagger file or the apictl project
+// @param nameSuffix : Suffix added to the names of the config maps and secrets
+// @return manifests, error
+func NewApiManifests(apiName, namespace, path, nameSuffix string) (*ApiManifests, error) {
+	api := &wso2v1alpha2.API{
+		TypeMeta:   metav1.TypeMeta{APIVersion: ApiGVR.GroupVersion().String(), Kind: "API"},
+		ObjectMeta: metav1.ObjectMeta{Name: apiName, Namespace: namespace},
+	}
+	manifests := &ApiManifests{Api: api}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	swaggerPath := path
+	if stat.IsDir() {
+		swaggerPath, err = resolveProjectSwagger(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	swaggerConfigMap, err := NewConfigMapFromPath(fmt.Sprintf("%s-swagger%s", apiName, nameSuffix), namespace,
+		swaggerPath)
+	if err != nil {
+		return nil, err
+	}
+	manifests.ConfigMaps = append(manifests.ConfigMaps, swaggerConfigMap)
+	api.Spec.SwaggerConfigMapName = swaggerConfigMap.Name
+
+	if stat.IsDir() {
+		if err = manifests.addProjectResources(path, nameSuffix); err != nil {
+			return nil, err
+		}
+	}
+	return manifests, nil
+}
+
+// addProjectResources renders the interceptors, certificates and docs of an apictl project. The API custom resource
+// has no fields for the certificates and the docs, hence they are referenced with the annotations
+// ApiCertificatesAnnotation and ApiDocsAnnotation
+func (m *ApiManifests) addProjectResources(projectPath, nameSuffix string) error {
+	apiName, namespace := m.Api.Name, m.Api.Namespace
+
+	// ballerina interceptors
+	balInterceptorsDir := filepath.Join(projectPath, projectBalInterceptorsDir)
+	if hasFiles(balInterceptorsDir) {
+		configMap, err := NewConfigMapFromPath(fmt.Sprintf("%s-bal-intcpt%s", apiName, nameSuffix), namespace,
+			balInterceptorsDir)
+		if err != nil {
+			return err
+		}
+		m.ConfigMaps = append(m.ConfigMaps, configMap)
+		m.Api.Spec.Definition.Interceptors.Ballerina = []string{configMap.Name}
+	}
+
+	// java interceptors, a config map for each jar
+	jars, _ := filepath.Glob(filepath.Join(projectPath, projectJavaInterceptorsDir, "*.jar"))
+	for _, jar := range jars {
+		jarName := strings.TrimSuffix(filepath.Base(jar), filepath.Ext(jar))
+		configMap, err := NewConfigMapFromPath(
+			fmt.Sprintf("%s-%s-jar-intcpt%s", apiName, ToK8sName(jarName), nameSuffix), namespace, jar)
+		if err != nil {
+			return err
+		}
+		m.ConfigMaps = append(m.ConfigMaps, configMap)
+		m.Api.Spec.Definition.Interceptors.Java = append(m.Api.Spec.Definition.Interceptors.Java, configMap.Name)
+	}
+
+	// certificates
+	certDirs := []struct{ dir, suffix string }{
+		{projectEndpointCertsDir, "endpoint-certs"},
+		{projectClientCertsDir, "client-certs"},
+	}
+	var certSecrets []string
+	for _, certDir := range certDirs {
+		dir := filepath.Join(projectPath, certDir.dir)
+		if !hasFiles(dir) {
+			continue
+		}
+		configMap, err := NewConfigMapFromPath(fmt.Sprintf("%s-%s%s", apiName, certDir.suffix, nameSuffix),
+			namespace, dir)
+		if err != nil {
+			return err
+		}
+		data := configMap.BinaryData
+		for key, value := range configMap.Data {
+			data[key] = []byte(value)
+		}
+		m.Secrets = append(m.Secrets, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: configMap.ObjectMeta,
+			Type:       corev1.SecretTypeOpaque,
+			Data:       data,
+		})
+		certSecrets = append(certSecrets, configMap.Name)
+	}
+	if len(certSecrets) > 0 {
+		m.setAnnotation(ApiCertificatesAnnotation, strings.Join(certSecrets, ","))
+	}
+
+	// docs, archived as they are in nested directories
+	docsConfigMap, err := newDocsConfigMap(fmt.Sprintf("%s-docs%s", apiName, nameSuffix), namespace, projectPath)
+	if err != nil {
+		return err
+	}
+	if docsConfigMap != nil {
+		m.ConfigMaps = append(m.ConfigMaps, docsConfigMap)
+		m.setAnnotation(ApiDocsAnnotation, docsConfigMap.Name)
+	}
+	return nil
+}
+
+// SetProjectArchive adds the archive of an apictl project to the swagger config map. The swagger definition is kept
+// in the config map, hence the API Operators which read only the swagger definition are not affected. The API
+// Operators which support project archives read the sequences, certificates and environment configs of the project
+// from the archive. The archive should not contain the ProjectDocsDirs, which are in the docs config map
+// @param archivePath : Path to the archive of the apictl project
+// @return error if the swagger definition and the archive are larger than the maximum size of a config map
+func (m *ApiManifests) SetProjectArchive(archivePath string) error {
+	data, err := ioutil.ReadFile(archivePath)
+	if err != nil {
+		return err
+	}
+	for _, configMap := range m.ConfigMaps {
+		if configMap.Name != m.Api.Spec.SwaggerConfigMapName {
+			continue
+		}
+		size := len(data)
+		for _, swagger := range configMap.Data {
+			size += len(swagger)
+		}
+		if size > maxConfigMapDataSize {
+			return fmt.Errorf("size of the swagger definition and the project archive %d bytes exceeds the "+
+				"maximum size of a config map %d bytes", size, maxConfigMapDataSize)
+		}
+		if configMap.BinaryData == nil {
+			configMap.BinaryData = map[string][]byte{}
+		}
+		configMap.BinaryData[m.Api.Name+".zip"] = data
+		return nil
+	}
+	return fmt.Errorf("swagger config map %s is not found", m.Api.Spec.SwaggerConfigMapName)
+}
+
+// newDocsConfigMap renders a config map with an archive of the ProjectDocsDirs of an apictl project. Returns nil if
+// the project has none of them
+func newDocsConfigMap(configMapName, namespace, projectPath string) (*corev1.ConfigMap, error) {
+	tmpDir, err := ioutil.TempDir("", "api-docs")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	docsDir := filepath.Join(tmpDir, "docs")
+	hasDocs := false
+	for _, dir := range ProjectDocsDirs {
+		if stat, err := os.Stat(filepath.Join(projectPath, dir)); err != nil || !stat.IsDir() {
+			continue
+		}
+		if err = utils.CopyDir(filepath.Join(projectPath, dir), filepath.Join(docsDir, dir)); err != nil {
+			return nil, err
+		}
+		hasDocs = true
+	}
+	if !hasDocs {
+		return nil, nil
+	}
+
+	archivePath := filepath.Join(tmpDir, "docs.zip")
+	if err = utils.Zip(docsDir, archivePath); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxConfigMapDataSize {
+		return nil, fmt.Errorf("size of the archive of the docs %d bytes exceeds the maximum size of a config map "+
+			"%d bytes", len(data), maxConfigMapDataSize)
+	}
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: namespace},
+		BinaryData: map[string][]byte{configMapName + ".zip": data},
+	}, nil
+}
+
+// setAnnotation sets an annotation of the API custom resource
+func (m *ApiManifests) setAnnotation(key, value string) {
+	if m.Api.Annotations == nil {
+		m.Api.Annotations = map[string]string{}
+	}
+	m.Api.Annotations[key] = value
+}
+
+// ApplyOverlay merges an overlay of the API custom resource in YAML or JSON into the rendered API. Fields of the
+// overlay override the rendered fields. Name and namespace of the API are not changed
+// @param overlay : Content of the overlay. Either a full API custom resource or a document with only the spec
+// @return error if the overlay contains fields which are not supported by the API custom resource
+func (m *ApiManifests) ApplyOverlay(overlay []byte) error {
+	overlayJson, err := yaml.YAMLToJSON(overlay)
+	if err != nil {
+		return fmt.Errorf("error reading API overlay: %v", err)
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(overlayJson, &fields); err != nil {
+		return fmt.Errorf("error reading API overlay: %v", err)
+	}
+	if _, hasSpec := fields["spec"]; !hasSpec {
+		// overlay with only the spec
+		overlayJson, _ = json.Marshal(map[string]interface{}{"spec": fields})
+	}
+
+	name, namespace := m.Api.Name, m.Api.Namespace
+	decoder := json.NewDecoder(bytes.NewReader(overlayJson))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(m.Api); err != nil {
+		return fmt.Errorf("invalid API overlay: %v", err)
+	}
+	m.Api.Name, m.Api.Namespace = name, namespace
+	m.Api.TypeMeta = metav1.TypeMeta{APIVersion: ApiGVR.GroupVersion().String(), Kind: "API"}
+	return nil
+}
+
+// Objects returns the resources in the order they should be created
+func (m *ApiManifests) Objects() []runtime.Object {
+	var objects []runtime.Object
+	for _, configMap := range m.ConfigMaps {
+		objects = append(objects, configMap)
+	}
+	for _, secret := range m.Secrets {
+		objects = append(objects, secret)
+	}
+	return append(objects, m.Api)
+}
+
+// ConfigNames returns the names of the config maps and the secrets
+func (m *ApiManifests) ConfigNames() (configMaps []string, secrets []string) {
+	for _, configMap := range m.ConfigMaps {
+		configMaps = append(configMaps, configMap.Name)
+	}
+	for _, secret := range m.Secrets {
+		secrets = append(secrets, secret.Name)
+	}
+	return configMaps, secrets
+}
+
+// WriteManifests writes kubernetes resources as YAML or JSON to a writer or to a directory with a file per resource
+// @param objects : Resources to be written
+// @param format : Output format, yaml or json
+// @param outputDir : Directory to write the resources. Resources are written to the writer if empty
+// @param out : Writer to write the resources if the output directory is not given
+// @return error
+func WriteManifests(objects []runtime.Object, format, outputDir string, out io.Writer) error {
+	if format != ManifestFormatYaml && format != ManifestFormatJson {
+		return fmt.Errorf("invalid output format \"%s\". Available formats: %s, %s", format,
+			ManifestFormatYaml, ManifestFormatJson)
+	}
+
+	var items []interface{}
+	for _, obj := range objects {
+		item, err := ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		// remove the fields which are set by the cluster
+		unstructured.RemoveNestedField(item.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(item.Object, "status")
+		items = append(items, item.Object)
+	}
+
+	if outputDir != "" {
+		if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
+			return err
+		}
+		for _, item := range items {
+			obj := unstructured.Unstructured{Object: item.(map[string]interface{})}
+			data, err := marshalManifest(item, format)
+			if err != nil {
+				return err
+			}
+			fileName := fmt.Sprintf("%s-%s.%s", strings.ToLower(obj.GetKind()), obj.GetName(), format)
+			if err = ioutil.WriteFile(filepath.Join(outputDir, fileName), data, 0644); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if format == ManifestFormatJson {
+		data, err := marshalManifest(map[string]interface{}{"apiVersion": "v1", "kind": "List", "items": items},
+			format)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	for i, item := range items {
+		data, err := marshalManifest(item, format)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			data = append([]byte("---\n"), data...)
+		}
+		if _, err = out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToK8sName converts a string to a valid kubernetes resource name
+func ToK8sName(name string) string {
+	return strings.Trim(invalidK8sNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// marshalManifest marshals a resource to YAML or indented JSON
+func marshalManifest(obj interface{}, format string) ([]byte, error) {
+	if format == ManifestFormatYaml {
+		return yaml.Marshal(obj)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var indented bytes.Buffer
+	if err = json.Indent(&indented, data, "", "  "); err != nil {
+		return nil, err
+	}
+	indented.WriteString("\n")
+	return indented.Bytes(), nil
+}
+
+// resolveProjectSwagger returns the path to the swagger definition of an apictl project
+func resolveProjectSwagger(projectPath string) (string, error) {
+	for _, ext := range []string{".yaml", ".yml", ".json"} {
+		swaggerPath := filepath.Join(projectPath, filepath.FromSlash(projectSwaggerFileWithoutExt+ext))
+		if _, err := os.Stat(swaggerPath); err == nil {
+			return swaggerPath, nil
+		}
+	}
+	return "", fmt.Errorf("swagger definition not found in the project %s", projectPath)
+}
+
+// hasFiles checks whether a directory contains files
+func hasFiles(dir string) bool {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, file := range files {
+		if file.Mode().IsRegular() {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateApi validates the fields of an API custom resource against the schema of the API Operator
+// @param api : API custom resource
+// @return error with all the invalid fields
+func ValidateApi(api *wso2v1alpha2.API) error {
+	var errs []string
+	addErrs := func(field string, msgs []string) {
+		for _, msg := range msgs {
+			errs = append(errs, fmt.Sprintf("%s: %s", field, msg))
+		}
+	}
+
+	addErrs("metadata.name", validation.IsDNS1123Subdomain(api.Name))
+	if api.Namespace != "" {
+		addErrs("metadata.namespace", validation.IsDNS1123Label(api.Namespace))
+	}
+	addErrs("spec.swaggerConfigMapName", validation.IsDNS1123Subdomain(api.Spec.SwaggerConfigMapName))
+
+	switch api.Spec.Mode {
+	case "", wso2v1alpha2.PrivateJet, wso2v1alpha2.Sidecar, wso2v1alpha2.Shared, wso2v1alpha2.Serverless:
+	default:
+		addErrs("spec.mode", []string{fmt.Sprintf("unsupported mode \"%s\". Available modes: %v, %v, %v, %v",
+			api.Spec.Mode, wso2v1alpha2.PrivateJet, wso2v1alpha2.Sidecar, wso2v1alpha2.Shared,
+			wso2v1alpha2.Serverless)})
+	}
+	if api.Spec.Replicas < 0 {
+		addErrs("spec.replicas", []string{"must be greater than or equal to 0"})
+	}
+	for _, env := range api.Spec.EnvironmentVariables {
+		keyVal := strings.SplitN(env, "=", 2)
+		if len(keyVal) != 2 {
+			addErrs("spec.environmentVariables", []string{fmt.Sprintf("\"%s\" should be in the format KEY=VALUE", env)})
+			continue
+		}
+		addErrs("spec.environmentVariables", validation.IsEnvVarName(keyVal[0]))
+	}
+	if api.Spec.IngressHostname != "" {
+		addErrs("spec.ingressHostname", validation.IsDNS1123Subdomain(api.Spec.IngressHostname))
+	}
+	if api.Spec.ApiEndPoint != "" && !utils.IsValidUrl(api.Spec.ApiEndPoint) {
+		addErrs("spec.apiEndPoint", []string{fmt.Sprintf("\"%s\" is not a valid URL", api.Spec.ApiEndPoint)})
+	}
+	if strings.ContainsAny(api.Spec.Image, " \t\n") {
+		addErrs("spec.image", []string{fmt.Sprintf("\"%s\" is not a valid image", api.Spec.Image)})
+	}
+	for _, name := range api.Spec.Definition.Interceptors.Ballerina {
+		addErrs("spec.definition.interceptors.ballerina", validation.IsDNS1123Subdomain(name))
+	}
+	for _, name := range api.Spec.Definition.Interceptors.Java {
+		addErrs("spec.definition.interceptors.java", validation.IsDNS1123Subdomain(name))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid API %s:\n  %s", api.Name, strings.Join(errs, "\n  "))
+	}
+	return nil
+}
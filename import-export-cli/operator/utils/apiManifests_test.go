@@ -0,0 +1,187 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const testProjectPath = "testdata/PetstoreAPI"
+
+func TestNewApiManifestsFromSwagger(t *testing.T) {
+	swaggerPath := filepath.Join(testProjectPath, "Meta-information", "swagger.yaml")
+	manifests, err := NewApiManifests("petstore", "wso2", swaggerPath, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(manifests.ConfigMaps))
+	assert.Equal(t, "petstore-swagger", manifests.Api.Spec.SwaggerConfigMapName)
+	assert.Contains(t, manifests.ConfigMaps[0].Data["swagger.yaml"], "title: Petstore")
+	assert.Equal(t, "wso2", manifests.Api.Namespace)
+}
+
+func TestNewApiManifestsFromProject(t *testing.T) {
+	manifests, err := NewApiManifests("petstore", "wso2", testProjectPath, "-v2")
+	assert.Nil(t, err)
+
+	configMaps, secrets := manifests.ConfigNames()
+	assert.Equal(t, []string{"petstore-swagger-v2", "petstore-bal-intcpt-v2",
+		"petstore-mediation-interceptor-jar-intcpt-v2", "petstore-docs-v2"}, configMaps)
+	assert.Equal(t, []string{"petstore-endpoint-certs-v2"}, secrets)
+
+	assert.Equal(t, []string{"petstore-bal-intcpt-v2"}, manifests.Api.Spec.Definition.Interceptors.Ballerina)
+	assert.Equal(t, []string{"petstore-mediation-interceptor-jar-intcpt-v2"},
+		manifests.Api.Spec.Definition.Interceptors.Java)
+	assert.NotEmpty(t, manifests.ConfigMaps[2].BinaryData["Mediation_Interceptor.jar"],
+		"Jars should be added as binary data")
+
+	assert.Contains(t, string(manifests.Secrets[0].Data["backend.crt"]), "BEGIN CERTIFICATE")
+	assert.Equal(t, "petstore-endpoint-certs-v2", manifests.Api.Annotations[ApiCertificatesAnnotation],
+		"API should reference the certificates")
+	assert.Equal(t, "petstore-docs-v2", manifests.Api.Annotations[ApiDocsAnnotation], "API should reference the docs")
+
+	docs := manifests.ConfigMaps[3].BinaryData["petstore-docs-v2.zip"]
+	docsArchive, err := zip.NewReader(bytes.NewReader(docs), int64(len(docs)))
+	assert.Nil(t, err)
+	var docsFiles []string
+	for _, file := range docsArchive.File {
+		docsFiles = append(docsFiles, filepath.ToSlash(file.Name))
+	}
+	assert.Contains(t, docsFiles, "docs/Docs/FileContents/petstore-guide.md",
+		"Docs should be archived with their directories")
+
+	objects := manifests.Objects()
+	assert.Equal(t, 6, len(objects))
+	assert.Equal(t, runtime.Object(manifests.Api), objects[5], "API should be created after its configs")
+}
+
+func TestSetProjectArchive(t *testing.T) {
+	manifests, err := NewApiManifests("petstore", "wso2", testProjectPath, "")
+	assert.Nil(t, err)
+
+	archive := filepath.Join(testProjectPath, "libs", "Mediation_Interceptor.jar")
+	assert.Nil(t, manifests.SetProjectArchive(archive))
+	assert.Contains(t, manifests.ConfigMaps[0].Data["swagger.yaml"], "title: Petstore",
+		"Swagger definition should be kept with the archive")
+	assert.NotEmpty(t, manifests.ConfigMaps[0].BinaryData["petstore.zip"])
+
+	largeArchive, err := ioutil.TempFile("", "petstore*.zip")
+	assert.Nil(t, err)
+	defer os.Remove(largeArchive.Name())
+	_, err = largeArchive.Write(make([]byte, maxConfigMapDataSize+1))
+	assert.Nil(t, err)
+	assert.Nil(t, largeArchive.Close())
+	assert.NotNil(t, manifests.SetProjectArchive(largeArchive.Name()),
+		"Archives larger than a config map should not be accepted")
+}
+
+func TestWriteManifests(t *testing.T) {
+	manifests, err := NewApiManifests("petstore", "wso2", testProjectPath, "")
+	assert.Nil(t, err)
+
+	var out bytes.Buffer
+	assert.Nil(t, WriteManifests(manifests.Objects(), ManifestFormatYaml, "", &out))
+	objects, err := DecodeK8sObjects(out.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(objects))
+	assert.Equal(t, "API", objects[5].GetKind())
+	assert.NotContains(t, out.String(), "creationTimestamp")
+
+	out.Reset()
+	assert.Nil(t, WriteManifests(manifests.Objects(), ManifestFormatJson, "", &out))
+	objects, err = DecodeK8sObjects(out.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(objects), "JSON output should be a list of the resources")
+
+	outputDir, err := ioutil.TempDir("", "apictl-manifests")
+	assert.Nil(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.Nil(t, WriteManifests(manifests.Objects(), ManifestFormatYaml, outputDir, nil))
+	files, _ := ioutil.ReadDir(outputDir)
+	assert.Equal(t, 6, len(files))
+	_, err = os.Stat(filepath.Join(outputDir, "api-petstore.yaml"))
+	assert.Nil(t, err)
+
+	assert.NotNil(t, WriteManifests(manifests.Objects(), "xml", "", &out))
+}
+
+func TestToK8sName(t *testing.T) {
+	assert.Equal(t, "mediation-interceptor-1-0", ToK8sName("Mediation_Interceptor-1.0"))
+}
+
+func TestApplyOverlay(t *testing.T) {
+	manifests, err := NewApiManifests("petstore", "wso2", testProjectPath, "")
+	assert.Nil(t, err)
+
+	overlay := `
+apiVersion: wso2.com/v1alpha2
+kind: API
+metadata:
+  name: other
+  labels:
+    team: pets
+spec:
+  replicas: 3
+  mode: sidecar
+  ingressHostname: petstore.wso2.com
+  environmentVariables:
+  - LOG_LEVEL=DEBUG
+`
+	assert.Nil(t, manifests.ApplyOverlay([]byte(overlay)))
+	api := manifests.Api
+	assert.Equal(t, "petstore", api.Name, "Name of the API should not be overridden")
+	assert.Equal(t, "pets", api.Labels["team"])
+	assert.Equal(t, 3, api.Spec.Replicas)
+	assert.Equal(t, "petstore-swagger", api.Spec.SwaggerConfigMapName, "Rendered fields should be kept")
+	assert.Equal(t, []string{"petstore-bal-intcpt"}, api.Spec.Definition.Interceptors.Ballerina)
+	assert.Nil(t, ValidateApi(api))
+
+	assert.Nil(t, manifests.ApplyOverlay([]byte(`{"image": "wso2/petstore:v1"}`)), "Overlays with only the spec should be accepted")
+	assert.Equal(t, "wso2/petstore:v1", api.Spec.Image)
+
+	err = manifests.ApplyOverlay([]byte("spec:\n  hpa:\n    maxReplicas: 5\n"))
+	assert.NotNil(t, err, "Fields not in the API custom resource should not be accepted")
+	assert.Contains(t, err.Error(), "hpa")
+}
+
+func TestValidateApi(t *testing.T) {
+	manifests, err := NewApiManifests("petstore", "wso2", testProjectPath, "")
+	assert.Nil(t, err)
+	api := manifests.Api
+	assert.Nil(t, ValidateApi(api))
+
+	api.Spec.Mode = "invalid"
+	api.Spec.Replicas = -1
+	api.Spec.EnvironmentVariables = []string{"LOG_LEVEL", "1KEY=value"}
+	api.Spec.IngressHostname = "Petstore_Host"
+	api.Spec.ApiEndPoint = "petstore"
+	api.Spec.Definition.Interceptors.Java = []string{"Invalid_Name"}
+	err = ValidateApi(api)
+	assert.NotNil(t, err)
+	for _, field := range []string{"spec.mode", "spec.replicas", "spec.environmentVariables", "spec.ingressHostname",
+		"spec.apiEndPoint", "spec.definition.interceptors.java"} {
+		assert.Contains(t, err.Error(), field)
+	}
+}
@@ -0,0 +1,387 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Phases of an API deployed with the API Operator
+const (
+	ApiPhasePending     = "Pending"
+	ApiPhaseBuilding    = "Building"
+	ApiPhaseProgressing = "Progressing"
+	ApiPhaseReady       = "Ready"
+	ApiPhaseFailed      = "Failed"
+)
+
+const (
+	apiStatusNameHeader      = "NAME"
+	apiStatusNamespaceHeader = "NAMESPACE"
+	apiStatusPhaseHeader     = "STATUS"
+	apiStatusReadyHeader     = "READY"
+	apiStatusMessageHeader   = "MESSAGE"
+
+	defaultApiStatusTableFormat = "table {{.Name}}\t{{.Namespace}}\t{{.Phase}}\t{{.Ready}}\t{{.Message}}"
+
+	// suffix of the name of the job building the docker image of an API
+	apiImageBuildJobSuffix = "-kaniko"
+)
+
+// apiStatusPollInterval is the interval between the status checks while waiting for an API
+var apiStatusPollInterval = 2 * time.Second
+
+// pod container waiting reasons which do not recover without a change
+var failedContainerReasons = []string{"CrashLoopBackOff", "ImagePullBackOff", "ErrImagePull", "InvalidImageName",
+	"CreateContainerConfigError", "CreateContainerError"}
+
+// ApiStatus is the status of an API and the kubernetes resources generated for it by the API Operator
+type ApiStatus struct {
+	Name      string
+	Namespace string
+	// Phase of the API: Pending, Building, Progressing, Ready or Failed
+	Phase   string
+	Message string
+	// Replicas is the replica count in the status of the API custom resource
+	Replicas   int
+	Deployment *appsv1.Deployment
+	Service    *corev1.Service
+	Hpa        *HpaStatus
+	Pods       []corev1.Pod
+}
+
+// HpaStatus is the status of the horizontal pod autoscaler of an API
+type HpaStatus struct {
+	Name            string
+	MinReplicas     int32
+	MaxReplicas     int32
+	CurrentReplicas int32
+	DesiredReplicas int32
+}
+
+// Ready returns the ready and desired replica count of the API deployment
+func (s *ApiStatus) Ready() string {
+	if s.Deployment == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", s.Deployment.Status.ReadyReplicas, desiredReplicas(s.Deployment))
+}
+
+// Done returns whether the API reached a final phase
+func (s *ApiStatus) Done() bool {
+	return s.Phase == ApiPhaseReady || s.Phase == ApiPhaseFailed
+}
+
+// GetApiStatus reads the status of an API from the API custom resource, the generated deployment, service,
+// horizontal pod autoscaler and pods
+// @param client : Client to the cluster
+// @param namespace : Namespace of the API
+// @param name : Name of the API
+// @return status, error if the API is not found
+func GetApiStatus(client *K8sClient, namespace, name string) (*ApiStatus, error) {
+	namespace = client.ResolveNamespace(namespace)
+	api, err := client.Dynamic.Resource(ApiGVR).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting API %s in the namespace %s: %v", name, namespace, err)
+	}
+	return getApiStatus(client, api)
+}
+
+// ListApiStatuses reads the statuses of all the APIs in a namespace
+// @param client : Client to the cluster
+// @param namespace : Namespace of the APIs
+// @return statuses sorted by name, error
+func ListApiStatuses(client *K8sClient, namespace string) ([]*ApiStatus, error) {
+	namespace = client.ResolveNamespace(namespace)
+	apis, err := client.Dynamic.Resource(ApiGVR).Namespace(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing APIs in the namespace %s: %v", namespace, err)
+	}
+	statuses := make([]*ApiStatus, 0, len(apis.Items))
+	for i := range apis.Items {
+		status, err := getApiStatus(client, &apis.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
+
+// WaitForApiReady polls the status of an API until it is ready, failed or the timeout is reached
+// @param client : Client to the cluster
+// @param namespace : Namespace of the API
+// @param name : Name of the API
+// @param sinceGeneration : Generation of the API deployment before an update. The API is not considered ready until
+// the deployment is updated to a newer generation. 0 to accept the current deployment
+// @param timeout : Maximum time to wait
+// @param onChange : Called with the status when the phase or the ready replica count changes. Ignored if nil
+// @return last status, error if the API failed, is not ready within the timeout or not found
+func WaitForApiReady(client *K8sClient, namespace, name string, sinceGeneration int64, timeout time.Duration,
+	onChange func(*ApiStatus)) (*ApiStatus, error) {
+	deadline := time.Now().Add(timeout)
+	lastState := ""
+	for {
+		status, err := GetApiStatus(client, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		if status.Phase == ApiPhaseReady && status.Deployment.Generation <= sinceGeneration {
+			status.Phase, status.Message = ApiPhaseProgressing, "waiting for the API Operator to update the deployment"
+		}
+		if state := status.Phase + status.Ready() + status.Message; state != lastState && onChange != nil {
+			onChange(status)
+			lastState = state
+		}
+		switch status.Phase {
+		case ApiPhaseReady:
+			return status, nil
+		case ApiPhaseFailed:
+			return status, fmt.Errorf("API %s failed: %s", name, status.Message)
+		}
+		if !time.Now().Before(deadline) {
+			return status, fmt.Errorf("API %s is not ready within %v. Last status: %s", name, timeout,
+				status.Phase)
+		}
+		time.Sleep(apiStatusPollInterval)
+	}
+}
+
+// getApiStatus reads the status of the resources generated for an API custom resource
+func getApiStatus(client *K8sClient, api *unstructured.Unstructured) (*ApiStatus, error) {
+	status := &ApiStatus{Name: api.GetName(), Namespace: api.GetNamespace()}
+	replicas, _, _ := unstructured.NestedInt64(api.Object, "status", "replicas")
+	status.Replicas = int(replicas)
+	ctx := context.TODO()
+
+	// resources generated by the API Operator have the name of the API
+	deployment, err := client.Clientset.AppsV1().Deployments(status.Namespace).Get(ctx, status.Name,
+		metav1.GetOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("error getting deployment of the API %s: %v", status.Name, err)
+	}
+	if err == nil {
+		status.Deployment = deployment
+	}
+
+	service, err := client.Clientset.CoreV1().Services(status.Namespace).Get(ctx, status.Name, metav1.GetOptions{})
+	if err == nil {
+		status.Service = service
+	}
+
+	hpa, err := client.Clientset.AutoscalingV1().HorizontalPodAutoscalers(status.Namespace).Get(ctx, status.Name,
+		metav1.GetOptions{})
+	if err == nil {
+		status.Hpa = &HpaStatus{Name: hpa.Name, MaxReplicas: hpa.Spec.MaxReplicas,
+			CurrentReplicas: hpa.Status.CurrentReplicas, DesiredReplicas: hpa.Status.DesiredReplicas}
+		if hpa.Spec.MinReplicas != nil {
+			status.Hpa.MinReplicas = *hpa.Spec.MinReplicas
+		}
+	}
+
+	pods, err := client.Clientset.CoreV1().Pods(status.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=" + status.Name})
+	if err == nil {
+		status.Pods = pods.Items
+		sort.Slice(status.Pods, func(i, j int) bool { return status.Pods[i].Name < status.Pods[j].Name })
+	}
+
+	var buildJob *batchv1.Job
+	job, err := client.Clientset.BatchV1().Jobs(status.Namespace).Get(ctx, status.Name+apiImageBuildJobSuffix,
+		metav1.GetOptions{})
+	if err == nil {
+		buildJob = job
+	}
+
+	status.Phase, status.Message = resolveApiPhase(status, buildJob)
+	return status, nil
+}
+
+// resolveApiPhase resolves the phase of an API from the status of its image build job, deployment and pods
+func resolveApiPhase(status *ApiStatus, buildJob *batchv1.Job) (string, string) {
+	if buildJob != nil {
+		if buildJob.Status.Active > 0 {
+			return ApiPhaseBuilding, "building the docker image of the API"
+		}
+		for _, condition := range buildJob.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue &&
+				status.Deployment == nil {
+				return ApiPhaseFailed, fmt.Sprintf("building the docker image failed: %s", condition.Message)
+			}
+		}
+	}
+
+	deployment := status.Deployment
+	if deployment == nil {
+		return ApiPhasePending, "waiting for the API Operator to create the deployment"
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse &&
+			condition.Reason == "ProgressDeadlineExceeded" {
+			return ApiPhaseFailed, condition.Message
+		}
+	}
+	for _, pod := range status.Pods {
+		for _, container := range pod.Status.ContainerStatuses {
+			if waiting := container.State.Waiting; waiting != nil && isFailedContainerReason(waiting.Reason) {
+				return ApiPhaseFailed, fmt.Sprintf("pod %s: %s: %s", pod.Name, waiting.Reason, waiting.Message)
+			}
+		}
+	}
+
+	desired := desiredReplicas(deployment)
+	deploymentStatus := deployment.Status
+	if deploymentStatus.ObservedGeneration >= deployment.Generation && desired > 0 &&
+		deploymentStatus.UpdatedReplicas == desired && deploymentStatus.Replicas == desired &&
+		deploymentStatus.AvailableReplicas == desired {
+		return ApiPhaseReady, ""
+	}
+	return ApiPhaseProgressing, fmt.Sprintf("%d of %d updated replicas are available",
+		deploymentStatus.AvailableReplicas, desired)
+}
+
+// PrintApiStatuses prints the statuses of APIs as a table
+func PrintApiStatuses(statuses []*ApiStatus, format string) {
+	if format == "" {
+		format = defaultApiStatusTableFormat
+	}
+	statusContext := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, status := range statuses {
+			if err := t.Execute(w, status); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Name":      apiStatusNameHeader,
+		"Namespace": apiStatusNamespaceHeader,
+		"Phase":     apiStatusPhaseHeader,
+		"Ready":     apiStatusReadyHeader,
+		"Message":   apiStatusMessageHeader,
+	}
+	if err := statusContext.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// PrintApiStatus prints the detailed status of an API
+func PrintApiStatus(w io.Writer, status *ApiStatus) {
+	fmt.Fprintf(w, "Name:        %s\n", status.Name)
+	fmt.Fprintf(w, "Namespace:   %s\n", status.Namespace)
+	fmt.Fprintf(w, "Status:      %s\n", status.Phase)
+	if status.Message != "" {
+		fmt.Fprintf(w, "Message:     %s\n", status.Message)
+	}
+
+	if deployment := status.Deployment; deployment != nil {
+		fmt.Fprintf(w, "Deployment:  %s (%s ready, %d up-to-date, %d available)\n", deployment.Name, status.Ready(),
+			deployment.Status.UpdatedReplicas, deployment.Status.AvailableReplicas)
+	} else {
+		fmt.Fprintln(w, "Deployment:  <none>")
+	}
+
+	if service := status.Service; service != nil {
+		var ports []string
+		for _, port := range service.Spec.Ports {
+			ports = append(ports, fmt.Sprintf("%s:%d", port.Name, port.Port))
+		}
+		var externalIPs []string
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				externalIPs = append(externalIPs, ingress.IP)
+			} else {
+				externalIPs = append(externalIPs, ingress.Hostname)
+			}
+		}
+		externalIPs = append(externalIPs, service.Spec.ExternalIPs...)
+		if len(externalIPs) == 0 {
+			externalIPs = []string{"<none>"}
+		}
+		fmt.Fprintf(w, "Service:     %s (%s, cluster IP: %s, external IP: %s, ports: %s)\n", service.Name,
+			service.Spec.Type, service.Spec.ClusterIP, strings.Join(externalIPs, ","), strings.Join(ports, ","))
+	} else {
+		fmt.Fprintln(w, "Service:     <none>")
+	}
+
+	if hpa := status.Hpa; hpa != nil {
+		fmt.Fprintf(w, "HPA:         %s (min: %d, max: %d, current: %d, desired: %d)\n", hpa.Name, hpa.MinReplicas,
+			hpa.MaxReplicas, hpa.CurrentReplicas, hpa.DesiredReplicas)
+	} else {
+		fmt.Fprintln(w, "HPA:         <none>")
+	}
+
+	if len(status.Pods) == 0 {
+		fmt.Fprintln(w, "Pods:        <none>")
+		return
+	}
+	fmt.Fprintln(w, "Pods:")
+	for _, pod := range status.Pods {
+		ready, restarts := 0, int32(0)
+		podStatus := string(pod.Status.Phase)
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.Ready {
+				ready++
+			}
+			restarts += container.RestartCount
+			if container.State.Waiting != nil && container.State.Waiting.Reason != "" {
+				podStatus = container.State.Waiting.Reason
+			}
+		}
+		fmt.Fprintf(w, "  %s\t%d/%d ready\t%s\t%d restarts\n", pod.Name, ready, len(pod.Spec.Containers),
+			podStatus, restarts)
+	}
+}
+
+// desiredReplicas returns the replica count of a deployment
+func desiredReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas != nil {
+		return *deployment.Spec.Replicas
+	}
+	return 1
+}
+
+// isFailedContainerReason checks whether a container waiting reason is a failure
+func isFailedContainerReason(reason string) bool {
+	for _, failedReason := range failedContainerReasons {
+		if reason == failedReason {
+			return true
+		}
+	}
+	return false
+}
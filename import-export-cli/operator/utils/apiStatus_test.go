@@ -0,0 +1,145 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestApi(name string) *unstructured.Unstructured {
+	api := &unstructured.Unstructured{}
+	api.SetAPIVersion(ApiGVR.GroupVersion().String())
+	api.SetKind("API")
+	api.SetName(name)
+	api.SetNamespace("wso2")
+	return api
+}
+
+func newTestDeployment(name string, generation int64, replicas, available int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "wso2", Generation: generation},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: generation, Replicas: replicas,
+			UpdatedReplicas: replicas, ReadyReplicas: available, AvailableReplicas: available},
+	}
+}
+
+func newStatusTestClient(objects ...runtime.Object) *K8sClient {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(ApiGVR.GroupVersion().WithKind("APIList"), &unstructured.UnstructuredList{})
+	return NewK8sClient(fake.NewSimpleClientset(objects...),
+		dynamicFake.NewSimpleDynamicClient(scheme, newTestApi("petstore"), newTestApi("inventory")), nil, "wso2")
+}
+
+func TestGetApiStatusReady(t *testing.T) {
+	client := newStatusTestClient(
+		newTestDeployment("petstore", 2, 2, 2),
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "petstore", Namespace: "wso2"},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, Ports: []corev1.ServicePort{
+				{Name: "https", Port: 9095}}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "petstore-1", Namespace: "wso2",
+			Labels: map[string]string{"app": "petstore"}}},
+	)
+
+	status, err := GetApiStatus(client, "", "petstore")
+	assert.Nil(t, err)
+	assert.Equal(t, ApiPhaseReady, status.Phase)
+	assert.Equal(t, "2/2", status.Ready())
+	assert.NotNil(t, status.Service)
+	assert.Nil(t, status.Hpa)
+	assert.Equal(t, 1, len(status.Pods))
+
+	var out bytes.Buffer
+	PrintApiStatus(&out, status)
+	assert.Contains(t, out.String(), "Status:      Ready")
+	assert.Contains(t, out.String(), "https:9095")
+
+	_, err = GetApiStatus(client, "", "unknown")
+	assert.NotNil(t, err, "Getting the status of an unknown API should fail")
+}
+
+func TestGetApiStatusPhases(t *testing.T) {
+	status, err := GetApiStatus(newStatusTestClient(), "", "petstore")
+	assert.Nil(t, err)
+	assert.Equal(t, ApiPhasePending, status.Phase, "API should be pending until the deployment is created")
+
+	buildJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "petstore-kaniko", Namespace: "wso2"},
+		Status: batchv1.JobStatus{Active: 1}}
+	status, _ = GetApiStatus(newStatusTestClient(buildJob), "", "petstore")
+	assert.Equal(t, ApiPhaseBuilding, status.Phase)
+
+	buildJob.Status = batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+		{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}}
+	status, _ = GetApiStatus(newStatusTestClient(buildJob), "", "petstore")
+	assert.Equal(t, ApiPhaseFailed, status.Phase)
+
+	status, _ = GetApiStatus(newStatusTestClient(newTestDeployment("petstore", 1, 2, 1)), "", "petstore")
+	assert.Equal(t, ApiPhaseProgressing, status.Phase)
+
+	crashingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "petstore-1", Namespace: "wso2", Labels: map[string]string{"app": "petstore"}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}}},
+	}
+	status, _ = GetApiStatus(newStatusTestClient(newTestDeployment("petstore", 1, 2, 1), crashingPod), "",
+		"petstore")
+	assert.Equal(t, ApiPhaseFailed, status.Phase)
+	assert.Contains(t, status.Message, "CrashLoopBackOff")
+}
+
+func TestListApiStatuses(t *testing.T) {
+	statuses, err := ListApiStatuses(newStatusTestClient(newTestDeployment("petstore", 1, 1, 1)), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(statuses))
+	assert.Equal(t, "inventory", statuses[0].Name, "APIs should be sorted by name")
+	assert.Equal(t, ApiPhasePending, statuses[0].Phase)
+	assert.Equal(t, ApiPhaseReady, statuses[1].Phase)
+}
+
+func TestWaitForApiReady(t *testing.T) {
+	apiStatusPollInterval = 10 * time.Millisecond
+	defer func() { apiStatusPollInterval = 2 * time.Second }()
+
+	var phases []string
+	onChange := func(status *ApiStatus) { phases = append(phases, status.Phase) }
+	status, err := WaitForApiReady(newStatusTestClient(newTestDeployment("petstore", 3, 1, 1)), "", "petstore",
+		0, time.Second, onChange)
+	assert.Nil(t, err)
+	assert.Equal(t, ApiPhaseReady, status.Phase)
+	assert.Equal(t, []string{ApiPhaseReady}, phases)
+
+	_, err = WaitForApiReady(newStatusTestClient(newTestDeployment("petstore", 3, 1, 1)), "", "petstore",
+		3, 50*time.Millisecond, nil)
+	assert.NotNil(t, err, "Deployments which are not updated should not be considered as ready")
+
+	_, err = WaitForApiReady(newStatusTestClient(), "", "petstore", 0, 50*time.Millisecond, nil)
+	assert.NotNil(t, err, "Waiting should fail after the timeout")
+}
@@ -25,6 +25,7 @@ const K8sApply = "apply"
 const K8sDelete = "delete"
 const K8sRollOut = "rollout"
 const K8sGet = "get"
+const K8sFieldManager = "apictl"
 
 // Kubernetes resources
 const kindKey = "kind"
@@ -41,6 +42,18 @@ const K8sSecretDockerRegType = "docker-registry"
 const ApiOpControllerConfigMap = "controller-config"
 const ApiOperator = "api-operator"
 const ApiOpWso2Namespace = "wso2-system"
+const ApiOpRegistryConfigMap = "docker-registry-config"
+const ApiOpVersionAnnotation = "apictl.wso2.com/api-operator-version"
+
+// Annotations of the API custom resource referencing the configs of an apictl project without a field in the spec
+const ApiCertificatesAnnotation = "apictl.wso2.com/certificate-secrets"
+const ApiDocsAnnotation = "apictl.wso2.com/docs-config-map"
+const ApiOpRolloutTimeoutSec = 300
+
+// Changes made to resources when applying them to the cluster
+const ResourceCreated = "created"
+const ResourceConfigured = "configured"
+const ResourceUnchanged = "unchanged"
 
 // API Operator CRDs
 const ApiOpCrdApi = "apis.wso2.com"
@@ -0,0 +1,447 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sYaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ApiGVR is the group version resource of the API custom resource of the API Operator
+var ApiGVR = schema.GroupVersionResource{Group: "wso2.com", Version: "v1alpha2", Resource: "apis"}
+
+// CrdGroupKind is the group kind of custom resource definitions
+var CrdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: CrdKind}
+
+// NamespaceGroupKind is the group kind of namespaces
+var NamespaceGroupKind = schema.GroupKind{Kind: Namespace}
+
+// ClusterRoleGroupKind is the group kind of cluster roles
+var ClusterRoleGroupKind = schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: ClusterRole}
+
+// ClusterRoleBindingGroupKind is the group kind of cluster role bindings
+var ClusterRoleBindingGroupKind = schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: ClusterRoleBinding}
+
+// KubeContext is the kubeconfig context used to connect to the cluster. The current context is used if empty
+var KubeContext string
+
+// K8sClient is a client to a Kubernetes cluster
+type K8sClient struct {
+	// Clientset to manage the built-in resources
+	Clientset kubernetes.Interface
+	// Dynamic client to manage the custom resources and the resources read from YAML files
+	Dynamic dynamic.Interface
+	// Mapper to resolve the resources of kinds
+	Mapper meta.RESTMapper
+	// Namespace of the kubeconfig context used when a namespace is not specified
+	Namespace string
+}
+
+// k8sClient is the client shared by the Kubernetes commands
+var k8sClient *K8sClient
+
+// k8sClientMutex guards k8sClient, which is read by the APIs of a batch deployed in parallel
+var k8sClientMutex sync.Mutex
+
+// GetK8sClient returns the client to the cluster of the kubeconfig context. The client is created on the first call
+// and shared by the later calls, including concurrent ones
+// @return client, error
+func GetK8sClient() (*K8sClient, error) {
+	k8sClientMutex.Lock()
+	defer k8sClientMutex.Unlock()
+	if k8sClient != nil {
+		return k8sClient, nil
+	}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{CurrentContext: KubeContext},
+	)
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error reading kubeconfig: %v", err)
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, fmt.Errorf("error reading the namespace from kubeconfig: %v", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery()))
+	k8sClient = NewK8sClient(clientset, dynamicClient, mapper, namespace)
+	return k8sClient, nil
+}
+
+// SetK8sClient sets the client shared by the Kubernetes commands. Used to connect to fake clusters in tests
+func SetK8sClient(client *K8sClient) {
+	k8sClientMutex.Lock()
+	defer k8sClientMutex.Unlock()
+	k8sClient = client
+}
+
+// NewK8sClient creates a client using the given clients and mapper
+func NewK8sClient(clientset kubernetes.Interface, dynamicClient dynamic.Interface, mapper meta.RESTMapper,
+	namespace string) *K8sClient {
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return &K8sClient{Clientset: clientset, Dynamic: dynamicClient, Mapper: mapper, Namespace: namespace}
+}
+
+// ResolveNamespace returns the given namespace or the namespace of the kubeconfig context if empty
+func (c *K8sClient) ResolveNamespace(namespace string) string {
+	if namespace == "" {
+		return c.Namespace
+	}
+	return namespace
+}
+
+// ApplyYaml creates or updates all the resources in a YAML or JSON content with multiple documents
+// @param data : Content of the resources
+// @param namespace : Namespace of the namespaced resources which do not specify a namespace
+// @return error
+func (c *K8sClient) ApplyYaml(data []byte, namespace string) error {
+	objects, err := DecodeK8sObjects(data)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		if err = c.Apply(obj, namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply creates a resource or updates it if the resource already exists. The resource is applied with a server side
+// apply, hence the fields not specified in the resource such as the fields set by the cluster or other controllers
+// are kept
+// @param obj : Resource to be applied
+// @param namespace : Namespace of the resource if it is namespaced and does not specify a namespace
+// @return error
+func (c *K8sClient) Apply(obj *unstructured.Unstructured, namespace string) error {
+	resource, err := c.resourceInterface(obj, namespace)
+	if err != nil {
+		return err
+	}
+	applied := obj.DeepCopy()
+	applied.SetResourceVersion("")
+	applied.SetManagedFields(nil)
+	data, err := applied.MarshalJSON()
+	if err != nil {
+		return wrapK8sError(err, "encoding", obj)
+	}
+	// take over the fields applied earlier with kubectl, the resources of apictl are the source of truth
+	force := true
+	_, err = resource.Patch(context.TODO(), obj.GetName(), types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: K8sFieldManager, Force: &force})
+	return wrapK8sError(err, "applying", obj)
+}
+
+// Create creates a resource and fails if the resource already exists
+// @param obj : Resource to be created
+// @param namespace : Namespace of the resource if it is namespaced and does not specify a namespace
+// @return error
+func (c *K8sClient) Create(obj *unstructured.Unstructured, namespace string) error {
+	resource, err := c.resourceInterface(obj, namespace)
+	if err != nil {
+		return err
+	}
+	_, err = resource.Create(context.TODO(), obj, metav1.CreateOptions{})
+	return wrapK8sError(err, "creating", obj)
+}
+
+// Diff returns the change to be made to the cluster by applying a resource: ResourceCreated, ResourceConfigured or
+// ResourceUnchanged. Fields set only in the cluster such as defaults and the status are ignored
+// @param obj : Resource to be applied
+// @param namespace : Namespace of the resource if it is namespaced and does not specify a namespace
+// @return change, error
+func (c *K8sClient) Diff(obj *unstructured.Unstructured, namespace string) (string, error) {
+	gvk := obj.GroupVersionKind()
+	if _, err := c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version); meta.IsNoMatchError(err) {
+		c.ResetMapper()
+		if _, err = c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version); meta.IsNoMatchError(err) {
+			// the resource type is not served yet, hence the resource does not exist
+			return ResourceCreated, nil
+		}
+	}
+	resource, err := c.resourceInterface(obj, namespace)
+	if err != nil {
+		return "", err
+	}
+	existing, err := resource.Get(context.TODO(), obj.GetName(), metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		return ResourceCreated, nil
+	}
+	if err != nil {
+		return "", wrapK8sError(err, "getting", obj)
+	}
+	desired := obj.DeepCopy()
+	unstructured.RemoveNestedField(desired.Object, "status")
+	if isSubset(desired.Object, existing.Object) {
+		return ResourceUnchanged, nil
+	}
+	return ResourceConfigured, nil
+}
+
+// Delete deletes a resource of a kind. Resources which are not found are ignored
+// @param groupKind : Group and kind of the resource
+// @param namespace : Namespace of the resource. Ignored if the resource is cluster scoped
+// @param name : Name of the resource
+// @return error
+func (c *K8sClient) Delete(groupKind schema.GroupKind, namespace, name string) error {
+	mapping, err := c.Mapper.RESTMapping(groupKind)
+	if err != nil {
+		return fmt.Errorf("error resolving the resource type %s: %v", groupKind.String(), err)
+	}
+	var resource dynamic.ResourceInterface = c.Dynamic.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		resource = c.Dynamic.Resource(mapping.Resource).Namespace(c.ResolveNamespace(namespace))
+	}
+	err = resource.Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting %s %s: %v", strings.ToLower(groupKind.Kind), name, err)
+	}
+	return nil
+}
+
+// CreateOrUpdateSecret creates a secret or updates it if the secret already exists
+func (c *K8sClient) CreateOrUpdateSecret(secret *corev1.Secret) error {
+	secrets := c.Clientset.CoreV1().Secrets(c.ResolveNamespace(secret.Namespace))
+	_, err := secrets.Create(context.TODO(), secret, metav1.CreateOptions{})
+	if k8sErrors.IsAlreadyExists(err) {
+		_, err = secrets.Update(context.TODO(), secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("error creating secret %s: %v", secret.Name, err)
+	}
+	return nil
+}
+
+// CreateOrUpdateConfigMap creates a config map or updates it if the config map already exists
+func (c *K8sClient) CreateOrUpdateConfigMap(configMap *corev1.ConfigMap) error {
+	configMaps := c.Clientset.CoreV1().ConfigMaps(c.ResolveNamespace(configMap.Namespace))
+	_, err := configMaps.Create(context.TODO(), configMap, metav1.CreateOptions{})
+	if k8sErrors.IsAlreadyExists(err) {
+		_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("error creating configmap %s: %v", configMap.Name, err)
+	}
+	return nil
+}
+
+// IsResourceTypeAvailable checks whether a resource type is served by the cluster
+// @param resourceType : Resource type in the format <plural>.<group> (eg: apis.wso2.com) or <plural> for the core group
+// @return true if the resource type is available, error
+func (c *K8sClient) IsResourceTypeAvailable(resourceType string) (bool, error) {
+	resource := schema.ParseGroupResource(resourceType)
+	_, resourceLists, err := c.Clientset.Discovery().ServerGroupsAndResources()
+	if err != nil && len(resourceLists) == 0 {
+		return false, err
+	}
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil || groupVersion.Group != resource.Group {
+			continue
+		}
+		for _, apiResource := range resourceList.APIResources {
+			if apiResource.Name == resource.Resource {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ResetMapper clears the cached resource types so that the newly created custom resource definitions are resolved
+func (c *K8sClient) ResetMapper() {
+	if resettable, ok := c.Mapper.(interface{ Reset() }); ok {
+		resettable.Reset()
+	}
+}
+
+// resourceInterface returns the dynamic client of the resource type of an object
+func (c *K8sClient) resourceInterface(obj *unstructured.Unstructured, namespace string) (dynamic.ResourceInterface,
+	error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		// the resource type may be a newly created custom resource definition
+		c.ResetMapper()
+		mapping, err = c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error resolving the resource type of %s %s: %v", gvk.Kind, obj.GetName(), err)
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return c.Dynamic.Resource(mapping.Resource), nil
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(c.ResolveNamespace(namespace))
+	}
+	return c.Dynamic.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+}
+
+// isSubset returns true if all the fields in desired have the same values in actual. Null fields in desired are
+// ignored and items of lists are compared in order
+func isSubset(desired, actual interface{}) bool {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual == nil && len(desiredValue) == 0
+		}
+		for key, value := range desiredValue {
+			if !isSubset(value, actualValue[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok {
+			return actual == nil && len(desiredValue) == 0
+		}
+		if len(desiredValue) != len(actualValue) {
+			return false
+		}
+		for i := range desiredValue {
+			if !isSubset(desiredValue[i], actualValue[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return true
+	default:
+		// numbers can be decoded as int64 or float64
+		return actual != nil && fmt.Sprint(desired) == fmt.Sprint(actual)
+	}
+}
+
+// DecodeK8sObjects decodes the resources in a YAML or JSON content with multiple documents. Items of lists are
+// returned as separate resources
+// @param data : Content of the resources
+// @return resources, error
+func DecodeK8sObjects(data []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	decoder := k8sYaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		raw := make(map[string]interface{})
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("error decoding kubernetes resources: %v", err)
+		}
+		if len(raw) == 0 {
+			// empty documents
+			continue
+		}
+		obj := &unstructured.Unstructured{Object: raw}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, err
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+			continue
+		}
+		if obj.GetKind() == "" {
+			return nil, fmt.Errorf("error decoding kubernetes resources: kind is not specified in %v", raw)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// ToUnstructured converts a typed resource to an unstructured resource
+func ToUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// NewDockerRegistrySecret creates a secret of type docker-registry with the given credentials
+// @param name : Name of the secret
+// @param namespace : Namespace of the secret
+// @param server : Server URL of the docker registry
+// @param username : Username of the docker registry
+// @param password : Password of the docker registry
+// @return secret, error
+func NewDockerRegistrySecret(name, namespace, server, username, password string) (*corev1.Secret, error) {
+	dockerConfig := map[string]interface{}{
+		"auths": map[string]interface{}{
+			server: map[string]string{
+				"username": username,
+				"password": password,
+				"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	}
+	dockerConfigJson, err := json.Marshal(dockerConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: dockerConfigJson},
+	}, nil
+}
+
+// wrapK8sError adds the operation and the resource to an error returned by the cluster
+func wrapK8sError(err error, operation string, obj *unstructured.Unstructured) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("error %s %s %s: %v", operation, strings.ToLower(obj.GetKind()), obj.GetName(), err)
+}
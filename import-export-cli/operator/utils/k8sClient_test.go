@@ -0,0 +1,197 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+const testApiCrs = `
+apiVersion: wso2.com/v1alpha2
+kind: API
+metadata:
+  name: petstore
+spec:
+  swaggerConfigMapName: petstore-swagger
+---
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: petstore-swagger
+    namespace: wso2
+  data:
+    swagger.yaml: "openapi: 3.0.0"
+`
+
+func newFakeK8sClient() *K8sClient {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Version: "v1"}, ApiGVR.GroupVersion()})
+	mapper.Add(ApiGVR.GroupVersion().WithKind("API"), meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: Namespace}, meta.RESTScopeRoot)
+	dynamicClient := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
+	dynamicClient.PrependReactor("patch", "*", applyPatchReactor(dynamicClient.ReactionChain))
+	return NewK8sClient(fake.NewSimpleClientset(), dynamicClient, mapper, "")
+}
+
+// applyPatchReactor emulates server side apply which is not supported by the fake dynamic client. Missing resources
+// are created and existing resources are merge patched using the given reactors
+func applyPatchReactor(reactors []k8sTesting.Reactor) k8sTesting.ReactionFunc {
+	invoke := func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		for _, reactor := range reactors {
+			if reactor.Handles(action) {
+				return reactor.React(action)
+			}
+		}
+		return false, nil, nil
+	}
+	return func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8sTesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		_, _, err := invoke(k8sTesting.NewGetAction(patch.GetResource(), patch.GetNamespace(), patch.GetName()))
+		if k8sErrors.IsNotFound(err) {
+			obj := &unstructured.Unstructured{}
+			if err = obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+				return true, nil, err
+			}
+			return invoke(k8sTesting.NewCreateAction(patch.GetResource(), patch.GetNamespace(), obj))
+		}
+		return invoke(k8sTesting.NewPatchAction(patch.GetResource(), patch.GetNamespace(), patch.GetName(),
+			types.MergePatchType, patch.GetPatch()))
+	}
+}
+
+func TestDecodeK8sObjects(t *testing.T) {
+	objects, err := DecodeK8sObjects([]byte(testApiCrs))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objects), "Empty documents should be skipped and list items should be flattened")
+	assert.Equal(t, "API", objects[0].GetKind())
+	assert.Equal(t, "ConfigMap", objects[1].GetKind())
+	assert.Equal(t, "wso2", objects[1].GetNamespace())
+
+	_, err = DecodeK8sObjects([]byte("metadata:\n  name: petstore\n"))
+	assert.NotNil(t, err, "Resources without a kind should not be accepted")
+}
+
+func TestApplyCreatesAndUpdatesResources(t *testing.T) {
+	client := newFakeK8sClient()
+	assert.Nil(t, client.ApplyYaml([]byte(testApiCrs), ""))
+
+	api, err := client.Dynamic.Resource(ApiGVR).Namespace(metav1.NamespaceDefault).
+		Get(context.TODO(), "petstore", metav1.GetOptions{})
+	assert.Nil(t, err, "Resources without a namespace should be created in the default namespace")
+
+	api.Object["status"] = map[string]interface{}{"replicas": "1"}
+	api, err = client.Dynamic.Resource(ApiGVR).Namespace(metav1.NamespaceDefault).
+		Update(context.TODO(), api, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	objects, _ := DecodeK8sObjects([]byte(testApiCrs))
+	objects[0].Object["spec"] = map[string]interface{}{"swaggerConfigMapName": "petstore-swagger-v2"}
+	assert.Nil(t, client.Apply(objects[0], ""))
+
+	api, err = client.Dynamic.Resource(ApiGVR).Namespace(metav1.NamespaceDefault).
+		Get(context.TODO(), "petstore", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "petstore-swagger-v2", api.Object["spec"].(map[string]interface{})["swaggerConfigMapName"])
+	assert.Equal(t, map[string]interface{}{"replicas": "1"}, api.Object["status"],
+		"Fields set only in the cluster should be kept")
+
+	assert.NotNil(t, client.Create(api, ""), "Creating an existing resource should fail")
+}
+
+func TestDeleteIgnoresMissingResources(t *testing.T) {
+	client := newFakeK8sClient()
+	assert.Nil(t, client.ApplyYaml([]byte(testApiCrs), ""))
+
+	apiGroupKind := schema.GroupKind{Group: ApiGVR.Group, Kind: "API"}
+	assert.Nil(t, client.Delete(apiGroupKind, "", "petstore"))
+	_, err := client.Dynamic.Resource(ApiGVR).Namespace(metav1.NamespaceDefault).
+		Get(context.TODO(), "petstore", metav1.GetOptions{})
+	assert.NotNil(t, err)
+
+	assert.Nil(t, client.Delete(apiGroupKind, "", "petstore"))
+	assert.NotNil(t, client.Delete(schema.GroupKind{Kind: "Unknown"}, "", "petstore"),
+		"Deleting unknown resource types should fail")
+}
+
+func TestCreateOrUpdateSecret(t *testing.T) {
+	client := newFakeK8sClient()
+	secret, err := NewDockerRegistrySecret("registry", "wso2", "https://index.docker.io/v1/", "admin", "pass")
+	assert.Nil(t, err)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+
+	dockerConfig := struct {
+		Auths map[string]struct {
+			Username string
+			Password string
+			Auth     string
+		}
+	}{}
+	assert.Nil(t, json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &dockerConfig))
+	assert.Equal(t, "admin", dockerConfig.Auths["https://index.docker.io/v1/"].Username)
+	assert.Equal(t, "YWRtaW46cGFzcw==", dockerConfig.Auths["https://index.docker.io/v1/"].Auth)
+
+	assert.Nil(t, client.CreateOrUpdateSecret(secret))
+	secret, _ = NewDockerRegistrySecret("registry", "wso2", "https://index.docker.io/v1/", "admin", "newPass")
+	assert.Nil(t, client.CreateOrUpdateSecret(secret), "Existing secrets should be updated")
+
+	saved, err := client.Clientset.CoreV1().Secrets("wso2").Get(context.TODO(), "registry", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, secret.Data, saved.Data)
+}
+
+func TestIsResourceTypeAvailable(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps"}}},
+		{GroupVersion: "wso2.com/v1alpha2", APIResources: []metav1.APIResource{{Name: "apis"}}},
+	}
+	client := NewK8sClient(clientset, nil, nil, "")
+
+	available, err := client.IsResourceTypeAvailable(ApiOpCrdApi)
+	assert.Nil(t, err)
+	assert.True(t, available)
+
+	available, _ = client.IsResourceTypeAvailable("configmaps")
+	assert.True(t, available)
+
+	available, _ = client.IsResourceTypeAvailable(ApiOpCrdSecurity)
+	assert.False(t, available)
+}
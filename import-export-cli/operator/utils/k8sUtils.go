@@ -19,41 +19,90 @@
 package utils
 
 import (
-	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
-	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// K8sWaitForResourceType waits until all the given resource types are served by the cluster
+// @param maxTimeSec : Maximum time to wait in seconds
+// @param resourceTypes : Resource types in the format <plural>.<group>. Eg: apis.wso2.com
+// @return error if the resource types are not available within the given time
 func K8sWaitForResourceType(maxTimeSec int, resourceTypes ...string) error {
 	if maxTimeSec < 0 {
 		return errors.New("'maxTimeSec' should be non negative")
 	}
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
 
-	noErrors := false
-	for i := maxTimeSec; i > 0 && !noErrors; i-- {
+	var unavailable []string
+	for i := maxTimeSec; i >= 0; i-- {
+		unavailable = nil
 		for _, resourceType := range resourceTypes {
-			noErrors = true
-			if err := ExecuteCommandWithoutPrintingErrors(Kubectl, K8sGet, resourceType); err != nil {
-				noErrors = false
-				continue
+			available, err := client.IsResourceTypeAvailable(resourceType)
+			if err != nil {
+				utils.Logln(utils.LogPrefixWarning+"Error checking resource type "+resourceType+":", err)
+			}
+			if !available {
+				unavailable = append(unavailable, resourceType)
 			}
 		}
-
-		time.Sleep(1e9) // sleep 1 second
+		if len(unavailable) == 0 {
+			client.ResetMapper()
+			return nil
+		}
+		if i > 0 {
+			time.Sleep(1e9) // sleep 1 second
+		}
 	}
+	return errors.New("kubernetes resources not installed: " + strings.Join(unavailable, ", "))
+}
 
-	if !noErrors {
-		return errors.New("kubernetes resources not installed")
+// K8sWaitForDeployment waits until all the replicas of a deployment are updated and available
+// @param name : Name of the deployment
+// @param namespace : Namespace of the deployment
+// @param maxTimeSec : Maximum time to wait in seconds
+// @return error if the deployment is not rolled out within the given time
+func K8sWaitForDeployment(name, namespace string, maxTimeSec int) error {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
 	}
-
-	return nil
+	for i := maxTimeSec; i >= 0; i-- {
+		deployment, err := client.Clientset.AppsV1().Deployments(client.ResolveNamespace(namespace)).Get(
+			context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting deployment %s: %v", name, err)
+		}
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		status := deployment.Status
+		if status.ObservedGeneration >= deployment.Generation && status.UpdatedReplicas == replicas &&
+			status.AvailableReplicas == replicas {
+			fmt.Printf("deployment %q successfully rolled out\n", name)
+			return nil
+		}
+		if i > 0 {
+			time.Sleep(1e9) // sleep 1 second
+		}
+	}
+	return fmt.Errorf("deployment %s is not rolled out within %d seconds", name, maxTimeSec)
 }
 
 // K8sCreateSecretFromInputs creates K8S a docker-registry secret with given inputs
@@ -62,140 +111,246 @@ func K8sCreateSecretFromInputs(secretName string, namespace string, server strin
 		username = "N/A"
 		password = "N/A"
 	}
-	dockerSecret, err := GetCommandOutput(
-		Kubectl, K8sCreate, Secret, K8sSecretDockerRegType, secretName,
-		"--docker-server", server,
-		"--docker-username", username,
-		"--docker-password", password,
-		"-n", namespace,
-		"--dry-run", "-o", "yaml",
-	)
-
+	dockerSecret, err := NewDockerRegistrySecret(secretName, namespace, server, username, password)
 	if err != nil {
 		utils.HandleErrorAndExit("Error rendering kubernetes secret for Docker Hub", err)
 	}
 
-	// apply created secret yaml file
-	if err := K8sApplyFromStdin(dockerSecret); err != nil {
+	client, err := GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+	}
+	if err := client.CreateOrUpdateSecret(dockerSecret); err != nil {
 		utils.HandleErrorAndExit("Error creating docker secret credentials", err)
 	}
 }
 
 // K8sCreateSecretFromFile creates K8S a generic secret with give file
 func K8sCreateSecretFromFile(secretName string, namespace string, filePath string, renamedFile string) {
-	var fromFile string
-	if renamedFile == "" {
-		fromFile = fmt.Sprintf("--from-file=%s", filePath)
-	} else {
-		fromFile = fmt.Sprintf("--from-file=%s=%s", renamedFile, filePath)
-	}
-
-	// render secret
-	secret, err := GetCommandOutput(
-		Kubectl, K8sCreate, Secret, "generic",
-		secretName, fromFile,
-		"-n", namespace,
-		"--dry-run", "-o", "yaml",
-	)
+	key, data, err := readK8sDataFile(filePath, renamedFile)
 	if err != nil {
 		utils.HandleErrorAndExit("Error creating secret from file", err)
 	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{key: data},
+	}
 
-	// apply secret
-	if err = K8sApplyFromStdin(secret); err != nil {
+	client, err := GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+	}
+	if err = client.CreateOrUpdateSecret(secret); err != nil {
 		utils.HandleErrorAndExit("Error creating secret from file", err)
 	}
 }
 
-// K8sApplyFromFile applies resources from list of files, urls or directories
-func K8sApplyFromFile(fileList ...string) error {
-	kubectlArgs := []string{K8sApply}
-	for _, file := range fileList {
-		kubectlArgs = append(kubectlArgs, "-f", file)
+// K8sCreateConfigMapFromFile creates a config map with the content of a file or updates it if it already exists
+// @param configMapName : Name of the config map
+// @param namespace : Namespace of the config map
+// @param filePath : Path to the file
+// @param renamedFile : Key of the file in the config map. Name of the file is used if empty
+// @return error
+func K8sCreateConfigMapFromFile(configMapName, namespace, filePath, renamedFile string) error {
+	key, data, err := readK8sDataFile(filePath, renamedFile)
+	if err != nil {
+		return err
 	}
-
-	return ExecuteCommand(Kubectl, kubectlArgs...)
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+	return client.CreateOrUpdateConfigMap(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: namespace},
+		Data:       map[string]string{key: string(data)},
+	})
 }
 
-// K8sApplyFromBytes applies resources by content
-func K8sApplyFromBytes(data [][]byte) error {
-	dir, _ := ioutil.TempDir("", "example")
-	defer os.RemoveAll(dir) // clean up
+// K8sCreateConfigMapFromPath creates a new config map with a file or all the files in a directory.
+// Returns an error if the config map already exists
+// @param configMapName : Name of the config map
+// @param namespace : Namespace of the config map
+// @param path : Path to the file or the directory
+// @return error
+func K8sCreateConfigMapFromPath(configMapName, namespace, path string) error {
+	configMap, err := NewConfigMapFromPath(configMapName, namespace, path)
+	if err != nil {
+		return err
+	}
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.Clientset.CoreV1().ConfigMaps(client.ResolveNamespace(namespace)).
+		Create(context.TODO(), configMap, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating configmap %s: %v", configMapName, err)
+	}
+	return nil
+}
 
-	for i, d := range data {
-		tmpFile := filepath.Join(dir, fmt.Sprintf("config-file-%v.yml", i))
-		err := ioutil.WriteFile(tmpFile, d, 0666) // permission -rw-rw-rw
-		if err != nil {
-			return err
+// K8sDeleteConfigMaps deletes the given config maps in a namespace
+func K8sDeleteConfigMaps(namespace string, configMapNames ...string) error {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+	configMaps := client.Clientset.CoreV1().ConfigMaps(client.ResolveNamespace(namespace))
+	for _, name := range configMapNames {
+		err = configMaps.Delete(context.TODO(), name, metav1.DeleteOptions{})
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting configmap %s: %v", name, err)
 		}
 	}
-
-	return ExecuteCommand(Kubectl, K8sApply, "-f", dir)
+	return nil
 }
 
-// K8sApplyFromStdin applies resources from standard input
-func K8sApplyFromStdin(stdInputs string) error {
-	return ExecuteCommandFromStdin(stdInputs, Kubectl, K8sApply, "-f", "-")
+// K8sDeleteSecrets deletes the given secrets in a namespace
+func K8sDeleteSecrets(namespace string, secretNames ...string) error {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+	secrets := client.Clientset.CoreV1().Secrets(client.ResolveNamespace(namespace))
+	for _, name := range secretNames {
+		err = secrets.Delete(context.TODO(), name, metav1.DeleteOptions{})
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting secret %s: %v", name, err)
+		}
+	}
+	return nil
 }
 
-// ExecuteCommand executes the command with args and prints output, errors in standard output, error
-func ExecuteCommand(command string, args ...string) error {
-	cmd := exec.Command(command, args...)
-	setCommandOutAndError(cmd)
-	return cmd.Run()
-}
+// NewConfigMapFromPath renders a config map with a file or all the files in a directory (non recursive).
+// Files that are not valid UTF-8 are added as binary data
+func NewConfigMapFromPath(configMapName, namespace, path string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: namespace},
+		Data:       map[string]string{},
+		BinaryData: map[string][]byte{},
+	}
 
-// ExecuteCommandWithoutPrintingErrors executes the command with args and prints output, standard output
-func ExecuteCommandWithoutPrintingErrors(command string, args ...string) error {
-	cmd := exec.Command(command, args...)
-	setCommandOutOnly(cmd)
-	return cmd.Run()
-}
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if stat.IsDir() {
+		files = nil
+		infos, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.Mode().IsRegular() {
+				files = append(files, filepath.Join(path, info.Name()))
+			}
+		}
+	}
 
-// ExecuteCommandWithoutOutputs executes the command with args without printing outputs and errors
-func ExecuteCommandWithoutOutputs(command string, args ...string) error {
-	cmd := exec.Command(command, args...)
-	return cmd.Run()
+	for _, file := range files {
+		key, data, err := readK8sDataFile(file, "")
+		if err != nil {
+			return nil, err
+		}
+		if utf8.Valid(data) {
+			configMap.Data[key] = string(data)
+		} else {
+			configMap.BinaryData[key] = data
+		}
+	}
+	return configMap, nil
 }
 
-// ExecuteCommandFromStdin executes the command with args and prints output the standard output
-func ExecuteCommandFromStdin(stdInput string, command string, args ...string) error {
-	cmd := exec.Command(command, args...)
-	setCommandOutAndError(cmd)
+// K8sApplyFromFile applies resources from list of files, urls or directories
+func K8sApplyFromFile(fileList ...string) error {
+	var data [][]byte
+	for _, file := range fileList {
+		fileData, err := readK8sResourceFile(file)
+		if err != nil {
+			return err
+		}
+		data = append(data, fileData...)
+	}
+	return K8sApplyFromBytes(data)
+}
 
-	pipe, err := cmd.StdinPipe()
+// K8sApplyFromBytes applies resources by content
+func K8sApplyFromBytes(data [][]byte) error {
+	client, err := GetK8sClient()
 	if err != nil {
 		return err
 	}
-	if _, err = pipe.Write([]byte(stdInput)); err != nil {
-		return err
+	for _, d := range data {
+		if err = client.ApplyYaml(d, ""); err != nil {
+			return err
+		}
 	}
-	if err := pipe.Close(); err != nil {
+	return nil
+}
+
+// K8sDeleteResource deletes a resource of a kind from the cluster. Resources which are not found are ignored
+// @param groupKind : Group and kind of the resource
+// @param namespace : Namespace of the resource. Ignored if the resource is cluster scoped
+// @param name : Name of the resource
+// @return error
+func K8sDeleteResource(groupKind schema.GroupKind, namespace, name string) error {
+	client, err := GetK8sClient()
+	if err != nil {
 		return err
 	}
-
-	return cmd.Run()
+	return client.Delete(groupKind, namespace, name)
 }
 
-// GetCommandOutput executes a command and returns the output
-func GetCommandOutput(command string, args ...string) (string, error) {
-	cmd := exec.Command(command, args...)
-	var errBuf bytes.Buffer
-	cmd.Stderr = io.MultiWriter(os.Stderr, &errBuf)
-
-	output, err := cmd.Output()
-	return string(output), err
+// readK8sDataFile reads a file to be added to a secret or a config map and returns its key and content
+func readK8sDataFile(filePath, renamedFile string) (string, []byte, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", nil, err
+	}
+	key := renamedFile
+	if key == "" {
+		key = filepath.Base(filePath)
+	}
+	return key, data, nil
 }
 
-// setCommandOutAndError sets the output and error of the command cmd to the standard output and error
-func setCommandOutAndError(cmd *exec.Cmd) {
-	var errBuf, outBuf bytes.Buffer
-	cmd.Stderr = io.MultiWriter(os.Stderr, &errBuf)
-	cmd.Stdout = io.MultiWriter(os.Stdout, &outBuf)
-}
+// readK8sResourceFile reads the resources in a URL, a file or the YAML and JSON files in a directory
+func readK8sResourceFile(file string) ([][]byte, error) {
+	if utils.IsValidUrl(file) {
+		data, err := utils.ReadFromUrl(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading resources from URL %s: %v", file, err)
+		}
+		return [][]byte{data}, nil
+	}
+
+	stat, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	if !stat.IsDir() {
+		data, err := ioutil.ReadFile(file)
+		return [][]byte{data}, err
+	}
 
-// setCommandOutOnly sets the output the command cmd to the standard output and not sets the error
-func setCommandOutOnly(cmd *exec.Cmd) {
-	var outBuf bytes.Buffer
-	cmd.Stdout = io.MultiWriter(os.Stdout, &outBuf)
+	files, err := ioutil.ReadDir(file)
+	if err != nil {
+		return nil, err
+	}
+	var data [][]byte
+	for _, f := range files {
+		ext := filepath.Ext(f.Name())
+		if f.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		fileData, err := ioutil.ReadFile(filepath.Join(file, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, fileData)
+	}
+	return data, nil
 }
@@ -0,0 +1,335 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// InstallConfig represents the configuration file used to install or upgrade the API Operator declaratively
+type InstallConfig struct {
+	Version    string            `yaml:"version"`    // Version of the API Operator, ignored if a bundle is given
+	Bundle     string            `yaml:"bundle"`     // Local file or directory with the API Operator configs
+	Registry   *InstallRegistry  `yaml:"registry"`   // Registry to push the built micro-gateway images
+	Controller map[string]string `yaml:"controller"` // Values to override in the controller-config
+}
+
+// InstallRegistry represents the registry configuration of an InstallConfig. Only one of Password, PasswordEnv,
+// PasswordFile and PasswordStdin can be given
+type InstallRegistry struct {
+	Type          string `yaml:"type"`
+	Repository    string `yaml:"repository"`
+	Username      string `yaml:"username"`
+	Password      string `yaml:"password"`
+	PasswordEnv   string `yaml:"passwordEnv"`   // Environment variable to read the password from
+	PasswordFile  string `yaml:"passwordFile"`  // File to read the password from
+	PasswordStdin bool   `yaml:"passwordStdin"` // Prompt for the password in the stdin
+	KeyFile       string `yaml:"keyFile"`
+}
+
+// OperatorInstallation represents an API Operator installed in the cluster
+type OperatorInstallation struct {
+	Namespace string
+	Version   string
+}
+
+// ReadInstallConfig reads and validates an install configuration file. Relative paths in the file are resolved
+// against the directory of the file
+func ReadInstallConfig(path string) (*InstallConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &InstallConfig{}
+	if err = yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("error reading install config %s: %v", path, err)
+	}
+
+	baseDir := filepath.Dir(path)
+	config.Bundle = resolveInstallPath(baseDir, config.Bundle)
+	if config.Registry != nil {
+		config.Registry.KeyFile = resolveInstallPath(baseDir, config.Registry.KeyFile)
+		config.Registry.PasswordFile = resolveInstallPath(baseDir, config.Registry.PasswordFile)
+	}
+	if err = ValidateInstallConfig(config); err != nil {
+		return nil, err
+	}
+	if config.Registry != nil {
+		if err = config.Registry.readPassword(); err != nil {
+			return nil, fmt.Errorf("error reading registry password: %v", err)
+		}
+	}
+	return config, nil
+}
+
+// readPassword sets the password read from the environment variable or the file given for the password. A password
+// given with PasswordStdin is read when the registry is configured
+func (reg *InstallRegistry) readPassword() error {
+	switch {
+	case reg.PasswordEnv != "":
+		reg.Password = os.Getenv(reg.PasswordEnv)
+		if reg.Password == "" {
+			return fmt.Errorf("environment variable %q is not set", reg.PasswordEnv)
+		}
+	case reg.PasswordFile != "":
+		data, err := ioutil.ReadFile(reg.PasswordFile)
+		if err != nil {
+			return err
+		}
+		reg.Password = strings.TrimRight(string(data), "\r\n")
+	}
+	return nil
+}
+
+// ValidateInstallConfig validates an install configuration
+func ValidateInstallConfig(config *InstallConfig) error {
+	var errs []string
+	if config.Bundle != "" {
+		if _, err := os.Stat(config.Bundle); err != nil {
+			errs = append(errs, "bundle: "+err.Error())
+		}
+	}
+	if config.Registry != nil {
+		if config.Registry.Type == "" {
+			errs = append(errs, "registry.type: registry type is required")
+		}
+		passwords := 0
+		for _, given := range []bool{config.Registry.Password != "", config.Registry.PasswordEnv != "",
+			config.Registry.PasswordFile != "", config.Registry.PasswordStdin} {
+			if given {
+				passwords++
+			}
+		}
+		if passwords > 1 {
+			errs = append(errs, "registry: only one of password, passwordEnv, passwordFile and passwordStdin "+
+				"can be given")
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New("invalid install config:\n" + strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// GetApiOperatorInstallation returns the API Operator installed in the cluster or nil if it is not installed
+func GetApiOperatorInstallation() (*OperatorInstallation, error) {
+	client, err := GetK8sClient()
+	if err != nil {
+		return nil, err
+	}
+	binding, err := client.Clientset.RbacV1().ClusterRoleBindings().Get(context.TODO(), ApiOperator,
+		metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster role binding %s: %v", ApiOperator, err)
+	}
+
+	installation := &OperatorInstallation{Namespace: ApiOpWso2Namespace}
+	for _, subject := range binding.Subjects {
+		if subject.Kind == "ServiceAccount" && subject.Name == ApiOperator && subject.Namespace != "" {
+			installation.Namespace = subject.Namespace
+		}
+	}
+
+	deployment, err := client.Clientset.AppsV1().Deployments(installation.Namespace).Get(context.TODO(), ApiOperator,
+		metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting deployment %s: %v", ApiOperator, err)
+	}
+	installation.Version = deployment.Annotations[ApiOpVersionAnnotation]
+	if installation.Version == "" && len(deployment.Spec.Template.Spec.Containers) > 0 {
+		// version of the installations not done with an install config is taken from the image tag
+		installation.Version = getImageVersion(deployment.Spec.Template.Spec.Containers[0].Image)
+	}
+	return installation, nil
+}
+
+// RenderApiOperatorConfigs decodes the API Operator configs and customizes them with the install configuration.
+// The values in the controller-config are overridden. The version of the install configuration is set to the version
+// of the API Operator image if the configs are read from a bundle
+// @param config : Install configuration
+// @param configData : Content of the API Operator configs
+// @return resources, error
+func RenderApiOperatorConfigs(config *InstallConfig, configData [][]byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	for _, data := range configData {
+		decoded, err := DecodeK8sObjects(data)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, decoded...)
+	}
+
+	controllerConfigFound := false
+	for _, obj := range objects {
+		switch {
+		case obj.GetKind() == "ConfigMap" && obj.GetName() == ApiOpControllerConfigMap:
+			controllerConfigFound = true
+			for key, value := range config.Controller {
+				if err := unstructured.SetNestedField(obj.Object, value, "data", key); err != nil {
+					return nil, err
+				}
+			}
+		case obj.GetKind() == "Deployment" && obj.GetName() == ApiOperator:
+			if config.Bundle != "" {
+				version, err := getDeploymentImageVersion(obj)
+				if err != nil {
+					return nil, err
+				}
+				config.Version = version
+			}
+			if config.Version != "" {
+				annotations := obj.GetAnnotations()
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+				annotations[ApiOpVersionAnnotation] = config.Version
+				obj.SetAnnotations(annotations)
+			}
+		}
+	}
+
+	if len(config.Controller) > 0 && !controllerConfigFound {
+		return nil, fmt.Errorf("config map %s is not found in the API Operator configs", ApiOpControllerConfigMap)
+	}
+	return objects, nil
+}
+
+// ApplyApiOperatorConfigs applies the API Operator configs which are not already applied to the cluster.
+// Namespaces and custom resource definitions are applied first and the other resources are applied once the custom
+// resource types are served by the cluster. Each change is printed as "<kind>/<name> <change>"
+// @param objects : Resources to be applied
+// @param dryRun : Only print the changes without applying them
+// @param out : Writer to print the changes
+// @return error
+func ApplyApiOperatorConfigs(objects []*unstructured.Unstructured, dryRun bool, out io.Writer) error {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+
+	var crds, nonCrds []*unstructured.Unstructured
+	var resourceTypes []string
+	for _, obj := range objects {
+		if obj.GetKind() == CrdKind || obj.GetKind() == Namespace {
+			crds = append(crds, obj)
+			if obj.GetKind() == CrdKind {
+				resourceTypes = append(resourceTypes, obj.GetName())
+			}
+		} else {
+			nonCrds = append(nonCrds, obj)
+		}
+	}
+
+	crdsChanged, err := applyChangedResources(client, crds, dryRun, out)
+	if err != nil {
+		return err
+	}
+	if crdsChanged && !dryRun && len(resourceTypes) > 0 {
+		fmt.Fprintln(out, "Waiting for resource creation...")
+		if err = K8sWaitForResourceType(ApiOpRolloutTimeoutSec, resourceTypes...); err != nil {
+			return err
+		}
+	}
+	_, err = applyChangedResources(client, nonCrds, dryRun, out)
+	return err
+}
+
+// applyChangedResources applies the resources which are different in the cluster and returns true if any resource
+// is changed
+func applyChangedResources(client *K8sClient, objects []*unstructured.Unstructured, dryRun bool,
+	out io.Writer) (bool, error) {
+	changed := false
+	for _, obj := range objects {
+		change, err := client.Diff(obj, "")
+		if err != nil {
+			return changed, err
+		}
+		if change != ResourceUnchanged {
+			changed = true
+			if !dryRun {
+				if err = client.Apply(obj, ""); err != nil {
+					return changed, err
+				}
+			}
+		}
+
+		suffix := ""
+		if dryRun {
+			suffix = " (dry run)"
+		}
+		fmt.Fprintf(out, "%s/%s %s%s\n", strings.ToLower(obj.GetKind()), obj.GetName(), change, suffix)
+	}
+	return changed, nil
+}
+
+// getDeploymentImageVersion returns the version of the API Operator from the image of the API Operator deployment
+func getDeploymentImageVersion(obj *unstructured.Unstructured) (string, error) {
+	containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	if err != nil {
+		return "", err
+	}
+	for _, container := range containers {
+		if containerMap, ok := container.(map[string]interface{}); ok && containerMap["name"] == ApiOperator {
+			image, _ := containerMap["image"].(string)
+			return getImageVersion(image), nil
+		}
+	}
+	return "", nil
+}
+
+// getImageVersion returns the version in the tag of an image in the format v<version>. Eg: v1.2.0 for
+// wso2/k8s-api-operator:1.2.0. An empty string is returned if the image does not have a tag
+func getImageVersion(image string) string {
+	if i := strings.LastIndex(image, ":"); i >= 0 && !strings.Contains(image[i:], "/") {
+		return "v" + strings.TrimPrefix(image[i+1:], "v")
+	}
+	return ""
+}
+
+// resolveInstallPath resolves a path in an install configuration against the directory of the configuration
+func resolveInstallPath(baseDir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+// ReadApiOperatorConfigs reads the API Operator configs from a URL, a local file or the YAML files in a local
+// directory
+func ReadApiOperatorConfigs(configFile string) [][]byte {
+	return *readConfigData(configFile)
+}
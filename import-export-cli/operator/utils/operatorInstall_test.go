@@ -0,0 +1,220 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const testOperatorConfigs = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: wso2-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: api-operator
+subjects:
+- kind: ServiceAccount
+  name: api-operator
+  namespace: wso2-system
+roleRef:
+  kind: ClusterRole
+  name: api-operator
+  apiGroup: rbac.authorization.k8s.io
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api-operator
+  namespace: wso2-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: api-operator
+        image: wso2/k8s-api-operator:1.2.0
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: controller-config
+  namespace: wso2-system
+data:
+  kanikoImg: gcr.io/kaniko-project/executor:v0.24.0
+  mgwToolkitImg: wso2am/wso2micro-gw-toolkit:3.2.0
+`
+
+func TestReadInstallConfig(t *testing.T) {
+	assert.Nil(t, os.Setenv("APICTL_TEST_REGISTRY_PASSWORD", "secret"))
+	defer os.Unsetenv("APICTL_TEST_REGISTRY_PASSWORD")
+
+	config, err := ReadInstallConfig(filepath.Join("testdata", "install", "install.yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.2.0", config.Version)
+	assert.Equal(t, "secret", config.Registry.Password, "Password should be read from the environment variable")
+	assert.Equal(t, "registry.local/kaniko-project/executor:v0.24.0", config.Controller["kanikoImg"])
+
+	assert.NotNil(t, ValidateInstallConfig(&InstallConfig{Registry: &InstallRegistry{Type: "DOCKER_HUB",
+		Password: "secret", PasswordStdin: true}}), "Only one password should be accepted")
+	assert.NotNil(t, ValidateInstallConfig(&InstallConfig{Registry: &InstallRegistry{Repository: "wso2"}}),
+		"Registry type should be required")
+	assert.NotNil(t, ValidateInstallConfig(&InstallConfig{Bundle: filepath.Join("testdata", "install", "missing")}),
+		"Missing bundles should not be accepted")
+}
+
+func TestReadInstallConfigPasswordFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "install")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "registry-password"), []byte("secret\n"), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "install.yaml"), []byte(`registry:
+  type: DOCKER_HUB
+  repository: wso2
+  username: wso2
+  passwordFile: registry-password
+`), 0600))
+
+	config, err := ReadInstallConfig(filepath.Join(dir, "install.yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "registry-password"), config.Registry.PasswordFile)
+	assert.Equal(t, "secret", config.Registry.Password, "Password should be read from the file")
+
+	assert.Nil(t, os.Remove(filepath.Join(dir, "registry-password")))
+	_, err = ReadInstallConfig(filepath.Join(dir, "install.yaml"))
+	assert.NotNil(t, err, "Missing password files should not be accepted")
+}
+
+func TestRenderApiOperatorConfigs(t *testing.T) {
+	config := &InstallConfig{
+		Version:    "v1.2.0",
+		Controller: map[string]string{"kanikoImg": "registry.local/kaniko-project/executor:v0.24.0"},
+	}
+	objects, err := RenderApiOperatorConfigs(config, [][]byte{[]byte(testOperatorConfigs)})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(objects))
+
+	assert.Equal(t, ApiOpWso2Namespace, objects[2].GetNamespace())
+	assert.Equal(t, "v1.2.0", objects[2].GetAnnotations()[ApiOpVersionAnnotation])
+
+	data, _, _ := unstructured.NestedStringMap(objects[3].Object, "data")
+	assert.Equal(t, "registry.local/kaniko-project/executor:v0.24.0", data["kanikoImg"])
+	assert.Equal(t, "wso2am/wso2micro-gw-toolkit:3.2.0", data["mgwToolkitImg"])
+
+	_, err = RenderApiOperatorConfigs(config, [][]byte{[]byte(testApiCrs)})
+	assert.NotNil(t, err, "Controller configs should not be overridden without the controller-config")
+}
+
+func TestRenderApiOperatorConfigsFromBundle(t *testing.T) {
+	config := &InstallConfig{Version: "v1.1.0", Bundle: filepath.Join("testdata", "install")}
+	objects, err := RenderApiOperatorConfigs(config, [][]byte{[]byte(testOperatorConfigs)})
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.2.0", config.Version, "Version of a bundle should be taken from the API Operator image")
+	assert.Equal(t, "v1.2.0", objects[2].GetAnnotations()[ApiOpVersionAnnotation])
+}
+
+func TestDiffIgnoresFieldsSetByTheCluster(t *testing.T) {
+	client := newFakeK8sClient()
+	objects, err := DecodeK8sObjects([]byte(testApiCrs))
+	assert.Nil(t, err)
+	configMap := objects[1]
+
+	change, err := client.Diff(configMap, "")
+	assert.Nil(t, err)
+	assert.Equal(t, ResourceCreated, change)
+
+	existing := configMap.DeepCopy()
+	existing.SetLabels(map[string]string{"app": "petstore"})
+	assert.Nil(t, client.Apply(existing, ""))
+	change, err = client.Diff(configMap, "")
+	assert.Nil(t, err)
+	assert.Equal(t, ResourceUnchanged, change)
+
+	assert.Nil(t, unstructured.SetNestedField(configMap.Object, "openapi: 3.0.1", "data", "swagger.yaml"))
+	change, err = client.Diff(configMap, "")
+	assert.Nil(t, err)
+	assert.Equal(t, ResourceConfigured, change)
+}
+
+func TestApplyApiOperatorConfigsIsIdempotent(t *testing.T) {
+	client := newFakeK8sClient()
+	SetK8sClient(client)
+	defer SetK8sClient(nil)
+
+	objects, err := DecodeK8sObjects([]byte(testApiCrs))
+	assert.Nil(t, err)
+	configMap := objects[1]
+
+	out := &bytes.Buffer{}
+	assert.Nil(t, ApplyApiOperatorConfigs([]*unstructured.Unstructured{configMap}, true, out))
+	assert.Equal(t, "configmap/petstore-swagger created (dry run)\n", out.String())
+	configMapGVR := corev1.SchemeGroupVersion.WithResource("configmaps")
+	_, err = client.Dynamic.Resource(configMapGVR).Namespace("wso2").
+		Get(context.TODO(), "petstore-swagger", metav1.GetOptions{})
+	assert.NotNil(t, err, "Resources should not be created in dry run")
+
+	out.Reset()
+	assert.Nil(t, ApplyApiOperatorConfigs([]*unstructured.Unstructured{configMap}, false, out))
+	assert.Equal(t, "configmap/petstore-swagger created\n", out.String())
+
+	out.Reset()
+	assert.Nil(t, ApplyApiOperatorConfigs([]*unstructured.Unstructured{configMap}, false, out))
+	assert.Equal(t, "configmap/petstore-swagger unchanged\n", out.String())
+}
+
+func TestGetApiOperatorInstallation(t *testing.T) {
+	client := newFakeK8sClient()
+	SetK8sClient(client)
+	defer SetK8sClient(nil)
+
+	installation, err := GetApiOperatorInstallation()
+	assert.Nil(t, err)
+	assert.Nil(t, installation)
+
+	_, err = client.Clientset.RbacV1().ClusterRoleBindings().Create(context.TODO(), &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: ApiOperator},
+		Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: ApiOperator, Namespace: "apim-operator"}},
+	}, metav1.CreateOptions{})
+	assert.Nil(t, err)
+	_, err = client.Clientset.AppsV1().Deployments("apim-operator").Create(context.TODO(), &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: ApiOperator},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: ApiOperator, Image: "wso2/k8s-api-operator:1.2.0"}},
+		}}},
+	}, metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	installation, err = GetApiOperatorInstallation()
+	assert.Nil(t, err)
+	assert.Equal(t, &OperatorInstallation{Namespace: "apim-operator", Version: "v1.2.0"}, installation)
+}
@@ -0,0 +1,5 @@
+import ballerina/http;
+
+public function interceptRequest (http:Caller outboundEp, http:Request req) {
+    req.setHeader("X-Interceptor", "ballerina");
+}
@@ -383,10 +383,26 @@ _apictl_add_env()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--mg=")
+    two_word_flags+=("--mg")
+    local_nonpersistent_flags+=("--mg")
+    local_nonpersistent_flags+=("--mg=")
     flags+=("--mi=")
     two_word_flags+=("--mi")
     local_nonpersistent_flags+=("--mi")
     local_nonpersistent_flags+=("--mi=")
+    flags+=("--mi-client-cert=")
+    two_word_flags+=("--mi-client-cert")
+    local_nonpersistent_flags+=("--mi-client-cert")
+    local_nonpersistent_flags+=("--mi-client-cert=")
+    flags+=("--mi-client-key=")
+    two_word_flags+=("--mi-client-key")
+    local_nonpersistent_flags+=("--mi-client-key")
+    local_nonpersistent_flags+=("--mi-client-key=")
+    flags+=("--mi-node=")
+    two_word_flags+=("--mi-node")
+    local_nonpersistent_flags+=("--mi-node")
+    local_nonpersistent_flags+=("--mi-node=")
     flags+=("--publisher=")
     two_word_flags+=("--publisher")
     local_nonpersistent_flags+=("--publisher")
@@ -461,9 +477,9 @@ _apictl_add()
     noun_aliases=()
 }
 
-_apictl_bundle()
+_apictl_app-keys_clean-up()
 {
-    last_command="apictl_bundle"
+    last_command="apictl_app-keys_clean-up"
 
     command_aliases=()
 
@@ -475,36 +491,46 @@ _apictl_bundle()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_change-status_api()
+_apictl_app-keys_generate()
 {
-    last_command="apictl_change-status_api"
+    last_command="apictl_app-keys_generate"
 
     command_aliases=()
 
@@ -516,60 +542,62 @@ _apictl_change-status_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--action=")
-    two_word_flags+=("--action")
-    two_word_flags+=("-a")
-    local_nonpersistent_flags+=("--action")
-    local_nonpersistent_flags+=("--action=")
-    local_nonpersistent_flags+=("-a")
+    flags+=("--callback-url=")
+    two_word_flags+=("--callback-url")
+    local_nonpersistent_flags+=("--callback-url")
+    local_nonpersistent_flags+=("--callback-url=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--grant-types=")
+    two_word_flags+=("--grant-types")
+    local_nonpersistent_flags+=("--grant-types")
+    local_nonpersistent_flags+=("--grant-types=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--output-file=")
+    two_word_flags+=("--output-file")
+    local_nonpersistent_flags+=("--output-file")
+    local_nonpersistent_flags+=("--output-file=")
+    flags+=("--validity-time=")
+    two_word_flags+=("--validity-time")
+    local_nonpersistent_flags+=("--validity-time")
+    local_nonpersistent_flags+=("--validity-time=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--action=")
-    must_have_one_flag+=("-a")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_change-status_help()
+_apictl_app-keys_help()
 {
-    last_command="apictl_change-status_help"
+    last_command="apictl_app-keys_help"
 
     command_aliases=()
 
@@ -591,15 +619,13 @@ _apictl_change-status_help()
     noun_aliases=()
 }
 
-_apictl_change-status()
+_apictl_app-keys_map()
 {
-    last_command="apictl_change-status"
+    last_command="apictl_app-keys_map"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -607,22 +633,55 @@ _apictl_change-status()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--consumer-key=")
+    two_word_flags+=("--consumer-key")
+    local_nonpersistent_flags+=("--consumer-key")
+    local_nonpersistent_flags+=("--consumer-key=")
+    flags+=("--consumer-secret=")
+    two_word_flags+=("--consumer-secret")
+    local_nonpersistent_flags+=("--consumer-secret")
+    local_nonpersistent_flags+=("--consumer-secret=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--consumer-key=")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_api()
+_apictl_app-keys_regenerate-secret()
 {
-    last_command="apictl_delete_api"
+    last_command="apictl_app-keys_regenerate-secret"
 
     command_aliases=()
 
@@ -644,24 +703,24 @@ _apictl_delete_api()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--output-file=")
+    two_word_flags+=("--output-file")
+    local_nonpersistent_flags+=("--output-file")
+    local_nonpersistent_flags+=("--output-file=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -671,15 +730,13 @@ _apictl_delete_api()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_api-product()
+_apictl_app-keys_update()
 {
-    last_command="apictl_delete_api-product"
+    last_command="apictl_app-keys_update"
 
     command_aliases=()
 
@@ -691,28 +748,38 @@ _apictl_delete_api-product()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--callback-url=")
+    two_word_flags+=("--callback-url")
+    local_nonpersistent_flags+=("--callback-url")
+    local_nonpersistent_flags+=("--callback-url=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--grant-types=")
+    two_word_flags+=("--grant-types")
+    local_nonpersistent_flags+=("--grant-types")
+    local_nonpersistent_flags+=("--grant-types=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -726,9 +793,83 @@ _apictl_delete_api-product()
     noun_aliases=()
 }
 
-_apictl_delete_app()
+_apictl_app-keys()
 {
-    last_command="apictl_delete_app"
+    last_command="apictl_app-keys"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("clean-up")
+    commands+=("generate")
+    commands+=("help")
+    commands+=("map")
+    commands+=("regenerate-secret")
+    commands+=("update")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_bundle()
+{
+    last_command="apictl_bundle"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_change-status_api()
+{
+    last_command="apictl_change-status_api"
 
     command_aliases=()
 
@@ -740,6 +881,12 @@ _apictl_delete_app()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--action=")
+    two_word_flags+=("--action")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--action")
+    local_nonpersistent_flags+=("--action=")
+    local_nonpersistent_flags+=("-a")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -756,28 +903,38 @@ _apictl_delete_app()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--action=")
+    must_have_one_flag+=("-a")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_help()
+_apictl_change-status_help()
 {
-    last_command="apictl_delete_help"
+    last_command="apictl_change-status_help"
 
     command_aliases=()
 
@@ -799,16 +956,14 @@ _apictl_delete_help()
     noun_aliases=()
 }
 
-_apictl_delete()
+_apictl_change-status()
 {
-    last_command="apictl_delete"
+    last_command="apictl_change-status"
 
     command_aliases=()
 
     commands=()
     commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
     commands+=("help")
 
     flags=()
@@ -830,9 +985,9 @@ _apictl_delete()
     noun_aliases=()
 }
 
-_apictl_export_api()
+_apictl_delete_api()
 {
-    last_command="apictl_export_api"
+    last_command="apictl_delete_api"
 
     command_aliases=()
 
@@ -850,10 +1005,6 @@ _apictl_export_api()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -864,8 +1015,6 @@ _apictl_export_api()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--preserveStatus")
-    local_nonpersistent_flags+=("--preserveStatus")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
@@ -893,9 +1042,9 @@ _apictl_export_api()
     noun_aliases=()
 }
 
-_apictl_export_api-product()
+_apictl_delete_api-product()
 {
-    last_command="apictl_export_api-product"
+    last_command="apictl_delete_api-product"
 
     command_aliases=()
 
@@ -913,10 +1062,6 @@ _apictl_export_api-product()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -946,9 +1091,9 @@ _apictl_export_api-product()
     noun_aliases=()
 }
 
-_apictl_export_apis()
+_apictl_delete_app()
 {
-    last_command="apictl_export_apis"
+    last_command="apictl_delete_app"
 
     command_aliases=()
 
@@ -966,17 +1111,22 @@ _apictl_export_apis()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--force")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--preserveStatus")
-    local_nonpersistent_flags+=("--preserveStatus")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -984,13 +1134,15 @@ _apictl_export_apis()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_app()
+_apictl_delete_help()
 {
-    last_command="apictl_export_app"
+    last_command="apictl_delete_help"
 
     command_aliases=()
 
@@ -1002,56 +1154,27 @@ _apictl_export_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
-    flags+=("--withKeys")
-    local_nonpersistent_flags+=("--withKeys")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--owner=")
-    must_have_one_flag+=("-o")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_export_help()
+_apictl_delete()
 {
-    last_command="apictl_export_help"
+    last_command="apictl_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1059,28 +1182,26 @@ _apictl_export_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_export()
+_apictl_docs_add()
 {
-    last_command="apictl_export"
+    last_command="apictl_docs_add"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("apis")
-    commands+=("app")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1088,22 +1209,89 @@ _apictl_export()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--content=")
+    two_word_flags+=("--content")
+    local_nonpersistent_flags+=("--content")
+    local_nonpersistent_flags+=("--content=")
+    flags+=("--content-file=")
+    two_word_flags+=("--content-file")
+    local_nonpersistent_flags+=("--content-file")
+    local_nonpersistent_flags+=("--content-file=")
+    flags+=("--doc-name=")
+    two_word_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--other-type-name=")
+    two_word_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name=")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--source-type=")
+    two_word_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type=")
+    flags+=("--source-url=")
+    two_word_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url=")
+    flags+=("--summary=")
+    two_word_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary=")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--visibility=")
+    two_word_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--doc-name=")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_deployment-dir()
+_apictl_docs_delete()
 {
-    last_command="apictl_gen_deployment-dir"
+    last_command="apictl_docs_delete"
 
     command_aliases=()
 
@@ -1115,36 +1303,57 @@ _apictl_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
+    flags+=("--doc-name=")
+    two_word_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
+    must_have_one_flag+=("--doc-name=")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_help()
+_apictl_docs_help()
 {
-    last_command="apictl_gen_help"
+    last_command="apictl_docs_help"
 
     command_aliases=()
 
@@ -1166,15 +1375,13 @@ _apictl_gen_help()
     noun_aliases=()
 }
 
-_apictl_gen()
+_apictl_docs_list()
 {
-    last_command="apictl_gen"
+    last_command="apictl_docs_list"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1182,22 +1389,56 @@ _apictl_gen()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-products()
+_apictl_docs_sync()
 {
-    last_command="apictl_get_api-products"
+    last_command="apictl_docs_sync"
 
     command_aliases=()
 
@@ -1215,26 +1456,18 @@ _apictl_get_api-products()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--prune")
+    local_nonpersistent_flags+=("--prune")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1242,13 +1475,15 @@ _apictl_get_api-products()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_apis()
+_apictl_docs_update()
 {
-    last_command="apictl_get_apis"
+    last_command="apictl_docs_update"
 
     command_aliases=()
 
@@ -1260,50 +1495,99 @@ _apictl_get_apis()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--content=")
+    two_word_flags+=("--content")
+    local_nonpersistent_flags+=("--content")
+    local_nonpersistent_flags+=("--content=")
+    flags+=("--content-file=")
+    two_word_flags+=("--content-file")
+    local_nonpersistent_flags+=("--content-file")
+    local_nonpersistent_flags+=("--content-file=")
+    flags+=("--doc-name=")
+    two_word_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--other-type-name=")
+    two_word_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name=")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--source-type=")
+    two_word_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type=")
+    flags+=("--source-url=")
+    two_word_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url=")
+    flags+=("--summary=")
+    two_word_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary=")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--visibility=")
+    two_word_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--doc-name=")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_apps()
+_apictl_docs()
 {
-    last_command="apictl_get_apps"
+    last_command="apictl_docs"
 
     command_aliases=()
 
     commands=()
+    commands+=("add")
+    commands+=("delete")
+    commands+=("help")
+    commands+=("list")
+    commands+=("sync")
+    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -1311,46 +1595,1812 @@ _apictl_get_apps()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_export_api()
+{
+    last_command="apictl_export_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--preserveStatus")
+    local_nonpersistent_flags+=("--preserveStatus")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_export_api-product()
+{
+    last_command="apictl_export_api-product"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_export_apis()
+{
+    last_command="apictl_export_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--force")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--preserveStatus")
+    local_nonpersistent_flags+=("--preserveStatus")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_export_app()
+{
+    last_command="apictl_export_app"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--owner=")
     two_word_flags+=("--owner")
     two_word_flags+=("-o")
     local_nonpersistent_flags+=("--owner")
     local_nonpersistent_flags+=("--owner=")
     local_nonpersistent_flags+=("-o")
+    flags+=("--withKeys")
+    local_nonpersistent_flags+=("--withKeys")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--owner=")
+    must_have_one_flag+=("-o")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_export_help()
+{
+    last_command="apictl_export_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_export()
+{
+    last_command="apictl_export"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("apis")
+    commands+=("app")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_gen_deployment-dir()
+{
+    last_command="apictl_gen_deployment-dir"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_gen_help()
+{
+    last_command="apictl_gen_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_gen()
+{
+    last_command="apictl_gen"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-products()
+{
+    last_command="apictl_get_api-products"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apis()
+{
+    last_command="apictl_get_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apps()
+{
+    last_command="apictl_get_apps"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_envs()
+{
+    last_command="apictl_get_envs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_help()
+{
+    last_command="apictl_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_get_key-managers()
+{
+    last_command="apictl_get_key-managers"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_keys()
+{
+    last_command="apictl_get_keys"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--scopes=")
+    two_word_flags+=("--scopes")
+    local_nonpersistent_flags+=("--scopes")
+    local_nonpersistent_flags+=("--scopes=")
+    flags+=("--tier=")
+    two_word_flags+=("--tier")
+    local_nonpersistent_flags+=("--tier")
+    local_nonpersistent_flags+=("--tier=")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--token-type=")
+    two_word_flags+=("--token-type")
+    local_nonpersistent_flags+=("--token-type")
+    local_nonpersistent_flags+=("--token-type=")
+    flags+=("--validity-period=")
+    two_word_flags+=("--validity-period")
+    local_nonpersistent_flags+=("--validity-period")
+    local_nonpersistent_flags+=("--validity-period=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get()
+{
+    last_command="apictl_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api-products")
+    commands+=("apis")
+    commands+=("apps")
+    commands+=("envs")
+    commands+=("help")
+    commands+=("key-managers")
+    commands+=("keys")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_help()
+{
+    last_command="apictl_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_api()
+{
+    last_command="apictl_import_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--http")
+    local_nonpersistent_flags+=("--http")
+    flags+=("--junit-report=")
+    two_word_flags+=("--junit-report")
+    local_nonpersistent_flags+=("--junit-report")
+    local_nonpersistent_flags+=("--junit-report=")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--run-tests")
+    local_nonpersistent_flags+=("--run-tests")
+    flags+=("--skipCleanup")
+    local_nonpersistent_flags+=("--skipCleanup")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_api-product()
+{
+    last_command="apictl_import_api-product"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--import-apis")
+    local_nonpersistent_flags+=("--import-apis")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--skipCleanup")
+    local_nonpersistent_flags+=("--skipCleanup")
+    flags+=("--update-api-product")
+    local_nonpersistent_flags+=("--update-api-product")
+    flags+=("--update-apis")
+    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_app()
+{
+    last_command="apictl_import_app"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserveOwner")
+    local_nonpersistent_flags+=("--preserveOwner")
+    flags+=("--skipCleanup")
+    local_nonpersistent_flags+=("--skipCleanup")
+    flags+=("--skipKeys")
+    local_nonpersistent_flags+=("--skipKeys")
+    flags+=("--skipSubscriptions")
+    flags+=("-s")
+    local_nonpersistent_flags+=("--skipSubscriptions")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_help()
+{
+    last_command="apictl_import_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import()
+{
+    last_command="apictl_import"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_init()
+{
+    last_command="apictl_init"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--definition=")
+    two_word_flags+=("--definition")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--definition")
+    local_nonpersistent_flags+=("--definition=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--force")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--force")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--initial-state=")
+    two_word_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state=")
+    flags+=("--oas=")
+    two_word_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_invoke_api()
+{
+    last_command="apictl_invoke_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--body=")
+    two_word_flags+=("--body")
+    local_nonpersistent_flags+=("--body")
+    local_nonpersistent_flags+=("--body=")
+    flags+=("--body-file=")
+    two_word_flags+=("--body-file")
+    local_nonpersistent_flags+=("--body-file")
+    local_nonpersistent_flags+=("--body-file=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    flags+=("--header=")
+    two_word_flags+=("--header")
+    local_nonpersistent_flags+=("--header")
+    local_nonpersistent_flags+=("--header=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--http")
+    local_nonpersistent_flags+=("--http")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--path-param=")
+    two_word_flags+=("--path-param")
+    local_nonpersistent_flags+=("--path-param")
+    local_nonpersistent_flags+=("--path-param=")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query-param=")
+    two_word_flags+=("--query-param")
+    local_nonpersistent_flags+=("--query-param")
+    local_nonpersistent_flags+=("--query-param=")
+    flags+=("--resource=")
+    two_word_flags+=("--resource")
+    local_nonpersistent_flags+=("--resource")
+    local_nonpersistent_flags+=("--resource=")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--resource=")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_invoke_help()
+{
+    last_command="apictl_invoke_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_invoke()
+{
+    last_command="apictl_invoke"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_api()
+{
+    last_command="apictl_k8s_add_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--api-endpoint=")
+    two_word_flags+=("--api-endpoint")
+    local_nonpersistent_flags+=("--api-endpoint")
+    local_nonpersistent_flags+=("--api-endpoint=")
+    flags+=("--bal-interceptors=")
+    two_word_flags+=("--bal-interceptors")
+    local_nonpersistent_flags+=("--bal-interceptors")
+    local_nonpersistent_flags+=("--bal-interceptors=")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--env=")
+    two_word_flags+=("--env")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--env")
+    local_nonpersistent_flags+=("--env=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--from-dir=")
+    two_word_flags+=("--from-dir")
+    local_nonpersistent_flags+=("--from-dir")
+    local_nonpersistent_flags+=("--from-dir=")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--from-project=")
+    two_word_flags+=("--from-project")
+    local_nonpersistent_flags+=("--from-project")
+    local_nonpersistent_flags+=("--from-project=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--hostname=")
+    two_word_flags+=("--hostname")
+    local_nonpersistent_flags+=("--hostname")
+    local_nonpersistent_flags+=("--hostname=")
+    flags+=("--image=")
+    two_word_flags+=("--image")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--image")
+    local_nonpersistent_flags+=("--image=")
+    local_nonpersistent_flags+=("-i")
+    flags+=("--java-interceptors=")
+    two_word_flags+=("--java-interceptors")
+    local_nonpersistent_flags+=("--java-interceptors")
+    local_nonpersistent_flags+=("--java-interceptors=")
+    flags+=("--manifest=")
+    two_word_flags+=("--manifest")
+    local_nonpersistent_flags+=("--manifest")
+    local_nonpersistent_flags+=("--manifest=")
+    flags+=("--mode=")
+    two_word_flags+=("--mode")
+    two_word_flags+=("-m")
+    local_nonpersistent_flags+=("--mode")
+    local_nonpersistent_flags+=("--mode=")
+    local_nonpersistent_flags+=("-m")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--output-dir=")
+    two_word_flags+=("--output-dir")
+    local_nonpersistent_flags+=("--output-dir")
+    local_nonpersistent_flags+=("--output-dir=")
+    flags+=("--overlay=")
+    two_word_flags+=("--overlay")
+    local_nonpersistent_flags+=("--overlay")
+    local_nonpersistent_flags+=("--overlay=")
+    flags+=("--override")
+    local_nonpersistent_flags+=("--override")
+    flags+=("--parallel=")
+    two_word_flags+=("--parallel")
+    local_nonpersistent_flags+=("--parallel")
+    local_nonpersistent_flags+=("--parallel=")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--params-environment=")
+    two_word_flags+=("--params-environment")
+    local_nonpersistent_flags+=("--params-environment")
+    local_nonpersistent_flags+=("--params-environment=")
+    flags+=("--replicas=")
+    two_word_flags+=("--replicas")
+    local_nonpersistent_flags+=("--replicas")
+    local_nonpersistent_flags+=("--replicas=")
+    flags+=("--timeout=")
+    two_word_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--watch")
+    flags+=("-w")
+    local_nonpersistent_flags+=("--watch")
+    local_nonpersistent_flags+=("-w")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_help()
+{
+    last_command="apictl_k8s_add_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_add()
+{
+    last_command="apictl_k8s_add"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_change_help()
+{
+    last_command="apictl_k8s_change_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_change_registry()
+{
+    last_command="apictl_k8s_change_registry"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--key-file=")
+    two_word_flags+=("--key-file")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--key-file")
+    local_nonpersistent_flags+=("--key-file=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--registry-type=")
+    two_word_flags+=("--registry-type")
+    two_word_flags+=("-R")
+    local_nonpersistent_flags+=("--registry-type")
+    local_nonpersistent_flags+=("--registry-type=")
+    local_nonpersistent_flags+=("-R")
+    flags+=("--repository=")
+    two_word_flags+=("--repository")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--repository")
+    local_nonpersistent_flags+=("--repository=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_change()
+{
+    last_command="apictl_k8s_change"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+    commands+=("registry")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_apictl()
+{
+    last_command="apictl_k8s_delete_apictl"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_help()
+{
+    last_command="apictl_k8s_delete_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_delete()
+{
+    last_command="apictl_k8s_delete"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("apictl")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_get_api()
+{
+    last_command="apictl_k8s_get_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--timeout=")
+    two_word_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout=")
+    flags+=("--watch")
+    flags+=("-w")
+    local_nonpersistent_flags+=("--watch")
+    local_nonpersistent_flags+=("-w")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_get_help()
+{
+    last_command="apictl_k8s_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_get()
+{
+    last_command="apictl_k8s_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_help()
+{
+    last_command="apictl_k8s_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_install_api-operator()
+{
+    last_command="apictl_k8s_install_api-operator"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    local_nonpersistent_flags+=("--config")
+    local_nonpersistent_flags+=("--config=")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--key-file=")
+    two_word_flags+=("--key-file")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--key-file")
+    local_nonpersistent_flags+=("--key-file=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--registry-type=")
+    two_word_flags+=("--registry-type")
+    two_word_flags+=("-R")
+    local_nonpersistent_flags+=("--registry-type")
+    local_nonpersistent_flags+=("--registry-type=")
+    local_nonpersistent_flags+=("-R")
+    flags+=("--repository=")
+    two_word_flags+=("--repository")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--repository")
+    local_nonpersistent_flags+=("--repository=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_envs()
+_apictl_k8s_install_help()
 {
-    last_command="apictl_get_envs"
+    last_command="apictl_k8s_install_help"
 
     command_aliases=()
 
@@ -1362,26 +3412,21 @@ _apictl_get_envs()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_get_help()
+_apictl_k8s_install_wso2am-operator()
 {
-    last_command="apictl_get_help"
+    last_command="apictl_k8s_install_wso2am-operator"
 
     command_aliases=()
 
@@ -1393,23 +3438,37 @@ _apictl_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_get_keys()
+_apictl_k8s_install()
 {
-    last_command="apictl_get_keys"
+    last_command="apictl_k8s_install"
 
     command_aliases=()
 
     commands=()
+    commands+=("api-operator")
+    commands+=("help")
+    commands+=("wso2am-operator")
 
     flags=()
     two_word_flags=()
@@ -1417,66 +3476,28 @@ _apictl_get_keys()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--token=")
-    two_word_flags+=("--token")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--token")
-    local_nonpersistent_flags+=("--token=")
-    local_nonpersistent_flags+=("-t")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get()
+_apictl_k8s_uninstall_api-operator()
 {
-    last_command="apictl_get"
+    last_command="apictl_k8s_uninstall_api-operator"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-products")
-    commands+=("apis")
-    commands+=("apps")
-    commands+=("envs")
-    commands+=("help")
-    commands+=("keys")
 
     flags=()
     two_word_flags=()
@@ -1484,10 +3505,14 @@ _apictl_get()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--force")
+    local_nonpersistent_flags+=("--force")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1497,9 +3522,9 @@ _apictl_get()
     noun_aliases=()
 }
 
-_apictl_help()
+_apictl_k8s_uninstall_help()
 {
-    last_command="apictl_help"
+    last_command="apictl_k8s_uninstall_help"
 
     command_aliases=()
 
@@ -1511,6 +3536,8 @@ _apictl_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1521,9 +3548,9 @@ _apictl_help()
     noun_aliases=()
 }
 
-_apictl_import_api()
+_apictl_k8s_uninstall_wso2am-operator()
 {
-    last_command="apictl_import_api"
+    last_command="apictl_k8s_uninstall_wso2am-operator"
 
     command_aliases=()
 
@@ -1535,52 +3562,33 @@ _apictl_import_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--force")
+    local_nonpersistent_flags+=("--force")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--skipCleanup")
-    local_nonpersistent_flags+=("--skipCleanup")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_api-product()
+_apictl_k8s_uninstall()
 {
-    last_command="apictl_import_api-product"
+    last_command="apictl_k8s_uninstall"
 
     command_aliases=()
 
     commands=()
+    commands+=("api-operator")
+    commands+=("help")
+    commands+=("wso2am-operator")
 
     flags=()
     two_word_flags=()
@@ -1588,48 +3596,24 @@ _apictl_import_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--import-apis")
-    local_nonpersistent_flags+=("--import-apis")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--skipCleanup")
-    local_nonpersistent_flags+=("--skipCleanup")
-    flags+=("--update-api-product")
-    local_nonpersistent_flags+=("--update-api-product")
-    flags+=("--update-apis")
-    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_app()
+_apictl_k8s_update_api()
 {
-    last_command="apictl_import_app"
+    last_command="apictl_k8s_update_api"
 
     command_aliases=()
 
@@ -1641,60 +3625,166 @@ _apictl_import_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
+    flags+=("--api-endpoint=")
+    two_word_flags+=("--api-endpoint")
+    local_nonpersistent_flags+=("--api-endpoint")
+    local_nonpersistent_flags+=("--api-endpoint=")
+    flags+=("--bal-interceptors=")
+    two_word_flags+=("--bal-interceptors")
+    local_nonpersistent_flags+=("--bal-interceptors")
+    local_nonpersistent_flags+=("--bal-interceptors=")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--env=")
+    two_word_flags+=("--env")
     two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("--env")
+    local_nonpersistent_flags+=("--env=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
+    flags+=("--from-dir=")
+    two_word_flags+=("--from-dir")
+    local_nonpersistent_flags+=("--from-dir")
+    local_nonpersistent_flags+=("--from-dir=")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
     two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
     local_nonpersistent_flags+=("-f")
+    flags+=("--from-project=")
+    two_word_flags+=("--from-project")
+    local_nonpersistent_flags+=("--from-project")
+    local_nonpersistent_flags+=("--from-project=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
+    flags+=("--hostname=")
+    two_word_flags+=("--hostname")
+    local_nonpersistent_flags+=("--hostname")
+    local_nonpersistent_flags+=("--hostname=")
+    flags+=("--image=")
+    two_word_flags+=("--image")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--image")
+    local_nonpersistent_flags+=("--image=")
+    local_nonpersistent_flags+=("-i")
+    flags+=("--java-interceptors=")
+    two_word_flags+=("--java-interceptors")
+    local_nonpersistent_flags+=("--java-interceptors")
+    local_nonpersistent_flags+=("--java-interceptors=")
+    flags+=("--manifest=")
+    two_word_flags+=("--manifest")
+    local_nonpersistent_flags+=("--manifest")
+    local_nonpersistent_flags+=("--manifest=")
+    flags+=("--mode=")
+    two_word_flags+=("--mode")
+    two_word_flags+=("-m")
+    local_nonpersistent_flags+=("--mode")
+    local_nonpersistent_flags+=("--mode=")
+    local_nonpersistent_flags+=("-m")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
     two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
     local_nonpersistent_flags+=("-o")
-    flags+=("--preserveOwner")
-    local_nonpersistent_flags+=("--preserveOwner")
-    flags+=("--skipCleanup")
-    local_nonpersistent_flags+=("--skipCleanup")
-    flags+=("--skipKeys")
-    local_nonpersistent_flags+=("--skipKeys")
-    flags+=("--skipSubscriptions")
-    flags+=("-s")
-    local_nonpersistent_flags+=("--skipSubscriptions")
-    local_nonpersistent_flags+=("-s")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
+    flags+=("--output-dir=")
+    two_word_flags+=("--output-dir")
+    local_nonpersistent_flags+=("--output-dir")
+    local_nonpersistent_flags+=("--output-dir=")
+    flags+=("--overlay=")
+    two_word_flags+=("--overlay")
+    local_nonpersistent_flags+=("--overlay")
+    local_nonpersistent_flags+=("--overlay=")
+    flags+=("--override")
+    local_nonpersistent_flags+=("--override")
+    flags+=("--parallel=")
+    two_word_flags+=("--parallel")
+    local_nonpersistent_flags+=("--parallel")
+    local_nonpersistent_flags+=("--parallel=")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--params-environment=")
+    two_word_flags+=("--params-environment")
+    local_nonpersistent_flags+=("--params-environment")
+    local_nonpersistent_flags+=("--params-environment=")
+    flags+=("--replicas=")
+    two_word_flags+=("--replicas")
+    local_nonpersistent_flags+=("--replicas")
+    local_nonpersistent_flags+=("--replicas=")
+    flags+=("--timeout=")
+    two_word_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--watch")
+    flags+=("-w")
+    local_nonpersistent_flags+=("--watch")
+    local_nonpersistent_flags+=("-w")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_help()
+_apictl_k8s_update_help()
 {
-    last_command="apictl_import_help"
+    last_command="apictl_k8s_update_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--context=")
+    two_word_flags+=("--context")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_update()
+{
+    last_command="apictl_k8s_update"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1702,27 +3792,36 @@ _apictl_import_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import()
+_apictl_k8s()
 {
-    last_command="apictl_import"
+    last_command="apictl_k8s"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
+    commands+=("add")
+    commands+=("change")
+    commands+=("delete")
+    commands+=("get")
     commands+=("help")
+    commands+=("install")
+    commands+=("uninstall")
+    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -1730,6 +3829,8 @@ _apictl_import()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--context=")
+    two_word_flags+=("--context")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1743,9 +3844,9 @@ _apictl_import()
     noun_aliases=()
 }
 
-_apictl_init()
+_apictl_login()
 {
-    last_command="apictl_init"
+    last_command="apictl_login"
 
     command_aliases=()
 
@@ -1757,28 +3858,24 @@ _apictl_init()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--definition=")
-    two_word_flags+=("--definition")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--definition")
-    local_nonpersistent_flags+=("--definition=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--force")
-    flags+=("-f")
-    local_nonpersistent_flags+=("--force")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--initial-state=")
-    two_word_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state=")
-    flags+=("--oas=")
-    two_word_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas=")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1788,9 +3885,9 @@ _apictl_init()
     noun_aliases=()
 }
 
-_apictl_k8s_add_api()
+_apictl_logout()
 {
-    last_command="apictl_k8s_add_api"
+    last_command="apictl_logout"
 
     command_aliases=()
 
@@ -1802,42 +3899,22 @@ _apictl_k8s_add_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--from-file=")
-    two_word_flags+=("--from-file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--from-file")
-    local_nonpersistent_flags+=("--from-file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--from-file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_add_help()
+_apictl_mg_deploy()
 {
-    last_command="apictl_k8s_add_help"
+    last_command="apictl_mg_deploy"
 
     command_aliases=()
 
@@ -1849,25 +3926,42 @@ _apictl_k8s_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--skipCleanup")
+    local_nonpersistent_flags+=("--skipCleanup")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_add()
+_apictl_mg_get_apis()
 {
-    last_command="apictl_k8s_add"
+    last_command="apictl_mg_get_apis"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1875,22 +3969,46 @@ _apictl_k8s_add()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_change_help()
+_apictl_mg_get_help()
 {
-    last_command="apictl_k8s_change_help"
+    last_command="apictl_mg_get_help"
 
     command_aliases=()
 
@@ -1912,13 +4030,15 @@ _apictl_k8s_change_help()
     noun_aliases=()
 }
 
-_apictl_k8s_change_registry()
+_apictl_mg_get()
 {
-    last_command="apictl_k8s_change_registry"
+    last_command="apictl_mg_get"
 
     command_aliases=()
 
     commands=()
+    commands+=("apis")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1930,38 +4050,6 @@ _apictl_k8s_change_registry()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--key-file=")
-    two_word_flags+=("--key-file")
-    two_word_flags+=("-c")
-    local_nonpersistent_flags+=("--key-file")
-    local_nonpersistent_flags+=("--key-file=")
-    local_nonpersistent_flags+=("-c")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--registry-type=")
-    two_word_flags+=("--registry-type")
-    two_word_flags+=("-R")
-    local_nonpersistent_flags+=("--registry-type")
-    local_nonpersistent_flags+=("--registry-type=")
-    local_nonpersistent_flags+=("-R")
-    flags+=("--repository=")
-    two_word_flags+=("--repository")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--repository")
-    local_nonpersistent_flags+=("--repository=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1971,15 +4059,13 @@ _apictl_k8s_change_registry()
     noun_aliases=()
 }
 
-_apictl_k8s_change()
+_apictl_mg_help()
 {
-    last_command="apictl_k8s_change"
+    last_command="apictl_mg_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("registry")
 
     flags=()
     two_word_flags=()
@@ -1987,22 +4073,19 @@ _apictl_k8s_change()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_delete_apictl()
+_apictl_mg_login()
 {
-    last_command="apictl_k8s_delete_apictl"
+    last_command="apictl_mg_login"
 
     command_aliases=()
 
@@ -2014,22 +4097,44 @@ _apictl_k8s_delete_apictl()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_help()
+_apictl_mg_logout()
 {
-    last_command="apictl_k8s_delete_help"
+    last_command="apictl_mg_logout"
 
     command_aliases=()
 
@@ -2041,25 +4146,34 @@ _apictl_k8s_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_delete()
+_apictl_mg_status()
 {
-    last_command="apictl_k8s_delete"
+    last_command="apictl_mg_status"
 
     command_aliases=()
 
     commands=()
-    commands+=("apictl")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2067,6 +4181,16 @@ _apictl_k8s_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2076,13 +4200,15 @@ _apictl_k8s_delete()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_help()
+_apictl_mg_undeploy_api()
 {
-    last_command="apictl_k8s_help"
+    last_command="apictl_mg_undeploy_api"
 
     command_aliases=()
 
@@ -2094,19 +4220,50 @@ _apictl_k8s_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_install_api-operator()
+_apictl_mg_undeploy_help()
 {
-    last_command="apictl_k8s_install_api-operator"
+    last_command="apictl_mg_undeploy_help"
 
     command_aliases=()
 
@@ -2118,64 +4275,25 @@ _apictl_k8s_install_api-operator()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--from-file=")
-    two_word_flags+=("--from-file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--from-file")
-    local_nonpersistent_flags+=("--from-file=")
-    local_nonpersistent_flags+=("-f")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--key-file=")
-    two_word_flags+=("--key-file")
-    two_word_flags+=("-c")
-    local_nonpersistent_flags+=("--key-file")
-    local_nonpersistent_flags+=("--key-file=")
-    local_nonpersistent_flags+=("-c")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--registry-type=")
-    two_word_flags+=("--registry-type")
-    two_word_flags+=("-R")
-    local_nonpersistent_flags+=("--registry-type")
-    local_nonpersistent_flags+=("--registry-type=")
-    local_nonpersistent_flags+=("-R")
-    flags+=("--repository=")
-    two_word_flags+=("--repository")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--repository")
-    local_nonpersistent_flags+=("--repository=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_install_help()
+_apictl_mg_undeploy()
 {
-    last_command="apictl_k8s_install_help"
+    last_command="apictl_mg_undeploy"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2183,19 +4301,22 @@ _apictl_k8s_install_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_install_wso2am-operator()
+_apictl_mg_update()
 {
-    last_command="apictl_k8s_install_wso2am-operator"
+    last_command="apictl_mg_update"
 
     command_aliases=()
 
@@ -2207,35 +4328,52 @@ _apictl_k8s_install_wso2am-operator()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--from-file=")
-    two_word_flags+=("--from-file")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
     two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--from-file")
-    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
     local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--skipCleanup")
+    local_nonpersistent_flags+=("--skipCleanup")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_install()
+_apictl_mg()
 {
-    last_command="apictl_k8s_install"
+    last_command="apictl_mg"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-operator")
+    commands+=("deploy")
+    commands+=("get")
     commands+=("help")
-    commands+=("wso2am-operator")
+    commands+=("login")
+    commands+=("logout")
+    commands+=("status")
+    commands+=("undeploy")
+    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -2256,9 +4394,9 @@ _apictl_k8s_install()
     noun_aliases=()
 }
 
-_apictl_k8s_uninstall_api-operator()
+_apictl_mi_activate_endpoint()
 {
-    last_command="apictl_k8s_uninstall_api-operator"
+    last_command="apictl_mi_activate_endpoint"
 
     command_aliases=()
 
@@ -2270,8 +4408,12 @@ _apictl_k8s_uninstall_api-operator()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--force")
-    local_nonpersistent_flags+=("--force")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2281,13 +4423,15 @@ _apictl_k8s_uninstall_api-operator()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_uninstall_help()
+_apictl_mi_activate_help()
 {
-    last_command="apictl_k8s_uninstall_help"
+    last_command="apictl_mi_activate_help"
 
     command_aliases=()
 
@@ -2309,9 +4453,9 @@ _apictl_k8s_uninstall_help()
     noun_aliases=()
 }
 
-_apictl_k8s_uninstall_wso2am-operator()
+_apictl_mi_activate_message-processor()
 {
-    last_command="apictl_k8s_uninstall_wso2am-operator"
+    last_command="apictl_mi_activate_message-processor"
 
     command_aliases=()
 
@@ -2323,8 +4467,12 @@ _apictl_k8s_uninstall_wso2am-operator()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--force")
-    local_nonpersistent_flags+=("--force")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2334,20 +4482,19 @@ _apictl_k8s_uninstall_wso2am-operator()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_uninstall()
+_apictl_mi_activate_proxy-service()
 {
-    last_command="apictl_k8s_uninstall"
+    last_command="apictl_mi_activate_proxy-service"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-operator")
-    commands+=("help")
-    commands+=("wso2am-operator")
 
     flags=()
     two_word_flags=()
@@ -2355,6 +4502,12 @@ _apictl_k8s_uninstall()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2364,17 +4517,23 @@ _apictl_k8s_uninstall()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_update_api()
+_apictl_mi_activate()
 {
-    last_command="apictl_k8s_update_api"
+    last_command="apictl_mi_activate"
 
     command_aliases=()
 
     commands=()
+    commands+=("endpoint")
+    commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -2382,42 +4541,10 @@ _apictl_k8s_update_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--from-file=")
-    two_word_flags+=("--from-file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--from-file")
-    local_nonpersistent_flags+=("--from-file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--mode=")
-    two_word_flags+=("--mode")
-    two_word_flags+=("-m")
-    local_nonpersistent_flags+=("--mode")
-    local_nonpersistent_flags+=("--mode=")
-    local_nonpersistent_flags+=("-m")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
-    flags+=("--replicas=")
-    two_word_flags+=("--replicas")
-    local_nonpersistent_flags+=("--replicas")
-    local_nonpersistent_flags+=("--replicas=")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2427,9 +4554,9 @@ _apictl_k8s_update_api()
     noun_aliases=()
 }
 
-_apictl_k8s_update_help()
+_apictl_mi_add_help()
 {
-    last_command="apictl_k8s_update_help"
+    last_command="apictl_mi_add_help"
 
     command_aliases=()
 
@@ -2451,15 +4578,13 @@ _apictl_k8s_update_help()
     noun_aliases=()
 }
 
-_apictl_k8s_update()
+_apictl_mi_add_log-level()
 {
-    last_command="apictl_k8s_update"
+    last_command="apictl_mi_add_log-level"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2467,6 +4592,12 @@ _apictl_k8s_update()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2476,24 +4607,19 @@ _apictl_k8s_update()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s()
+_apictl_mi_add_user()
 {
-    last_command="apictl_k8s"
+    last_command="apictl_mi_add_user"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("change")
-    commands+=("delete")
-    commands+=("help")
-    commands+=("install")
-    commands+=("uninstall")
-    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -2501,6 +4627,18 @@ _apictl_k8s()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2510,13 +4648,15 @@ _apictl_k8s()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_login()
+_apictl_mi_add_users()
 {
-    last_command="apictl_login"
+    last_command="apictl_mi_add_users"
 
     command_aliases=()
 
@@ -2528,40 +4668,52 @@ _apictl_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--from-file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_logout()
+_apictl_mi_add()
 {
-    last_command="apictl_logout"
+    last_command="apictl_mi_add"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("log-level")
+    commands+=("user")
+    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -2582,9 +4734,9 @@ _apictl_logout()
     noun_aliases=()
 }
 
-_apictl_mg_deploy()
+_apictl_mi_deactivate_endpoint()
 {
-    last_command="apictl_mg_deploy"
+    last_command="apictl_mi_deactivate_endpoint"
 
     command_aliases=()
 
@@ -2596,54 +4748,30 @@ _apictl_mg_deploy()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--host=")
-    two_word_flags+=("--host")
-    two_word_flags+=("-c")
-    local_nonpersistent_flags+=("--host")
-    local_nonpersistent_flags+=("--host=")
-    local_nonpersistent_flags+=("-c")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--skipCleanup")
-    local_nonpersistent_flags+=("--skipCleanup")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--host=")
-    must_have_one_flag+=("-c")
-    must_have_one_flag+=("--username=")
-    must_have_one_flag+=("-u")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_help()
+_apictl_mi_deactivate_help()
 {
-    last_command="apictl_mg_help"
+    last_command="apictl_mi_deactivate_help"
 
     command_aliases=()
 
@@ -2665,15 +4793,13 @@ _apictl_mg_help()
     noun_aliases=()
 }
 
-_apictl_mg()
+_apictl_mi_deactivate_message-processor()
 {
-    last_command="apictl_mg"
+    last_command="apictl_mi_deactivate_message-processor"
 
     command_aliases=()
 
     commands=()
-    commands+=("deploy")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2681,6 +4807,16 @@ _apictl_mg()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--for=")
+    two_word_flags+=("--for")
+    local_nonpersistent_flags+=("--for")
+    local_nonpersistent_flags+=("--for=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2690,13 +4826,15 @@ _apictl_mg()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_activate_endpoint()
+_apictl_mi_deactivate_proxy-service()
 {
-    last_command="apictl_mi_activate_endpoint"
+    last_command="apictl_mi_deactivate_proxy-service"
 
     command_aliases=()
 
@@ -2729,9 +4867,40 @@ _apictl_mi_activate_endpoint()
     noun_aliases=()
 }
 
-_apictl_mi_activate_help()
+_apictl_mi_deactivate()
 {
-    last_command="apictl_mi_activate_help"
+    last_command="apictl_mi_deactivate"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("endpoint")
+    commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_delete_help()
+{
+    last_command="apictl_mi_delete_help"
 
     command_aliases=()
 
@@ -2753,9 +4922,9 @@ _apictl_mi_activate_help()
     noun_aliases=()
 }
 
-_apictl_mi_activate_message-processor()
+_apictl_mi_delete_user()
 {
-    last_command="apictl_mi_activate_message-processor"
+    last_command="apictl_mi_delete_user"
 
     command_aliases=()
 
@@ -2767,6 +4936,12 @@ _apictl_mi_activate_message-processor()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -2788,13 +4963,15 @@ _apictl_mi_activate_message-processor()
     noun_aliases=()
 }
 
-_apictl_mi_activate_proxy-service()
+_apictl_mi_delete()
 {
-    last_command="apictl_mi_activate_proxy-service"
+    last_command="apictl_mi_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -2802,12 +4979,6 @@ _apictl_mi_activate_proxy-service()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2817,23 +4988,17 @@ _apictl_mi_activate_proxy-service()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_activate()
+_apictl_mi_deploy_capp()
 {
-    last_command="apictl_mi_activate"
+    last_command="apictl_mi_deploy_capp"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
-    commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -2841,22 +5006,38 @@ _apictl_mi_activate()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--timeout=")
+    two_word_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout")
+    local_nonpersistent_flags+=("--timeout=")
+    flags+=("--wait")
+    flags+=("-w")
+    local_nonpersistent_flags+=("--wait")
+    local_nonpersistent_flags+=("-w")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add_help()
+_apictl_mi_deploy_help()
 {
-    last_command="apictl_mi_add_help"
+    last_command="apictl_mi_deploy_help"
 
     command_aliases=()
 
@@ -2878,13 +5059,15 @@ _apictl_mi_add_help()
     noun_aliases=()
 }
 
-_apictl_mi_add_log-level()
+_apictl_mi_deploy()
 {
-    last_command="apictl_mi_add_log-level"
+    last_command="apictl_mi_deploy"
 
     command_aliases=()
 
     commands=()
+    commands+=("capp")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2892,12 +5075,6 @@ _apictl_mi_add_log-level()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2907,15 +5084,13 @@ _apictl_mi_add_log-level()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add_user()
+_apictl_mi_diff()
 {
-    last_command="apictl_mi_add_user"
+    last_command="apictl_mi_diff"
 
     command_aliases=()
 
@@ -2927,12 +5102,14 @@ _apictl_mi_add_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--env=")
+    two_word_flags+=("--env")
+    local_nonpersistent_flags+=("--env")
+    local_nonpersistent_flags+=("--env=")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2942,22 +5119,17 @@ _apictl_mi_add_user()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add()
+_apictl_mi_get_apis()
 {
-    last_command="apictl_mi_add"
+    last_command="apictl_mi_get_apis"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("log-level")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -2965,6 +5137,16 @@ _apictl_mi_add()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2974,13 +5156,15 @@ _apictl_mi_add()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_endpoint()
+_apictl_mi_get_composite-apps()
 {
-    last_command="apictl_mi_deactivate_endpoint"
+    last_command="apictl_mi_get_composite-apps"
 
     command_aliases=()
 
@@ -2998,6 +5182,10 @@ _apictl_mi_deactivate_endpoint()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3013,9 +5201,9 @@ _apictl_mi_deactivate_endpoint()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_help()
+_apictl_mi_get_connectors()
 {
-    last_command="apictl_mi_deactivate_help"
+    last_command="apictl_mi_get_connectors"
 
     command_aliases=()
 
@@ -3027,19 +5215,34 @@ _apictl_mi_deactivate_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_message-processor()
+_apictl_mi_get_data-services()
 {
-    last_command="apictl_mi_deactivate_message-processor"
+    last_command="apictl_mi_get_data-services"
 
     command_aliases=()
 
@@ -3057,6 +5260,10 @@ _apictl_mi_deactivate_message-processor()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3072,9 +5279,9 @@ _apictl_mi_deactivate_message-processor()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_proxy-service()
+_apictl_mi_get_endpoints()
 {
-    last_command="apictl_mi_deactivate_proxy-service"
+    last_command="apictl_mi_get_endpoints"
 
     command_aliases=()
 
@@ -3092,6 +5299,10 @@ _apictl_mi_deactivate_proxy-service()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3107,17 +5318,13 @@ _apictl_mi_deactivate_proxy-service()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate()
+_apictl_mi_get_help()
 {
-    last_command="apictl_mi_deactivate"
+    last_command="apictl_mi_get_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
-    commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -3125,22 +5332,19 @@ _apictl_mi_deactivate()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_delete_help()
+_apictl_mi_get_inbound-endpoints()
 {
-    last_command="apictl_mi_delete_help"
+    last_command="apictl_mi_get_inbound-endpoints"
 
     command_aliases=()
 
@@ -3152,19 +5356,34 @@ _apictl_mi_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_delete_user()
+_apictl_mi_get_local-entries()
 {
-    last_command="apictl_mi_delete_user"
+    last_command="apictl_mi_get_local-entries"
 
     command_aliases=()
 
@@ -3182,6 +5401,10 @@ _apictl_mi_delete_user()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3197,15 +5420,13 @@ _apictl_mi_delete_user()
     noun_aliases=()
 }
 
-_apictl_mi_delete()
+_apictl_mi_get_log-levels()
 {
-    last_command="apictl_mi_delete"
+    last_command="apictl_mi_get_log-levels"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3213,6 +5434,16 @@ _apictl_mi_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3222,13 +5453,15 @@ _apictl_mi_delete()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_apis()
+_apictl_mi_get_logs()
 {
-    last_command="apictl_mi_get_apis"
+    last_command="apictl_mi_get_logs"
 
     command_aliases=()
 
@@ -3254,6 +5487,12 @@ _apictl_mi_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3265,9 +5504,9 @@ _apictl_mi_get_apis()
     noun_aliases=()
 }
 
-_apictl_mi_get_composite-apps()
+_apictl_mi_get_message-processors()
 {
-    last_command="apictl_mi_get_composite-apps"
+    last_command="apictl_mi_get_message-processors"
 
     command_aliases=()
 
@@ -3304,9 +5543,9 @@ _apictl_mi_get_composite-apps()
     noun_aliases=()
 }
 
-_apictl_mi_get_connectors()
+_apictl_mi_get_message-stores()
 {
-    last_command="apictl_mi_get_connectors"
+    last_command="apictl_mi_get_message-stores"
 
     command_aliases=()
 
@@ -3318,6 +5557,10 @@ _apictl_mi_get_connectors()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--count=")
+    two_word_flags+=("--count")
+    local_nonpersistent_flags+=("--count")
+    local_nonpersistent_flags+=("--count=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3332,6 +5575,14 @@ _apictl_mi_get_connectors()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--interval=")
+    two_word_flags+=("--interval")
+    local_nonpersistent_flags+=("--interval")
+    local_nonpersistent_flags+=("--interval=")
+    flags+=("--watch")
+    flags+=("-w")
+    local_nonpersistent_flags+=("--watch")
+    local_nonpersistent_flags+=("-w")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3343,9 +5594,9 @@ _apictl_mi_get_connectors()
     noun_aliases=()
 }
 
-_apictl_mi_get_data-services()
+_apictl_mi_get_proxy-services()
 {
-    last_command="apictl_mi_get_data-services"
+    last_command="apictl_mi_get_proxy-services"
 
     command_aliases=()
 
@@ -3382,9 +5633,9 @@ _apictl_mi_get_data-services()
     noun_aliases=()
 }
 
-_apictl_mi_get_endpoints()
+_apictl_mi_get_sequences()
 {
-    last_command="apictl_mi_get_endpoints"
+    last_command="apictl_mi_get_sequences"
 
     command_aliases=()
 
@@ -3421,9 +5672,9 @@ _apictl_mi_get_endpoints()
     noun_aliases=()
 }
 
-_apictl_mi_get_help()
+_apictl_mi_get_tasks()
 {
-    last_command="apictl_mi_get_help"
+    last_command="apictl_mi_get_tasks"
 
     command_aliases=()
 
@@ -3435,19 +5686,34 @@ _apictl_mi_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_inbound-endpoints()
+_apictl_mi_get_templates()
 {
-    last_command="apictl_mi_get_inbound-endpoints"
+    last_command="apictl_mi_get_templates"
 
     command_aliases=()
 
@@ -3484,9 +5750,9 @@ _apictl_mi_get_inbound-endpoints()
     noun_aliases=()
 }
 
-_apictl_mi_get_local-entries()
+_apictl_mi_get_transaction-counts()
 {
-    last_command="apictl_mi_get_local-entries"
+    last_command="apictl_mi_get_transaction-counts"
 
     command_aliases=()
 
@@ -3523,9 +5789,9 @@ _apictl_mi_get_local-entries()
     noun_aliases=()
 }
 
-_apictl_mi_get_log-levels()
+_apictl_mi_get_transaction-reports()
 {
-    last_command="apictl_mi_get_log-levels"
+    last_command="apictl_mi_get_transaction-reports"
 
     command_aliases=()
 
@@ -3537,6 +5803,12 @@ _apictl_mi_get_log-levels()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--aggregate=")
+    two_word_flags+=("--aggregate")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--aggregate")
+    local_nonpersistent_flags+=("--aggregate=")
+    local_nonpersistent_flags+=("-a")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3551,6 +5823,18 @@ _apictl_mi_get_log-levels()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3562,9 +5846,9 @@ _apictl_mi_get_log-levels()
     noun_aliases=()
 }
 
-_apictl_mi_get_logs()
+_apictl_mi_get_users()
 {
-    last_command="apictl_mi_get_logs"
+    last_command="apictl_mi_get_users"
 
     command_aliases=()
 
@@ -3590,12 +5874,18 @@ _apictl_mi_get_logs()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
     two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
     local_nonpersistent_flags+=("-p")
+    flags+=("--role=")
+    two_word_flags+=("--role")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--role")
+    local_nonpersistent_flags+=("--role=")
+    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3607,9 +5897,9 @@ _apictl_mi_get_logs()
     noun_aliases=()
 }
 
-_apictl_mi_get_message-processors()
+_apictl_mi_get_vaults()
 {
-    last_command="apictl_mi_get_message-processors"
+    last_command="apictl_mi_get_vaults"
 
     command_aliases=()
 
@@ -3646,9 +5936,80 @@ _apictl_mi_get_message-processors()
     noun_aliases=()
 }
 
-_apictl_mi_get_message-stores()
+_apictl_mi_get()
 {
-    last_command="apictl_mi_get_message-stores"
+    last_command="apictl_mi_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("apis")
+    commands+=("composite-apps")
+    commands+=("connectors")
+    commands+=("data-services")
+    commands+=("endpoints")
+    commands+=("help")
+    commands+=("inbound-endpoints")
+    commands+=("local-entries")
+    commands+=("log-levels")
+    commands+=("logs")
+    commands+=("message-processors")
+    commands+=("message-stores")
+    commands+=("proxy-services")
+    commands+=("sequences")
+    commands+=("tasks")
+    commands+=("templates")
+    commands+=("transaction-counts")
+    commands+=("transaction-reports")
+    commands+=("users")
+    commands+=("vaults")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_help()
+{
+    last_command="apictl_mi_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mi_login()
+{
+    last_command="apictl_mi_login"
 
     command_aliases=()
 
@@ -3660,34 +6021,38 @@ _apictl_mi_get_message-stores()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--store-password")
+    local_nonpersistent_flags+=("--store-password")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_proxy-services()
+_apictl_mi_logout()
 {
-    last_command="apictl_mi_get_proxy-services"
+    last_command="apictl_mi_logout"
 
     command_aliases=()
 
@@ -3699,16 +6064,6 @@ _apictl_mi_get_proxy-services()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3718,15 +6073,13 @@ _apictl_mi_get_proxy-services()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_sequences()
+_apictl_mi_logs()
 {
-    last_command="apictl_mi_get_sequences"
+    last_command="apictl_mi_logs"
 
     command_aliases=()
 
@@ -3744,14 +6097,30 @@ _apictl_mi_get_sequences()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
+    flags+=("--follow")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--follow")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--grep=")
+    two_word_flags+=("--grep")
+    local_nonpersistent_flags+=("--grep")
+    local_nonpersistent_flags+=("--grep=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--interval=")
+    two_word_flags+=("--interval")
+    local_nonpersistent_flags+=("--interval")
+    local_nonpersistent_flags+=("--interval=")
+    flags+=("--level=")
+    two_word_flags+=("--level")
+    local_nonpersistent_flags+=("--level")
+    local_nonpersistent_flags+=("--level=")
+    flags+=("--since=")
+    two_word_flags+=("--since")
+    local_nonpersistent_flags+=("--since")
+    local_nonpersistent_flags+=("--since=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3763,9 +6132,9 @@ _apictl_mi_get_sequences()
     noun_aliases=()
 }
 
-_apictl_mi_get_tasks()
+_apictl_mi_snapshot()
 {
-    last_command="apictl_mi_get_tasks"
+    last_command="apictl_mi_snapshot"
 
     command_aliases=()
 
@@ -3783,14 +6152,16 @@ _apictl_mi_get_tasks()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3802,9 +6173,9 @@ _apictl_mi_get_tasks()
     noun_aliases=()
 }
 
-_apictl_mi_get_templates()
+_apictl_mi_undeploy_capp()
 {
-    last_command="apictl_mi_get_templates"
+    last_command="apictl_mi_undeploy_capp"
 
     command_aliases=()
 
@@ -3822,10 +6193,6 @@ _apictl_mi_get_templates()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3841,9 +6208,9 @@ _apictl_mi_get_templates()
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-counts()
+_apictl_mi_undeploy_help()
 {
-    last_command="apictl_mi_get_transaction-counts"
+    last_command="apictl_mi_undeploy_help"
 
     command_aliases=()
 
@@ -3855,38 +6222,25 @@ _apictl_mi_get_transaction-counts()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-reports()
+_apictl_mi_undeploy()
 {
-    last_command="apictl_mi_get_transaction-reports"
+    last_command="apictl_mi_undeploy"
 
     command_aliases=()
 
     commands=()
+    commands+=("capp")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3894,36 +6248,22 @@ _apictl_mi_get_transaction-reports()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
-    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_users()
+_apictl_mi_update_hashicorp-secret()
 {
-    last_command="apictl_mi_get_users"
+    last_command="apictl_mi_update_hashicorp-secret"
 
     command_aliases=()
 
@@ -3941,26 +6281,10 @@ _apictl_mi_get_users()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--pattern=")
-    two_word_flags+=("--pattern")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--pattern")
-    local_nonpersistent_flags+=("--pattern=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--role=")
-    two_word_flags+=("--role")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--role")
-    local_nonpersistent_flags+=("--role=")
-    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3972,32 +6296,13 @@ _apictl_mi_get_users()
     noun_aliases=()
 }
 
-_apictl_mi_get()
+_apictl_mi_update_help()
 {
-    last_command="apictl_mi_get"
+    last_command="apictl_mi_update_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("apis")
-    commands+=("composite-apps")
-    commands+=("connectors")
-    commands+=("data-services")
-    commands+=("endpoints")
-    commands+=("help")
-    commands+=("inbound-endpoints")
-    commands+=("local-entries")
-    commands+=("log-levels")
-    commands+=("logs")
-    commands+=("message-processors")
-    commands+=("message-stores")
-    commands+=("proxy-services")
-    commands+=("sequences")
-    commands+=("tasks")
-    commands+=("templates")
-    commands+=("transaction-counts")
-    commands+=("transaction-reports")
-    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -4005,22 +6310,19 @@ _apictl_mi_get()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_help()
+_apictl_mi_update_log-level()
 {
-    last_command="apictl_mi_help"
+    last_command="apictl_mi_update_log-level"
 
     command_aliases=()
 
@@ -4032,19 +6334,30 @@ _apictl_mi_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_login()
+_apictl_mi_update_statistics()
 {
-    last_command="apictl_mi_login"
+    last_command="apictl_mi_update_statistics"
 
     command_aliases=()
 
@@ -4056,36 +6369,38 @@ _apictl_mi_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--disable")
+    local_nonpersistent_flags+=("--disable")
+    flags+=("--enable")
+    local_nonpersistent_flags+=("--enable")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_logout()
+_apictl_mi_update_tracing()
 {
-    last_command="apictl_mi_logout"
+    last_command="apictl_mi_update_tracing"
 
     command_aliases=()
 
@@ -4097,22 +6412,38 @@ _apictl_mi_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--disable")
+    local_nonpersistent_flags+=("--disable")
+    flags+=("--enable")
+    local_nonpersistent_flags+=("--enable")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_hashicorp-secret()
+_apictl_mi_update_user-password()
 {
-    last_command="apictl_mi_update_hashicorp-secret"
+    last_command="apictl_mi_update_user-password"
 
     command_aliases=()
 
@@ -4124,6 +6455,12 @@ _apictl_mi_update_hashicorp-secret()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -4145,9 +6482,9 @@ _apictl_mi_update_hashicorp-secret()
     noun_aliases=()
 }
 
-_apictl_mi_update_help()
+_apictl_mi_update_user-roles()
 {
-    last_command="apictl_mi_update_help"
+    last_command="apictl_mi_update_user-roles"
 
     command_aliases=()
 
@@ -4159,19 +6496,44 @@ _apictl_mi_update_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--add=")
+    two_word_flags+=("--add")
+    local_nonpersistent_flags+=("--add")
+    local_nonpersistent_flags+=("--add=")
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--remove=")
+    two_word_flags+=("--remove")
+    local_nonpersistent_flags+=("--remove")
+    local_nonpersistent_flags+=("--remove=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_update_log-level()
+_apictl_mi_update_vault()
 {
-    last_command="apictl_mi_update_log-level"
+    last_command="apictl_mi_update_vault"
 
     command_aliases=()
 
@@ -4193,6 +6555,18 @@ _apictl_mi_update_log-level()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--role-id=")
+    two_word_flags+=("--role-id")
+    local_nonpersistent_flags+=("--role-id")
+    local_nonpersistent_flags+=("--role-id=")
+    flags+=("--secret-id-file=")
+    two_word_flags+=("--secret-id-file")
+    local_nonpersistent_flags+=("--secret-id-file")
+    local_nonpersistent_flags+=("--secret-id-file=")
+    flags+=("--token-file=")
+    two_word_flags+=("--token-file")
+    local_nonpersistent_flags+=("--token-file")
+    local_nonpersistent_flags+=("--token-file=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4214,6 +6588,11 @@ _apictl_mi_update()
     commands+=("hashicorp-secret")
     commands+=("help")
     commands+=("log-level")
+    commands+=("statistics")
+    commands+=("tracing")
+    commands+=("user-password")
+    commands+=("user-roles")
+    commands+=("vault")
 
     flags=()
     two_word_flags=()
@@ -4245,10 +6624,15 @@ _apictl_mi()
     commands+=("add")
     commands+=("deactivate")
     commands+=("delete")
+    commands+=("deploy")
+    commands+=("diff")
     commands+=("get")
     commands+=("help")
     commands+=("login")
     commands+=("logout")
+    commands+=("logs")
+    commands+=("snapshot")
+    commands+=("undeploy")
     commands+=("update")
 
     flags=()
@@ -4521,6 +6905,108 @@ _apictl_set()
     noun_aliases=()
 }
 
+_apictl_test_api()
+{
+    last_command="apictl_test_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--http")
+    local_nonpersistent_flags+=("--http")
+    flags+=("--junit-report=")
+    two_word_flags+=("--junit-report")
+    local_nonpersistent_flags+=("--junit-report")
+    local_nonpersistent_flags+=("--junit-report=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_test_help()
+{
+    last_command="apictl_test_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_test()
+{
+    last_command="apictl_test"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_vcs_deploy()
 {
     last_command="apictl_vcs_deploy"
@@ -4535,16 +7021,32 @@ _apictl_vcs_deploy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--http")
+    local_nonpersistent_flags+=("--http")
+    flags+=("--junit-report=")
+    two_word_flags+=("--junit-report")
+    local_nonpersistent_flags+=("--junit-report")
+    local_nonpersistent_flags+=("--junit-report=")
+    flags+=("--run-tests")
+    local_nonpersistent_flags+=("--run-tests")
     flags+=("--skipRollback")
     local_nonpersistent_flags+=("--skipRollback")
     flags+=("--insecure")
@@ -4714,15 +7216,18 @@ _apictl_root_command()
 
     commands=()
     commands+=("add")
+    commands+=("app-keys")
     commands+=("bundle")
     commands+=("change-status")
     commands+=("delete")
+    commands+=("docs")
     commands+=("export")
     commands+=("gen")
     commands+=("get")
     commands+=("help")
     commands+=("import")
     commands+=("init")
+    commands+=("invoke")
     commands+=("k8s")
     commands+=("login")
     commands+=("logout")
@@ -4731,6 +7236,7 @@ _apictl_root_command()
     commands+=("remove")
     commands+=("secret")
     commands+=("set")
+    commands+=("test")
     commands+=("vcs")
     commands+=("version")
 
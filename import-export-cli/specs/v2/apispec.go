@@ -97,7 +97,7 @@ type APIDefinition struct {
 	Tags                               []string           `json:"tags" yaml:"tags,omitempty"`
 	Documents                          []interface{}      `json:"documents,omitempty" yaml:"documents,omitempty"`
 	LastUpdated                        string             `json:"lastUpdated,omitempty" yaml:"lastUpdated,omitempty"`
-	AvailableTiers                     []string           `json:"availableTiers,omitempty" yaml:"availableTiers,omitempty"`
+	AvailableTiers                     []interface{}      `json:"availableTiers,omitempty" yaml:"availableTiers,omitempty"`
 	AvailableSubscriptionLevelPolicies []interface{}      `json:"availableSubscriptionLevelPolicies,omitempty" yaml:"availableSubscriptionLevelPolicies,omitempty"`
 	URITemplates                       []URITemplates     `json:"uriTemplates" yaml:"uriTemplates,omitempty"`
 	APIHeaderChanged                   bool               `json:"apiHeaderChanged,omitempty" yaml:"apiHeaderChanged,omitempty"`
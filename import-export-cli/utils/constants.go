@@ -82,6 +82,8 @@ const defaultUnifiedSearchEndpointSuffix = "api/am/publisher/v2/search"
 const defaultAdminApplicationListEndpointSuffix = "api/am/admin/v2/applications"
 const defaultDevPortalApplicationListEndpointSuffix = "api/am/devportal/v2/applications"
 const defaultDevPortalThrottlingPoliciesEndpointSuffix = "api/am/devportal/v2/throttling-policies"
+const defaultDevPortalKeyManagersEndpointSuffix = "api/am/devportal/v2/key-managers"
+const defaultDevPortalApisEndpointSuffix = "api/am/devportal/v2/apis"
 const defaultClientRegistrationEndpointSuffix = "client-registration/v0.17/register"
 const defaultTokenEndPoint = "oauth2/token"
 const defaultRevokeEndpointSuffix = "oauth2/revoke"
@@ -147,6 +149,29 @@ const ApplicationId = "applicationId"
 const ApiId = "apiId"
 const DefaultCliApp = "default-apictl-app"
 const DefaultTokenType = "JWT"
+const TokenTypeOAuth = "OAUTH"
+const TokenTypeOpaque = "OPAQUE"
+const JSONOutputFormat = "json"
+
+// Application key management related constants
+const KeyTypeProduction = "PRODUCTION"
+const KeyTypeSandbox = "SANDBOX"
+const DefaultKeyManager = "Resident Key Manager"
+
+var DefaultGrantTypes = []string{"refresh_token", "password", "client_credentials"}
+
+// API document related constants
+const DocSourceTypeInline = "INLINE"
+const DocSourceTypeMarkdown = "MARKDOWN"
+const DocSourceTypeURL = "URL"
+const DocSourceTypeFile = "FILE"
+const DefaultDocType = "HOWTO"
+const DefaultDocVisibility = "API_LEVEL"
+
+var ValidDocTypes = []string{"HOWTO", "SAMPLES", "PUBLIC_FORUM", "SUPPORT_FORUM", "API_MESSAGE_FORMAT",
+	"SWAGGER_DOC", "OTHER"}
+var ValidDocSourceTypes = []string{DocSourceTypeInline, DocSourceTypeMarkdown, DocSourceTypeURL, DocSourceTypeFile}
+var ValidDocVisibilities = []string{"OWNER_ONLY", "PRIVATE", "API_LEVEL"}
 
 var ValidInitialStates = []string{"CREATED", "PUBLISHED"}
 
@@ -193,6 +218,9 @@ const DefaultExportFormat = "YAML"
 // MiCmdLiteral denote the alias for micro integrator related commands
 const MiCmdLiteral = "mi"
 
+// MiNodeEnvSeparator separates the environment and the node in the name of a node of a Micro Integrator cluster
+const MiNodeEnvSeparator = "@"
+
 // MiManagementAPIContext
 const MiManagementAPIContext = "management"
 
@@ -217,10 +245,21 @@ const MiManagementDataServiceResource = "data-services"
 const MiManagementMiLoginResource = "login"
 const MiManagementMiLogoutResource = "logout"
 const MiManagementUserResource = "users"
+const MiManagementRoleResource = "roles"
 const MiManagementTransactionResource = "transactions"
 const MiManagementTransactionCountResource = "count"
 const MiManagementTransactionReportResource = "report"
 const MiManagementExternalVaultsResource = "external-vaults"
 const MiManagementExternalVaultHashiCorpResource = "hashicorp"
 
+// MgCmdLiteral denote the alias for Microgateway related commands
+const MgCmdLiteral = "mg"
+
+// MgwAdapterAPIContext is the context of the Microgateway adapter REST API
+const MgwAdapterAPIContext = "api/mgw/adapter/0.1"
+
+// Microgateway adapter resource paths
+const MgwAdapterApisResource = "apis"
+const MgwAdapterHealthResource = "health"
+
 const ZipFileSuffix = ".zip"
@@ -19,7 +19,9 @@
 package utils
 
 import (
+	"crypto/tls"
 	"errors"
+	"sort"
 	"strings"
 )
 
@@ -263,6 +265,32 @@ func GetDevPortalThrottlingPoliciesEndpointOfEnv(env, filePath string) string {
 	}
 }
 
+// Get KeyManagersEndpoint of the DevPortal of a given environment
+func GetDevPortalKeyManagersEndpointOfEnv(env, filePath string) string {
+	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
+	if !(envEndpoints.DevPortalEndpoint == "" || envEndpoints == nil) {
+		envEndpoints.DevPortalEndpoint = AppendSlashToString(envEndpoints.DevPortalEndpoint)
+		return envEndpoints.DevPortalEndpoint + defaultDevPortalKeyManagersEndpointSuffix
+	} else {
+		apiManagerEndpoint := GetApiManagerEndpointOfEnv(env, filePath)
+		apiManagerEndpoint = AppendSlashToString(apiManagerEndpoint)
+		return apiManagerEndpoint + defaultDevPortalKeyManagersEndpointSuffix
+	}
+}
+
+// Get ApisEndpoint of the DevPortal of a given environment
+func GetDevPortalApisEndpointOfEnv(env, filePath string) string {
+	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
+	if !(envEndpoints.DevPortalEndpoint == "" || envEndpoints == nil) {
+		envEndpoints.DevPortalEndpoint = AppendSlashToString(envEndpoints.DevPortalEndpoint)
+		return envEndpoints.DevPortalEndpoint + defaultDevPortalApisEndpointSuffix
+	} else {
+		apiManagerEndpoint := GetApiManagerEndpointOfEnv(env, filePath)
+		apiManagerEndpoint = AppendSlashToString(apiManagerEndpoint)
+		return apiManagerEndpoint + defaultDevPortalApisEndpointSuffix
+	}
+}
+
 // Get TokenEndpoint of a given environment
 func GetTokenEndpointOfEnv(env, filePath string) string {
 	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
@@ -387,23 +415,89 @@ func RequiredAPIMEndpointsExists(envEndpoints *EnvEndpoints) bool {
 		envEndpoints.TokenEndpoint != ""
 }
 
-// HasOnlyMIEndpoint checks wether an MI instance is present in a given environment
-// It returns true if the only instance in an environment is MI
-func HasOnlyMIEndpoint(envEndpoints *EnvEndpoints) bool {
+// HasOnlyMIOrMgwEndpoint checks wether an API Manager instance is not present in a given environment
+// It returns true if the only instances in an environment are MI and/or Microgateway
+func HasOnlyMIOrMgwEndpoint(envEndpoints *EnvEndpoints) bool {
 	return envEndpoints.ApiManagerEndpoint == "" && envEndpoints.AdminEndpoint == "" && envEndpoints.DevPortalEndpoint == "" &&
 		envEndpoints.PublisherEndpoint == "" && envEndpoints.RegistrationEndpoint == "" &&
-		envEndpoints.TokenEndpoint == "" && envEndpoints.MiManagementEndpoint != ""
+		envEndpoints.TokenEndpoint == "" && (envEndpoints.MiManagementEndpoint != "" ||
+		len(envEndpoints.MiManagementNodes) > 0 || envEndpoints.MgwAdapterEndpoint != "")
 }
 
-// GetMIManagementEndpointOfEnv return the Mi Management Endpoint of a given environment
+// GetMIManagementEndpointOfEnv return the Mi Management Endpoint of a given environment.
+// The endpoint of a node is returned if the environment is a node of a cluster (env@node)
 func GetMIManagementEndpointOfEnv(env, filePath string) (string, error) {
+	env, node := SplitMINodeEnvName(env)
 	envEndpoints, err := GetEndpointsOfEnvironment(env, filePath)
 	if err != nil {
 		return "", err
 	}
+	if node != "" {
+		nodeEndpoint, found := envEndpoints.MiManagementNodes[node]
+		if !found {
+			return "", errors.New("node '" + node + "' not found in environment '" + env + "'")
+		}
+		return nodeEndpoint, nil
+	}
 	return envEndpoints.MiManagementEndpoint, nil
 }
 
+// GetMINodesOfEnv returns the names of the Micro Integrator nodes of a given environment in the sorted order.
+// It returns an empty list if the environment is not a cluster
+func GetMINodesOfEnv(env, filePath string) []string {
+	envEndpoints, err := GetEndpointsOfEnvironment(env, filePath)
+	if err != nil {
+		return nil
+	}
+	var nodes []string
+	for node := range envEndpoints.MiManagementNodes {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// GetMINodeEnvName returns the name used to refer a node of a Micro Integrator cluster as an environment
+func GetMINodeEnvName(env, node string) string {
+	return env + MiNodeEnvSeparator + node
+}
+
+// SplitMINodeEnvName returns the environment and the node of a name returned by GetMINodeEnvName.
+// The node is empty if the name is not of a node
+func SplitMINodeEnvName(name string) (string, string) {
+	if i := strings.LastIndex(name, MiNodeEnvSeparator); i > 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// miClientCertificates caches the client certificates of the Micro Integrators loaded by GetMIClientCertificatesOfEnv
+var miClientCertificates = make(map[string][]tls.Certificate)
+
+// GetMIClientCertificatesOfEnv returns the client certificate configured for the Micro Integrator of an environment,
+// if any, to be presented in mutual TLS connections to it. The nodes of a cluster use the certificate of the cluster
+func GetMIClientCertificatesOfEnv(env, filePath string) ([]tls.Certificate, error) {
+	env, _ = SplitMINodeEnvName(env)
+	if certificates, ok := miClientCertificates[env]; ok {
+		return certificates, nil
+	}
+	envEndpoints, err := GetEndpointsOfEnvironment(env, filePath)
+	if err != nil {
+		return nil, err
+	}
+	var certificates []tls.Certificate
+	if envEndpoints.MiClientCertificate != "" {
+		certificate, err := tls.LoadX509KeyPair(envEndpoints.MiClientCertificate, envEndpoints.MiClientKey)
+		if err != nil {
+			return nil, errors.New("unable to load the Micro Integrator client certificate of '" + env + "': " +
+				err.Error())
+		}
+		certificates = []tls.Certificate{certificate}
+	}
+	miClientCertificates[env] = certificates
+	return certificates, nil
+}
+
 // GetMIManagementEndpointOfResource return the full resource url of a resource
 func GetMIManagementEndpointOfResource(resource, env, filePath string) string {
 	miEndpoint, _ := GetMIManagementEndpointOfEnv(env, filePath)
@@ -419,7 +513,7 @@ func MIExistsInEnv(env, filePath string) bool {
 	if err != nil {
 		return false
 	}
-	return miEndpoint != ""
+	return miEndpoint != "" || len(GetMINodesOfEnv(env, filePath)) > 0
 }
 
 // APIMExistsInEnv check wether there is a apim in the environment
@@ -430,3 +524,27 @@ func APIMExistsInEnv(env, filePath string) bool {
 	}
 	return envEndpoints.ApiManagerEndpoint != "" || RequiredAPIMEndpointsExists(envEndpoints)
 }
+
+// GetMgwAdapterEndpointOfEnv return the Microgateway adapter endpoint of a given environment
+func GetMgwAdapterEndpointOfEnv(env, filePath string) (string, error) {
+	envEndpoints, err := GetEndpointsOfEnvironment(env, filePath)
+	if err != nil {
+		return "", err
+	}
+	return envEndpoints.MgwAdapterEndpoint, nil
+}
+
+// GetMgwAdapterEndpointOfResource return the full resource url of a Microgateway adapter resource
+func GetMgwAdapterEndpointOfResource(resource, env, filePath string) string {
+	mgwEndpoint, _ := GetMgwAdapterEndpointOfEnv(env, filePath)
+	return strings.TrimSuffix(mgwEndpoint, "/") + "/" + MgwAdapterAPIContext + "/" + resource
+}
+
+// MgwAdapterExistsInEnv check wether there is a Microgateway adapter in the environment
+func MgwAdapterExistsInEnv(env, filePath string) bool {
+	mgwEndpoint, err := GetMgwAdapterEndpointOfEnv(env, filePath)
+	if err != nil {
+		return false
+	}
+	return mgwEndpoint != ""
+}
@@ -420,3 +420,67 @@ func TestGetKeysOfEnvironment(t *testing.T) {
 	defer os.Remove(testKeysFilePath)
 
 }
+
+func TestHasOnlyMIOrMgwEndpoint(t *testing.T) {
+	if !HasOnlyMIOrMgwEndpoint(&EnvEndpoints{MgwAdapterEndpoint: "https://localhost:9843"}) {
+		t.Errorf("Expected '%t', got '%t' instead\n", true, false)
+	}
+	if HasOnlyMIOrMgwEndpoint(&EnvEndpoints{ApiManagerEndpoint: "https://localhost:9443",
+		MgwAdapterEndpoint: "https://localhost:9843"}) {
+		t.Errorf("Expected '%t', got '%t' instead\n", false, true)
+	}
+}
+
+func TestGetMgwAdapterEndpointOfResource(t *testing.T) {
+	mainConfig := new(MainConfig)
+	mainConfig.Environments = make(map[string]EnvEndpoints)
+	mainConfig.Environments["mgw"] = EnvEndpoints{MgwAdapterEndpoint: "https://localhost:9843/"}
+	WriteConfigFile(mainConfig, testMainConfigFilePath)
+	defer os.Remove(testMainConfigFilePath)
+
+	expected := "https://localhost:9843/api/mgw/adapter/0.1/apis"
+	returned := GetMgwAdapterEndpointOfResource(MgwAdapterApisResource, "mgw", testMainConfigFilePath)
+	if returned != expected {
+		t.Errorf("Expected '%s', got '%s' instead\n", expected, returned)
+	}
+	if MgwAdapterExistsInEnv("not-available", testMainConfigFilePath) {
+		t.Errorf("Expected '%t', got '%t' instead\n", false, true)
+	}
+}
+
+func TestGetMIManagementEndpointOfNode(t *testing.T) {
+	mainConfig := new(MainConfig)
+	mainConfig.Environments = make(map[string]EnvEndpoints)
+	mainConfig.Environments["cluster"] = EnvEndpoints{MiManagementNodes: map[string]string{
+		"node2": "https://mi-2:9164", "node1": "https://mi-1:9164"}}
+	WriteConfigFile(mainConfig, testMainConfigFilePath)
+	defer os.Remove(testMainConfigFilePath)
+
+	nodes := GetMINodesOfEnv("cluster", testMainConfigFilePath)
+	if len(nodes) != 2 || nodes[0] != "node1" || nodes[1] != "node2" {
+		t.Errorf("Expected '%v', got '%v' instead\n", []string{"node1", "node2"}, nodes)
+	}
+	if !MIExistsInEnv("cluster", testMainConfigFilePath) {
+		t.Errorf("Expected '%t', got '%t' instead\n", true, false)
+	}
+
+	expected := "https://mi-2:9164"
+	returned, err := GetMIManagementEndpointOfEnv(GetMINodeEnvName("cluster", "node2"), testMainConfigFilePath)
+	if err != nil || returned != expected {
+		t.Errorf("Expected '%s', got '%s' instead\n", expected, returned)
+	}
+	if _, err = GetMIManagementEndpointOfEnv("cluster@node3", testMainConfigFilePath); err == nil {
+		t.Errorf("Expected an error for a node which is not in the environment\n")
+	}
+}
+
+func TestSplitMINodeEnvName(t *testing.T) {
+	env, node := SplitMINodeEnvName("cluster@node1")
+	if env != "cluster" || node != "node1" {
+		t.Errorf("Expected '%s', '%s', got '%s', '%s' instead\n", "cluster", "node1", env, node)
+	}
+	env, node = SplitMINodeEnvName("dev")
+	if env != "dev" || node != "" {
+		t.Errorf("Expected '%s', '%s', got '%s', '%s' instead\n", "dev", "", env, node)
+	}
+}
@@ -100,7 +100,7 @@ func (mainConfig *MainConfig) ParseMainConfigFromFile(data []byte) error {
 		return err
 	}
 	for name, endpoints := range mainConfig.Environments {
-		if !HasOnlyMIEndpoint(&endpoints) {
+		if !HasOnlyMIOrMgwEndpoint(&endpoints) {
 			if endpoints.ApiManagerEndpoint == "" {
 				if RequiredAPIMEndpointsExists(&endpoints) {
 					return nil
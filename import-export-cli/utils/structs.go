@@ -50,13 +50,17 @@ type EnvKeys struct {
 }
 
 type EnvEndpoints struct {
-	ApiManagerEndpoint   string `yaml:"apim"`
-	PublisherEndpoint    string `yaml:"publisher"`
-	DevPortalEndpoint    string `yaml:"devportal"`
-	RegistrationEndpoint string `yaml:"registration"`
-	AdminEndpoint        string `yaml:"admin"`
-	TokenEndpoint        string `yaml:"token"`
-	MiManagementEndpoint string `yaml:"mi"`
+	ApiManagerEndpoint   string            `yaml:"apim"`
+	PublisherEndpoint    string            `yaml:"publisher"`
+	DevPortalEndpoint    string            `yaml:"devportal"`
+	RegistrationEndpoint string            `yaml:"registration"`
+	AdminEndpoint        string            `yaml:"admin"`
+	TokenEndpoint        string            `yaml:"token"`
+	MiManagementEndpoint string            `yaml:"mi"`
+	MiManagementNodes    map[string]string `yaml:"mi_nodes,omitempty"`
+	MiClientCertificate  string            `yaml:"mi_client_cert,omitempty"`
+	MiClientKey          string            `yaml:"mi_client_key,omitempty"`
+	MgwAdapterEndpoint   string            `yaml:"mg"`
 }
 
 // ---------------- End of Structs for YAML Config Files ---------------------------------
@@ -98,6 +102,7 @@ type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
 	ExpiresIn    int32  `json:"expires_in"`
 }
 
@@ -134,12 +139,25 @@ type HttpErrorResponse struct {
 //Key generation request
 type KeygenRequest struct {
 	KeyType                 string   `json:"keyType"`
+	KeyManager              string   `json:"keyManager,omitempty"`
 	GrantTypesToBeSupported []string `json:"grantTypesToBeSupported"`
+	CallbackURL             string   `json:"callbackUrl,omitempty"`
+	Scopes                  []string `json:"scopes,omitempty"`
 	ValidityTime            int      `json:"validityTime"`
 }
 
+//Key mapping request for externally created OAuth clients
+type KeyMappingRequest struct {
+	ConsumerKey    string `json:"consumerKey"`
+	ConsumerSecret string `json:"consumerSecret"`
+	KeyType        string `json:"keyType"`
+	KeyManager     string `json:"keyManager,omitempty"`
+}
+
 //Key generation response
 type KeygenResponse struct {
+	KeyMappingID        string      `json:"keyMappingId"`
+	KeyManager          string      `json:"keyManager"`
 	CallbackURL         interface{} `json:"callbackUrl"`
 	ConsumerKey         string      `json:"consumerKey"`
 	ConsumerSecret      string      `json:"consumerSecret"`
@@ -160,6 +178,23 @@ type AppKeyList struct {
 	List  []ApplicationKey `json:"list"`
 }
 
+//Key managers list response
+type KeyManagerList struct {
+	Count int          `json:"count"`
+	List  []KeyManager `json:"list"`
+}
+
+// Key manager details available in the devportal
+type KeyManager struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Type                string   `json:"type"`
+	DisplayName         string   `json:"displayName"`
+	Description         string   `json:"description"`
+	Enabled             bool     `json:"enabled"`
+	AvailableGrantTypes []string `json:"availableGrantTypes"`
+}
+
 // Consumer Secret regeneration response
 type ConsumerSecretRegenResponse struct {
 	ConsumerKey    string `json:"consumerKey"`
@@ -200,9 +235,8 @@ type AppDetails struct {
 	Status            string        `json:"status"`
 	Groups            []interface{} `json:"groups"`
 	SubscriptionCount int           `json:"subscriptionCount"`
-	Keys              []ApplicationKey
-	Attributes        struct {
-	} `json:"attributes"`
+	Keys              []ApplicationKey  `json:"keys,omitempty"`
+	Attributes        map[string]string `json:"attributes"`
 	SubscriptionScopes []struct {
 		Key         string   `json:"key"`
 		Name        string   `json:"name"`
@@ -215,6 +249,8 @@ type AppDetails struct {
 
 // Application key details
 type ApplicationKey struct {
+	KeyMappingID        string      `json:"keyMappingId,omitempty"`
+	KeyManager          string      `json:"keyManager,omitempty"`
 	ConsumerKey         string      `json:"consumerKey"`
 	ConsumerSecret      string      `json:"consumerSecret"`
 	SupportedGrantTypes []string    `json:"supportedGrantTypes"`
@@ -346,6 +382,28 @@ type APIData struct {
 	Tags      []string `json:"tags"`
 }
 
+// API details available in the DevPortal
+type DevPortalAPI struct {
+	ID           string           `json:"id"`
+	Name         string           `json:"name"`
+	Context      string           `json:"context"`
+	Version      string           `json:"version"`
+	Provider     string           `json:"provider"`
+	Type         string           `json:"type"`
+	EndpointURLs []APIEndpointURL `json:"endpointURLs"`
+}
+
+// Gateway URLs of an API in a gateway environment
+type APIEndpointURL struct {
+	EnvironmentName        string `json:"environmentName"`
+	EnvironmentDisplayName string `json:"environmentDisplayName"`
+	EnvironmentType        string `json:"environmentType"`
+	URLs                   struct {
+		HTTP  string `json:"http"`
+		HTTPS string `json:"https"`
+	} `json:"URLs"`
+}
+
 // Project MetaData struct
 type MetaData struct {
 	Name     string `json:"name"`
@@ -353,3 +411,21 @@ type MetaData struct {
 	Revision string `json:"revision"`
 	Owner    string `json:"owner,omitempty"`
 }
+
+// API document of the Publisher REST API
+type Document struct {
+	DocumentID    string `json:"documentId,omitempty"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Summary       string `json:"summary,omitempty"`
+	SourceType    string `json:"sourceType"`
+	SourceURL     string `json:"sourceUrl,omitempty"`
+	FileName      string `json:"fileName,omitempty"`
+	OtherTypeName string `json:"otherTypeName,omitempty"`
+	Visibility    string `json:"visibility"`
+}
+
+type DocumentList struct {
+	Count int        `json:"count"`
+	List  []Document `json:"list"`
+}
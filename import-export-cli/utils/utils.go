@@ -179,6 +179,35 @@ func InvokePutRequest(queryParam map[string]string, url string, headers map[stri
 	return resp, err
 }
 
+// Invoke an http request with the given method, query parameters and body using go-resty
+func InvokeRequest(method string, url string, headers map[string]string, queryParam map[string]string,
+	body string) (*resty.Response, error) {
+	if Insecure {
+		resty.SetTLSClientConfig(
+			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
+				Renegotiation: TLSRenegotiationMode})
+	} else {
+		resty.SetTLSClientConfig(GetTlsConfigWithCertificate())
+	}
+	if os.Getenv("HTTP_PROXY") != "" {
+		resty.SetProxy(os.Getenv("HTTP_PROXY"))
+	} else if os.Getenv("HTTPS_PROXY") != "" {
+		resty.SetProxy(os.Getenv("HTTPS_PROXY"))
+	} else if os.Getenv("http_proxy") != "" {
+		resty.SetProxy(os.Getenv("http_proxy"))
+	} else if os.Getenv("https_proxy") != "" {
+		resty.SetProxy(os.Getenv("https_proxy"))
+	}
+	resty.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
+	request := resty.R().SetHeaders(headers).SetQueryParams(queryParam)
+	if body != "" {
+		request.SetBody(body)
+	}
+	resp, err := request.Execute(method, url)
+
+	return resp, err
+}
+
 //Invoke POST request with query parameters
 func InvokePostRequestWithQueryParam(queryParam map[string]string, url string, headers map[string]string, body string) (
 	*resty.Response, error) {
@@ -252,6 +281,43 @@ func InvokePATCHRequest(url string, headers map[string]string, body map[string]s
 	return resp, err
 }
 
+// Invoke an http request to the management API of the Micro Integrator of an environment using go-resty. A client of
+// its own is used, so that the client certificate of the Micro Integrator is presented only in requests to it
+func InvokeMIRequest(env, method, url string, headers map[string]string, queryParam map[string]string,
+	body interface{}) (*resty.Response, error) {
+	certificates, err := GetMIClientCertificatesOfEnv(env, MainConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+	client := resty.New()
+	var tlsConfig *tls.Config
+	if Insecure {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
+			Renegotiation: TLSRenegotiationMode}
+	} else {
+		tlsConfig = GetTlsConfigWithCertificate()
+	}
+	tlsConfig.Certificates = certificates
+	client.SetTLSClientConfig(tlsConfig)
+	if os.Getenv("HTTP_PROXY") != "" {
+		client.SetProxy(os.Getenv("HTTP_PROXY"))
+	} else if os.Getenv("HTTPS_PROXY") != "" {
+		client.SetProxy(os.Getenv("HTTPS_PROXY"))
+	} else if os.Getenv("http_proxy") != "" {
+		client.SetProxy(os.Getenv("http_proxy"))
+	} else if os.Getenv("https_proxy") != "" {
+		client.SetProxy(os.Getenv("https_proxy"))
+	}
+	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
+	request := client.R().SetHeaders(headers).SetQueryParams(queryParam)
+	if body != nil {
+		request.SetBody(body)
+	}
+	resp, err := request.Execute(method, url)
+
+	return resp, err
+}
+
 func PromptForUsername() string {
 	reader := bufio.NewReader(os.Stdin)
 